@@ -9,7 +9,6 @@ import (
 	"fmt"
 	"io"
 	"os"
-	"strconv"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -35,19 +34,7 @@ func generateCSV(ctx context.Context, start time.Time, end time.Time, output io.
 	}
 
 	w := csv.NewWriter(output)
-	headers := []string{
-		"nodeID",
-		"nodeCreationDate",
-		"byte-hours:AtRest",
-		"bytes:BWRepair-GET",
-		"bytes:BWRepair-PUT",
-		"bytes:BWAudit",
-		"bytes:BWPut",
-		"bytes:BWGet",
-		"walletAddress",
-		"disqualified",
-	}
-	if err := w.Write(headers); err != nil {
+	if err := w.Write(accounting.CSVHeader); err != nil {
 		return err
 	}
 
@@ -60,7 +47,7 @@ func generateCSV(ctx context.Context, start time.Time, end time.Time, output io.
 		}
 
 		row.Wallet = node.Operator.Wallet
-		record := structToStringSlice(row)
+		record := row.Record()
 		if err := w.Write(record); err != nil {
 			return err
 		}
@@ -74,23 +61,3 @@ func generateCSV(ctx context.Context, start time.Time, end time.Time, output io.
 	}
 	return err
 }
-
-func structToStringSlice(s *accounting.CSVRow) []string {
-	dqStr := ""
-	if s.Disqualified != nil {
-		dqStr = s.Disqualified.Format("2006-01-02")
-	}
-	record := []string{
-		s.NodeID.String(),
-		s.NodeCreationDate.Format("2006-01-02"),
-		strconv.FormatFloat(s.AtRestTotal, 'f', 5, 64),
-		strconv.FormatInt(s.GetRepairTotal, 10),
-		strconv.FormatInt(s.PutRepairTotal, 10),
-		strconv.FormatInt(s.GetAuditTotal, 10),
-		strconv.FormatInt(s.PutTotal, 10),
-		strconv.FormatInt(s.GetTotal, 10),
-		s.Wallet,
-		dqStr,
-	}
-	return record
-}
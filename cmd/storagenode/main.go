@@ -110,6 +110,7 @@ func databaseConfig(config storagenode.Config) storagenodedb.Config {
 		Info:     filepath.Join(config.Storage.Path, "piecestore.db"),
 		Info2:    filepath.Join(config.Storage.Path, "info.db"),
 		Pieces:   config.Storage.Path,
+		BlobSync: config.Storage.BlobSync,
 		Kademlia: config.Kademlia.DBPath,
 	}
 }
@@ -77,6 +77,18 @@ func (slow *SlowBlobs) Delete(ctx context.Context, ref storage.BlobRef) error {
 	return slow.blobs.Delete(ctx, ref)
 }
 
+// Exists returns whether the blob with the namespace and key is stored.
+func (slow *SlowBlobs) Exists(ctx context.Context, ref storage.BlobRef) (bool, error) {
+	slow.sleep()
+	return slow.blobs.Exists(ctx, ref)
+}
+
+// WalkNamespace calls walkFunc for every blob stored in the namespace.
+func (slow *SlowBlobs) WalkNamespace(ctx context.Context, namespace []byte, walkFunc func(storage.BlobRef) error) error {
+	slow.sleep()
+	return slow.blobs.WalkNamespace(ctx, namespace, walkFunc)
+}
+
 // FreeSpace return how much free space left for writing.
 func (slow *SlowBlobs) FreeSpace() (int64, error) {
 	slow.sleep()
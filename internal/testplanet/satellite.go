@@ -124,6 +124,7 @@ func (planet *Planet) newSatellites(count int) ([]*satellite.Peer, error) {
 					UptimeReputationDQ:           0.6,
 				},
 				UpdateStatsBatchSize: 100,
+				ReservationTTL:       time.Hour,
 			},
 			Discovery: discovery.Config{
 				DiscoveryInterval:  1 * time.Second,
@@ -162,6 +163,7 @@ func (planet *Planet) newSatellites(count int) ([]*satellite.Peer, error) {
 				MaxRepair:                     10,
 				Interval:                      time.Hour,
 				Timeout:                       1 * time.Minute, // Repairs can take up to 10 seconds. Leaving room for outliers
+				DownloadTimeout:               1 * time.Minute,
 				MaxBufferMem:                  4 * memory.MiB,
 				MaxExcessRateOptimalThreshold: 0.05,
 			},
@@ -170,6 +172,8 @@ func (planet *Planet) newSatellites(count int) ([]*satellite.Peer, error) {
 				Interval:           30 * time.Second,
 				MinBytesPerSecond:  1 * memory.KB,
 				MinDownloadTimeout: 5 * time.Second,
+
+				CapacityCheckInterval: time.Hour,
 			},
 			GarbageCollection: gc.Config{
 				Interval:          1 * time.Minute,
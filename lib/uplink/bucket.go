@@ -11,6 +11,7 @@ import (
 	"github.com/zeebo/errs"
 
 	"storj.io/storj/pkg/storj"
+	"storj.io/storj/uplink/ecclient"
 	"storj.io/storj/uplink/metainfo/kvmetainfo"
 	"storj.io/storj/uplink/storage/streams"
 	"storj.io/storj/uplink/stream"
@@ -25,6 +26,7 @@ type Bucket struct {
 	bucket   storj.Bucket
 	metainfo *kvmetainfo.DB
 	streams  streams.Store
+	ec       ecclient.Client
 }
 
 // TODO: move the object related OpenObject to object.go
@@ -201,5 +203,5 @@ func (b *Bucket) NewReader(ctx context.Context, path storj.Path) (_ ReadSeekClos
 
 // Close closes the Bucket session.
 func (b *Bucket) Close() error {
-	return nil
+	return b.ec.Close()
 }
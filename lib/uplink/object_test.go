@@ -0,0 +1,187 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package uplink_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/lib/uplink"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/uplink/metainfo/kvmetainfo"
+)
+
+// check that the expiration set when uploading an object is reported when opening it.
+func TestObjectExpiration(t *testing.T) {
+	var (
+		access     = uplink.NewEncryptionAccessWithDefaultKey(storj.Key{0, 1, 2, 3, 4})
+		bucketName = "ephemeral"
+		expires    = time.Now().Add(24 * time.Hour).UTC()
+	)
+
+	testPlanetWithLibUplink(t, testConfig{},
+		func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet, proj *uplink.Project) {
+			_, err := proj.CreateBucket(ctx, bucketName, nil)
+			require.NoError(t, err)
+
+			bucket, err := proj.OpenBucket(ctx, bucketName, access)
+			require.NoError(t, err)
+			defer ctx.Check(bucket.Close)
+
+			err = bucket.UploadObject(ctx, "expiring", bytes.NewBufferString("soon gone"), &uplink.UploadOptions{
+				Expires: expires,
+			})
+			require.NoError(t, err)
+
+			err = bucket.UploadObject(ctx, "lasting", bytes.NewBufferString("here to stay"), nil)
+			require.NoError(t, err)
+
+			expiring, err := bucket.OpenObject(ctx, "expiring")
+			require.NoError(t, err)
+			defer ctx.Check(expiring.Close)
+
+			assert.WithinDuration(t, expires, expiring.Meta.Expires, time.Second)
+
+			lasting, err := bucket.OpenObject(ctx, "lasting")
+			require.NoError(t, err)
+			defer ctx.Check(lasting.Close)
+
+			assert.True(t, lasting.Meta.Expires.IsZero())
+		})
+}
+
+// check that uploads and downloads are limited to the configured bandwidth.
+func TestObjectBandwidthLimits(t *testing.T) {
+	var (
+		access     = uplink.NewEncryptionAccessWithDefaultKey(storj.Key{0, 1, 2, 3, 4})
+		bucketName = "throttled"
+		data       = testrand.Bytes(16 * memory.KiB)
+		rate       = 8 * memory.KiB
+		// the first second worth of data is transferred without waiting
+		minimum = time.Duration(float64(len(data)-rate.Int()) / float64(rate) * float64(time.Second))
+	)
+
+	var cfg testConfig
+	cfg.uplinkCfg.Volatile.MaxUploadBandwidth = rate
+	cfg.uplinkCfg.Volatile.MaxDownloadBandwidth = rate
+
+	testPlanetWithLibUplink(t, cfg,
+		func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet, proj *uplink.Project) {
+			_, err := proj.CreateBucket(ctx, bucketName, &testBucketConfig)
+			require.NoError(t, err)
+
+			bucket, err := proj.OpenBucket(ctx, bucketName, access)
+			require.NoError(t, err)
+			defer ctx.Check(bucket.Close)
+
+			start := time.Now()
+			err = bucket.UploadObject(ctx, "object", bytes.NewReader(data), nil)
+			require.NoError(t, err)
+			assert.True(t, time.Since(start) >= minimum, "upload took %v, expected at least %v", time.Since(start), minimum)
+
+			object, err := bucket.OpenObject(ctx, "object")
+			require.NoError(t, err)
+			defer ctx.Check(object.Close)
+
+			start = time.Now()
+			reader, err := object.DownloadRange(ctx, 0, -1)
+			require.NoError(t, err)
+			downloaded, err := ioutil.ReadAll(reader)
+			require.NoError(t, err)
+			require.NoError(t, reader.Close())
+			assert.True(t, time.Since(start) >= minimum, "download took %v, expected at least %v", time.Since(start), minimum)
+
+			assert.Equal(t, data, downloaded)
+		})
+}
+
+// testBucketConfig is a bucket configuration whose remote segments fit on the
+// storage nodes of testPlanetWithLibUplink.
+var testBucketConfig = uplink.BucketConfig{
+	Volatile: struct {
+		RedundancyScheme storj.RedundancyScheme
+		SegmentsSize     memory.Size
+	}{
+		RedundancyScheme: storj.RedundancyScheme{
+			Algorithm:      storj.ReedSolomon,
+			ShareSize:      memory.KiB.Int32(),
+			RequiredShares: 2,
+			RepairShares:   3,
+			OptimalShares:  4,
+			TotalShares:    5,
+		},
+	},
+}
+
+// check that a copied object has the same data and metadata as its source,
+// and that objects with remote segments are not copied.
+func TestCopyObject(t *testing.T) {
+	var (
+		access = uplink.NewEncryptionAccessWithDefaultKey(storj.Key{0, 1, 2, 3, 4})
+		data   = testrand.Bytes(1 * memory.KiB)
+	)
+
+	testPlanetWithLibUplink(t, testConfig{},
+		func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet, proj *uplink.Project) {
+			for _, name := range []string{"source", "destination"} {
+				_, err := proj.CreateBucket(ctx, name, &testBucketConfig)
+				require.NoError(t, err)
+			}
+
+			src, err := proj.OpenBucket(ctx, "source", access)
+			require.NoError(t, err)
+			defer ctx.Check(src.Close)
+
+			err = src.UploadObject(ctx, "original", bytes.NewReader(data), &uplink.UploadOptions{
+				ContentType: "application/octet-stream",
+				Metadata:    map[string]string{"key": "value"},
+			})
+			require.NoError(t, err)
+
+			err = proj.CopyObject(ctx, "source", "original", "destination", "copy", access)
+			require.NoError(t, err)
+
+			dst, err := proj.OpenBucket(ctx, "destination", access)
+			require.NoError(t, err)
+			defer ctx.Check(dst.Close)
+
+			object, err := dst.OpenObject(ctx, "copy")
+			require.NoError(t, err)
+			defer ctx.Check(object.Close)
+
+			assert.Equal(t, "application/octet-stream", object.Meta.ContentType)
+			assert.Equal(t, map[string]string{"key": "value"}, object.Meta.Metadata)
+			assert.Equal(t, int64(len(data)), object.Meta.Size)
+
+			reader, err := object.DownloadRange(ctx, 0, -1)
+			require.NoError(t, err)
+			copied, err := ioutil.ReadAll(reader)
+			require.NoError(t, err)
+			require.NoError(t, reader.Close())
+
+			assert.Equal(t, data, copied)
+
+			// the source is left in place
+			_, err = src.OpenObject(ctx, "original")
+			require.NoError(t, err)
+
+			err = src.UploadObject(ctx, "remote", bytes.NewReader(testrand.Bytes(10*memory.KiB)), nil)
+			require.NoError(t, err)
+
+			err = proj.CopyObject(ctx, "source", "remote", "destination", "remote-copy", access)
+			require.True(t, kvmetainfo.ErrRemoteCopy.Has(err), err)
+
+			_, err = dst.OpenObject(ctx, "remote-copy")
+			require.True(t, storj.ErrObjectNotFound.Has(err), err)
+		})
+}
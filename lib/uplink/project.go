@@ -203,6 +203,7 @@ func (p *Project) OpenBucket(ctx context.Context, bucketName string, access *Enc
 	if err != nil {
 		return nil, err
 	}
+	streamStore = newThrottledStore(streamStore, p.uplinkCfg.Volatile.MaxUploadBandwidth, p.uplinkCfg.Volatile.MaxDownloadBandwidth)
 
 	return &Bucket{
 		BucketConfig: *cfg,
@@ -211,9 +212,26 @@ func (p *Project) OpenBucket(ctx context.Context, bucketName string, access *Enc
 		bucket:       bucketInfo,
 		metainfo:     kvmetainfo.New(p.project, p.metainfo, streamStore, segmentStore, access.store),
 		streams:      streamStore,
+		ec:           ec,
 	}, nil
 }
 
+// CopyObject copies the object at srcPath in srcBucket to dstPath in dstBucket,
+// keeping its content type, metadata, expiration, encryption parameters and
+// redundancy scheme. Both paths are encrypted with the given EncryptionAccess.
+//
+// The copy shares the encrypted segment data of the source, whose content keys
+// are re-encrypted for the new path, so nothing is downloaded or uploaded again.
+// Objects with remote segments cannot be copied, as both objects would share
+// the pieces on the storage nodes; kvmetainfo.ErrRemoteCopy is returned for
+// them.
+func (p *Project) CopyObject(ctx context.Context, srcBucket string, srcPath storj.Path, dstBucket string, dstPath storj.Path, access *EncryptionAccess) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	db := kvmetainfo.New(p.project, p.metainfo, nil, nil, access.store)
+	return db.CopyObject(ctx, srcBucket, srcPath, dstBucket, dstPath)
+}
+
 func (p *Project) retrieveSalt(ctx context.Context) (salt []byte, err error) {
 	defer mon.Task()(&ctx)(&err)
 
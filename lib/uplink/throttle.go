@@ -0,0 +1,125 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package uplink
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/ranger"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/uplink/storage/streams"
+)
+
+// throttledStore limits the rate at which object data is uploaded to and
+// downloaded from the wrapped stream store.
+type throttledStore struct {
+	streams.Store
+	upload   memory.Size
+	download memory.Size
+}
+
+// newThrottledStore wraps store so that uploads and downloads don't exceed the
+// given rates in bytes per second. A non-positive rate means no limit.
+func newThrottledStore(store streams.Store, upload, download memory.Size) streams.Store {
+	if upload <= 0 && download <= 0 {
+		return store
+	}
+	return &throttledStore{Store: store, upload: upload, download: download}
+}
+
+// Get returns a ranger whose readers are limited to the download rate.
+func (store *throttledStore) Get(ctx context.Context, path storj.Path, pathCipher storj.CipherSuite) (ranger.Ranger, streams.Meta, error) {
+	rr, meta, err := store.Store.Get(ctx, path, pathCipher)
+	if err != nil || store.download <= 0 {
+		return rr, meta, err
+	}
+	return &throttledRanger{Ranger: rr, rate: store.download}, meta, nil
+}
+
+// Put uploads data, reading it no faster than the upload rate.
+func (store *throttledStore) Put(ctx context.Context, path storj.Path, pathCipher storj.CipherSuite, data io.Reader, metadata []byte, expiration time.Time) (streams.Meta, error) {
+	if store.upload > 0 {
+		data = &throttledReader{ctx: ctx, Reader: data, limiter: newRateLimiter(store.upload)}
+	}
+	return store.Store.Put(ctx, path, pathCipher, data, metadata, expiration)
+}
+
+// throttledRanger limits the rate of reads from the ranges it returns.
+type throttledRanger struct {
+	ranger.Ranger
+	rate memory.Size
+}
+
+// Range returns a reader for the range, limited to the download rate.
+func (rr *throttledRanger) Range(ctx context.Context, offset, length int64) (io.ReadCloser, error) {
+	reader, err := rr.Ranger.Range(ctx, offset, length)
+	if err != nil {
+		return nil, err
+	}
+	return &throttledReadCloser{
+		throttledReader: throttledReader{ctx: ctx, Reader: reader, limiter: newRateLimiter(rr.rate)},
+		closer:          reader,
+	}, nil
+}
+
+// rateLimiter delays a transfer so that its rate doesn't exceed the limit.
+// At most a second worth of bytes is transferred at once.
+type rateLimiter struct {
+	limiter *rate.Limiter
+}
+
+func newRateLimiter(bytesPerSecond memory.Size) *rateLimiter {
+	burst := bytesPerSecond.Int()
+	if burst < 1 {
+		burst = 1
+	}
+	return &rateLimiter{limiter: rate.NewLimiter(rate.Limit(bytesPerSecond), burst)}
+}
+
+// burst returns the most bytes that can be transferred at once.
+func (limiter *rateLimiter) burst() int {
+	return limiter.limiter.Burst()
+}
+
+// transferred accounts for n transferred bytes, waiting until the transfer is
+// back under the limit or ctx is canceled.
+func (limiter *rateLimiter) transferred(ctx context.Context, n int) error {
+	if n <= 0 {
+		return nil
+	}
+	return limiter.limiter.WaitN(ctx, n)
+}
+
+// throttledReader limits the rate of reads from a reader.
+type throttledReader struct {
+	ctx context.Context
+	io.Reader
+	limiter *rateLimiter
+}
+
+func (reader *throttledReader) Read(data []byte) (int, error) {
+	if len(data) > reader.limiter.burst() {
+		data = data[:reader.limiter.burst()]
+	}
+	n, err := reader.Reader.Read(data)
+	if waitErr := reader.limiter.transferred(reader.ctx, n); waitErr != nil {
+		return n, waitErr
+	}
+	return n, err
+}
+
+// throttledReadCloser limits the rate of reads from a read closer.
+type throttledReadCloser struct {
+	throttledReader
+	closer io.Closer
+}
+
+func (reader *throttledReadCloser) Close() error {
+	return reader.closer.Close()
+}
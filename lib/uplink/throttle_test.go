@@ -0,0 +1,32 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package uplink
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+)
+
+func TestThrottledReader(t *testing.T) {
+	data := make([]byte, 4*memory.KiB)
+
+	// reads are split to the burst size
+	reader := &throttledReader{ctx: context.Background(), Reader: bytes.NewReader(data), limiter: newRateLimiter(memory.KiB)}
+	n, err := reader.Read(make([]byte, len(data)))
+	require.NoError(t, err)
+	assert.Equal(t, memory.KiB.Int(), n)
+
+	// waiting stops when the context is canceled
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	reader.ctx = ctx
+	_, err = reader.Read(make([]byte, len(data)))
+	assert.Equal(t, context.Canceled, err)
+}
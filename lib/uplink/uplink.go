@@ -74,6 +74,18 @@ type Config struct {
 		// RequestTimeout is the maximum time to wait for a request response from another node.
 		// If not set, the library default (20 seconds) will be used.
 		RequestTimeout time.Duration
+
+		// MaxUploadBandwidth limits how fast object data is uploaded, in
+		// bytes per second. The limit applies to each upload separately
+		// and to the object data before erasure coding, so the traffic
+		// sent to storage nodes will be higher. If not set, uploads are
+		// not limited.
+		MaxUploadBandwidth memory.Size
+
+		// MaxDownloadBandwidth limits how fast object data is downloaded,
+		// in bytes per second. The limit applies to each download
+		// separately. If not set, downloads are not limited.
+		MaxDownloadBandwidth memory.Size
 	}
 }
 
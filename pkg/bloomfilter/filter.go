@@ -48,8 +48,13 @@ func NewOptimal(expectedElements int, falsePositiveRate float64) *Filter {
 
 // NewOptimalMaxSize returns a filter based on expected element count and false positive rate, capped at a maximum size in bytes
 func NewOptimalMaxSize(expectedElements int, falsePositiveRate float64, maxSize memory.Size) *Filter {
+	return NewOptimalMaxSizeSeed(GenerateSeed(), expectedElements, falsePositiveRate, maxSize)
+}
+
+// NewOptimalMaxSizeSeed is like NewOptimalMaxSize, but it uses the given seed.
+// Filters created with the same arguments can be combined with AddFilter.
+func NewOptimalMaxSizeSeed(seed byte, expectedElements int, falsePositiveRate float64, maxSize memory.Size) *Filter {
 	hashCount, sizeInBytes := getHashCountAndSize(expectedElements, falsePositiveRate)
-	seed := byte(rand.Intn(255))
 
 	if sizeInBytes > maxSize.Int() {
 		sizeInBytes = maxSize.Int()
@@ -58,6 +63,11 @@ func NewOptimalMaxSize(expectedElements int, falsePositiveRate float64, maxSize
 	return newExplicit(seed, byte(hashCount), sizeInBytes)
 }
 
+// GenerateSeed returns a random seed for a filter.
+func GenerateSeed() byte {
+	return byte(rand.Intn(255))
+}
+
 func getHashCountAndSize(expectedElements int, falsePositiveRate float64) (hashCount, size int) {
 	// calculation based on https://en.wikipedia.org/wiki/Bloom_filter#Optimal_number_of_hash_functions
 	bitsPerElement := -1.44 * math.Log2(falsePositiveRate)
@@ -93,6 +103,18 @@ func (filter *Filter) Add(pieceID storj.PieceID) {
 	}
 }
 
+// AddFilter adds all the elements of other to the bloom filter.
+// Both filters must have the same seed, hash count and size.
+func (filter *Filter) AddFilter(other *Filter) error {
+	if filter.seed != other.seed || filter.hashCount != other.hashCount || len(filter.table) != len(other.table) {
+		return errs.New("incompatible filters")
+	}
+	for i, b := range other.table {
+		filter.table[i] |= b
+	}
+	return nil
+}
+
 // Contains return true if pieceID may be in the set
 func (filter *Filter) Contains(pieceID storj.PieceID) bool {
 	offset, rangeOffset := initialConditions(filter.seed)
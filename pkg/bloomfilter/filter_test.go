@@ -32,6 +32,32 @@ func TestNoFalsePositive(t *testing.T) {
 	}
 }
 
+func TestAddFilter(t *testing.T) {
+	const numberOfPieces = 1000
+	pieceIDs := generateTestIDs(2 * numberOfPieces)
+
+	seed := bloomfilter.GenerateSeed()
+	first := bloomfilter.NewOptimalMaxSizeSeed(seed, 2*numberOfPieces, 0.1, memory.MiB)
+	second := bloomfilter.NewOptimalMaxSizeSeed(seed, 2*numberOfPieces, 0.1, memory.MiB)
+	for _, pieceID := range pieceIDs[:numberOfPieces] {
+		first.Add(pieceID)
+	}
+	for _, pieceID := range pieceIDs[numberOfPieces:] {
+		second.Add(pieceID)
+	}
+
+	require.NoError(t, first.AddFilter(second))
+	for _, pieceID := range pieceIDs {
+		require.True(t, first.Contains(pieceID))
+	}
+
+	// filters with different parameters can't be combined
+	other := bloomfilter.NewOptimalMaxSizeSeed(seed+1, 2*numberOfPieces, 0.1, memory.MiB)
+	require.Error(t, first.AddFilter(other))
+	smaller := bloomfilter.NewOptimalMaxSizeSeed(seed, numberOfPieces, 0.1, memory.MiB)
+	require.Error(t, first.AddFilter(smaller))
+}
+
 func TestBytes(t *testing.T) {
 	for _, count := range []int{0, 100, 1000, 10000} {
 		filter := bloomfilter.NewOptimal(count, 0.1)
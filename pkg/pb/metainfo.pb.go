@@ -798,6 +798,7 @@ type SegmentCommitRequestOld struct {
 	Segment              int64         `protobuf:"varint,3,opt,name=segment,proto3" json:"segment,omitempty"`
 	Pointer              *Pointer      `protobuf:"bytes,4,opt,name=pointer,proto3" json:"pointer,omitempty"`
 	OriginalLimits       []*OrderLimit `protobuf:"bytes,5,rep,name=original_limits,json=originalLimits,proto3" json:"original_limits,omitempty"`
+	IdempotencyKey       []byte        `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
@@ -862,6 +863,13 @@ func (m *SegmentCommitRequestOld) GetOriginalLimits() []*OrderLimit {
 	return nil
 }
 
+func (m *SegmentCommitRequestOld) GetIdempotencyKey() []byte {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return nil
+}
+
 type SegmentCommitResponseOld struct {
 	Pointer              *Pointer `protobuf:"bytes,1,opt,name=pointer,proto3" json:"pointer,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -3450,6 +3458,152 @@ func (m *SegmentDownloadResponse) GetNext() *SegmentPosition {
 	return nil
 }
 
+type ObjectRelocateRequestOld struct {
+	Bucket               []byte                  `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Path                 []byte                  `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	NewBucket            []byte                  `protobuf:"bytes,3,opt,name=new_bucket,json=newBucket,proto3" json:"new_bucket,omitempty"`
+	NewPath              []byte                  `protobuf:"bytes,4,opt,name=new_path,json=newPath,proto3" json:"new_path,omitempty"`
+	Segments             []*SegmentRelocationOld `protobuf:"bytes,5,rep,name=segments,proto3" json:"segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *ObjectRelocateRequestOld) Reset()         { *m = ObjectRelocateRequestOld{} }
+func (m *ObjectRelocateRequestOld) String() string { return proto.CompactTextString(m) }
+func (*ObjectRelocateRequestOld) ProtoMessage()    {}
+func (*ObjectRelocateRequestOld) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{66}
+}
+func (m *ObjectRelocateRequestOld) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectRelocateRequestOld.Unmarshal(m, b)
+}
+func (m *ObjectRelocateRequestOld) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectRelocateRequestOld.Marshal(b, m, deterministic)
+}
+func (m *ObjectRelocateRequestOld) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectRelocateRequestOld.Merge(m, src)
+}
+func (m *ObjectRelocateRequestOld) XXX_Size() int {
+	return xxx_messageInfo_ObjectRelocateRequestOld.Size(m)
+}
+func (m *ObjectRelocateRequestOld) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectRelocateRequestOld.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectRelocateRequestOld proto.InternalMessageInfo
+
+func (m *ObjectRelocateRequestOld) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *ObjectRelocateRequestOld) GetPath() []byte {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+func (m *ObjectRelocateRequestOld) GetNewBucket() []byte {
+	if m != nil {
+		return m.NewBucket
+	}
+	return nil
+}
+
+func (m *ObjectRelocateRequestOld) GetNewPath() []byte {
+	if m != nil {
+		return m.NewPath
+	}
+	return nil
+}
+
+func (m *ObjectRelocateRequestOld) GetSegments() []*SegmentRelocationOld {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+type SegmentRelocationOld struct {
+	Segment              int64    `protobuf:"varint,1,opt,name=segment,proto3" json:"segment,omitempty"`
+	Metadata             []byte   `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentRelocationOld) Reset()         { *m = SegmentRelocationOld{} }
+func (m *SegmentRelocationOld) String() string { return proto.CompactTextString(m) }
+func (*SegmentRelocationOld) ProtoMessage()    {}
+func (*SegmentRelocationOld) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{67}
+}
+func (m *SegmentRelocationOld) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentRelocationOld.Unmarshal(m, b)
+}
+func (m *SegmentRelocationOld) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentRelocationOld.Marshal(b, m, deterministic)
+}
+func (m *SegmentRelocationOld) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentRelocationOld.Merge(m, src)
+}
+func (m *SegmentRelocationOld) XXX_Size() int {
+	return xxx_messageInfo_SegmentRelocationOld.Size(m)
+}
+func (m *SegmentRelocationOld) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentRelocationOld.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentRelocationOld proto.InternalMessageInfo
+
+func (m *SegmentRelocationOld) GetSegment() int64 {
+	if m != nil {
+		return m.Segment
+	}
+	return 0
+}
+
+func (m *SegmentRelocationOld) GetMetadata() []byte {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+type ObjectRelocateResponseOld struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectRelocateResponseOld) Reset()         { *m = ObjectRelocateResponseOld{} }
+func (m *ObjectRelocateResponseOld) String() string { return proto.CompactTextString(m) }
+func (*ObjectRelocateResponseOld) ProtoMessage()    {}
+func (*ObjectRelocateResponseOld) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{68}
+}
+func (m *ObjectRelocateResponseOld) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectRelocateResponseOld.Unmarshal(m, b)
+}
+func (m *ObjectRelocateResponseOld) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectRelocateResponseOld.Marshal(b, m, deterministic)
+}
+func (m *ObjectRelocateResponseOld) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectRelocateResponseOld.Merge(m, src)
+}
+func (m *ObjectRelocateResponseOld) XXX_Size() int {
+	return xxx_messageInfo_ObjectRelocateResponseOld.Size(m)
+}
+func (m *ObjectRelocateResponseOld) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectRelocateResponseOld.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectRelocateResponseOld proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("metainfo.Object_Status", Object_Status_name, Object_Status_value)
 	proto.RegisterType((*Bucket)(nil), "metainfo.Bucket")
@@ -3519,200 +3673,209 @@ func init() {
 	proto.RegisterType((*SegmentListItem)(nil), "metainfo.SegmentListItem")
 	proto.RegisterType((*SegmentDownloadRequest)(nil), "metainfo.SegmentDownloadRequest")
 	proto.RegisterType((*SegmentDownloadResponse)(nil), "metainfo.SegmentDownloadResponse")
+	proto.RegisterType((*ObjectRelocateRequestOld)(nil), "metainfo.ObjectRelocateRequestOld")
+	proto.RegisterType((*SegmentRelocationOld)(nil), "metainfo.SegmentRelocationOld")
+	proto.RegisterType((*ObjectRelocateResponseOld)(nil), "metainfo.ObjectRelocateResponseOld")
 }
 
 func init() { proto.RegisterFile("metainfo.proto", fileDescriptor_631e2f30a93cd64e) }
 
 var fileDescriptor_631e2f30a93cd64e = []byte{
-	// 2993 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x1a, 0x4b, 0x6f, 0x24, 0x47,
-	0x39, 0xf3, 0x1e, 0x7f, 0x33, 0x9e, 0x19, 0x97, 0xbd, 0xde, 0xd9, 0xf1, 0x3a, 0xf6, 0xf6, 0x66,
-	0x37, 0x8e, 0x94, 0xcc, 0x46, 0x0e, 0x48, 0x91, 0x92, 0x48, 0xd8, 0x9e, 0xcd, 0x7a, 0x92, 0xf5,
-	0x23, 0xed, 0xbc, 0x88, 0x82, 0x5a, 0x6d, 0x77, 0xd9, 0x6e, 0x76, 0xa6, 0x7b, 0xe8, 0xee, 0x59,
-	0xbc, 0x39, 0x71, 0x40, 0x42, 0x28, 0x1c, 0x38, 0x72, 0xca, 0x05, 0x71, 0xe2, 0x17, 0x44, 0x42,
-	0x5c, 0x41, 0x08, 0x71, 0x08, 0x37, 0x90, 0x02, 0xbf, 0x80, 0x0b, 0x67, 0x24, 0x54, 0xaf, 0xee,
-	0xea, 0xd7, 0x3c, 0xec, 0xf1, 0x4a, 0xb9, 0x75, 0x7f, 0xdf, 0x57, 0x5f, 0x55, 0x7d, 0xef, 0xfa,
-	0xaa, 0xa0, 0xd6, 0xc7, 0x9e, 0x6e, 0x5a, 0xa7, 0x76, 0x7b, 0xe0, 0xd8, 0x9e, 0x8d, 0xca, 0xe2,
-	0xbf, 0xd5, 0xc0, 0xd6, 0x89, 0xf3, 0x6c, 0xe0, 0x99, 0xb6, 0xc5, 0x70, 0x2d, 0x38, 0xb3, 0xcf,
-	0x38, 0x5d, 0x6b, 0xed, 0xcc, 0xb6, 0xcf, 0x7a, 0xf8, 0x01, 0xfd, 0x3b, 0x1e, 0x9e, 0x3e, 0xf0,
-	0xcc, 0x3e, 0x76, 0x3d, 0xbd, 0x3f, 0x10, 0xc4, 0x96, 0x6d, 0x60, 0xfe, 0x5d, 0x1f, 0xd8, 0xa6,
-	0xe5, 0x61, 0xc7, 0x38, 0xe6, 0x80, 0xaa, 0xed, 0x18, 0xd8, 0x71, 0xd9, 0x9f, 0xf2, 0xfb, 0x1c,
-	0x14, 0xb7, 0x87, 0x27, 0x4f, 0xb0, 0x87, 0x10, 0xe4, 0x2d, 0xbd, 0x8f, 0x9b, 0x99, 0xf5, 0xcc,
-	0x46, 0x55, 0xa5, 0xdf, 0xe8, 0x4d, 0xa8, 0x0c, 0x74, 0xef, 0x5c, 0x3b, 0x31, 0x07, 0xe7, 0xd8,
-	0x69, 0x66, 0xd7, 0x33, 0x1b, 0xb5, 0xcd, 0x9b, 0x6d, 0x69, 0x79, 0x3b, 0x14, 0x73, 0x34, 0x34,
-	0x3d, 0xac, 0x02, 0xa1, 0x65, 0x00, 0xb4, 0x03, 0x70, 0xe2, 0x60, 0xdd, 0xc3, 0x86, 0xa6, 0x7b,
-	0xcd, 0xdc, 0x7a, 0x66, 0xa3, 0xb2, 0xd9, 0x6a, 0xb3, 0x95, 0xb7, 0xc5, 0xca, 0xdb, 0x1f, 0x8a,
-	0x95, 0x6f, 0x97, 0xff, 0xfc, 0xed, 0xda, 0x0b, 0xbf, 0xfe, 0xd7, 0x5a, 0x46, 0x9d, 0xe3, 0xe3,
-	0xb6, 0x3c, 0xf4, 0x3a, 0x2c, 0x19, 0xf8, 0x54, 0x1f, 0xf6, 0x3c, 0xcd, 0xc5, 0x67, 0x7d, 0x6c,
-	0x79, 0x9a, 0x6b, 0x7e, 0x81, 0x9b, 0xf9, 0xf5, 0xcc, 0x46, 0x4e, 0x45, 0x1c, 0x77, 0xc4, 0x50,
-	0x47, 0xe6, 0x17, 0x18, 0x7d, 0x02, 0xb7, 0xc4, 0x08, 0x07, 0x1b, 0x43, 0xcb, 0xd0, 0xad, 0x93,
-	0x67, 0x9a, 0x7b, 0x72, 0x8e, 0xfb, 0xb8, 0x59, 0xa0, 0xab, 0x58, 0x69, 0x07, 0x22, 0x51, 0x7d,
-	0x9a, 0x23, 0x4a, 0xa2, 0xde, 0xe4, 0xa3, 0xa3, 0x08, 0x64, 0xc0, 0xaa, 0x60, 0x1c, 0xec, 0x5e,
-	0x1b, 0xe8, 0x8e, 0xde, 0xc7, 0x1e, 0x76, 0xdc, 0x66, 0x91, 0x32, 0x5f, 0x97, 0x65, 0xf3, 0xd0,
-	0xff, 0x3c, 0xf4, 0xe9, 0xd4, 0x15, 0xce, 0x26, 0x09, 0x89, 0x56, 0x01, 0x06, 0xba, 0xe3, 0x59,
-	0xd8, 0xd1, 0x4c, 0xa3, 0x59, 0xa2, 0x9a, 0x98, 0xe3, 0x90, 0xae, 0xa1, 0x98, 0x50, 0x63, 0xca,
-	0x7a, 0x6c, 0xba, 0x5e, 0xd7, 0xc3, 0xfd, 0x44, 0xa5, 0x85, 0x45, 0x9f, 0xbd, 0x94, 0xe8, 0x95,
-	0xff, 0x66, 0x61, 0x91, 0xcd, 0xb5, 0x43, 0x61, 0x2a, 0xfe, 0xc9, 0x10, 0xbb, 0xb3, 0xb6, 0x92,
-	0x34, 0x05, 0xe7, 0x2e, 0xa7, 0xe0, 0xfc, 0x75, 0x2a, 0xb8, 0x30, 0x7b, 0x05, 0x17, 0xa3, 0x0a,
-	0xfe, 0x01, 0x2c, 0x85, 0x85, 0xee, 0x0e, 0x6c, 0xcb, 0xc5, 0x68, 0x03, 0x8a, 0xc7, 0x14, 0x4e,
-	0xe5, 0x5e, 0xd9, 0x6c, 0xb4, 0xfd, 0xd8, 0xc1, 0xe8, 0x55, 0x8e, 0x57, 0xee, 0x43, 0x83, 0x41,
-	0x1e, 0x61, 0x6f, 0x84, 0xce, 0x94, 0x77, 0x60, 0x41, 0xa2, 0x9b, 0x7a, 0x9a, 0x57, 0x84, 0x75,
-	0x74, 0x70, 0x0f, 0x8f, 0xb4, 0x0e, 0x65, 0x59, 0xec, 0x49, 0x90, 0xb2, 0xc9, 0x14, 0x4d, 0xac,
-	0x80, 0x18, 0xb3, 0x60, 0xb0, 0x0c, 0xc5, 0x93, 0xa1, 0xe3, 0xda, 0x0e, 0x67, 0xc1, 0xff, 0xd0,
-	0x12, 0x14, 0x7a, 0x66, 0xdf, 0x64, 0xe6, 0x5c, 0x50, 0xd9, 0x0f, 0xba, 0x0d, 0x73, 0x86, 0xe9,
-	0xe0, 0x13, 0x22, 0x64, 0x6a, 0x33, 0x05, 0x35, 0x00, 0x28, 0x9f, 0x02, 0x92, 0x27, 0xe0, 0x7b,
-	0x6c, 0x43, 0xc1, 0xf4, 0x70, 0xdf, 0x6d, 0x66, 0xd6, 0x73, 0x1b, 0x95, 0xcd, 0x66, 0x74, 0x8b,
-	0xc2, 0xb5, 0x54, 0x46, 0x46, 0xb6, 0xd4, 0xb7, 0x1d, 0x4c, 0x27, 0x2e, 0xab, 0xf4, 0x5b, 0x39,
-	0x84, 0x15, 0x46, 0x7c, 0x84, 0xbd, 0x2d, 0xcf, 0x73, 0xcc, 0xe3, 0x21, 0x99, 0x71, 0x94, 0x8f,
-	0x84, 0x15, 0x9f, 0x8d, 0x2a, 0xfe, 0x45, 0xb8, 0x9d, 0xcc, 0x91, 0x0b, 0xeb, 0xe7, 0x19, 0x58,
-	0xdc, 0x32, 0x0c, 0x07, 0xbb, 0x2e, 0x36, 0x0e, 0x48, 0x04, 0x7f, 0x4c, 0x25, 0xb0, 0x21, 0xe4,
-	0xc2, 0x14, 0x86, 0xda, 0x3c, 0xba, 0x07, 0x24, 0x42, 0x56, 0x3b, 0xb0, 0xe4, 0x7a, 0xb6, 0xa3,
-	0x9f, 0x61, 0x8d, 0xa4, 0x07, 0x4d, 0x67, 0xdc, 0x78, 0x7c, 0x58, 0x68, 0xd3, 0x9c, 0xb1, 0x6f,
-	0x1b, 0x98, 0x4f, 0xa3, 0x22, 0x4e, 0x2e, 0xc1, 0x94, 0xaf, 0xb2, 0xb0, 0xcc, 0xbd, 0xf1, 0x13,
-	0xc7, 0xf4, 0xf5, 0x7e, 0xd0, 0x33, 0x88, 0xe6, 0x24, 0xdb, 0xa9, 0x0a, 0x4b, 0x21, 0xc2, 0x20,
-	0x0e, 0xcf, 0xb7, 0x4c, 0xbf, 0x51, 0x13, 0x4a, 0xdc, 0xdd, 0xb9, 0xa7, 0x8b, 0x5f, 0xf4, 0x16,
-	0x40, 0xe0, 0xd6, 0x93, 0xf8, 0xb3, 0x44, 0x8e, 0xde, 0x82, 0x56, 0x5f, 0xbf, 0x10, 0xee, 0x8b,
-	0x8d, 0x70, 0x4c, 0x29, 0xd0, 0x99, 0x6e, 0xf6, 0xf5, 0x8b, 0x87, 0x82, 0x40, 0x0e, 0x2c, 0x1d,
-	0x00, 0x7c, 0x31, 0x30, 0x1d, 0x9d, 0x1a, 0x53, 0x71, 0x8a, 0xa8, 0x29, 0x8d, 0x53, 0xbe, 0xc9,
-	0xc0, 0xcd, 0xb0, 0x80, 0x98, 0x02, 0x89, 0x84, 0x76, 0xa1, 0xa1, 0x0b, 0x15, 0x6a, 0x54, 0x29,
-	0xc2, 0x08, 0x57, 0x03, 0x23, 0x4c, 0x50, 0xb2, 0x5a, 0xf7, 0x87, 0xd1, 0x7f, 0x17, 0xbd, 0x01,
-	0xf3, 0x8e, 0x6d, 0x7b, 0xda, 0xc0, 0xc4, 0x27, 0xd8, 0xb7, 0xa7, 0xed, 0x3a, 0x59, 0xd2, 0x3f,
-	0xbe, 0x5d, 0x2b, 0x1d, 0x12, 0x78, 0xb7, 0xa3, 0x56, 0x08, 0x15, 0xfb, 0x31, 0x68, 0x94, 0x76,
-	0xcc, 0xa7, 0xba, 0x87, 0xb5, 0x27, 0xf8, 0x19, 0x15, 0x7c, 0x75, 0xfb, 0x26, 0x1f, 0x52, 0xa7,
-	0x54, 0x87, 0x0c, 0xff, 0x3e, 0x7e, 0xa6, 0xc2, 0xc0, 0xff, 0x56, 0xfe, 0x12, 0x6c, 0x6a, 0xc7,
-	0xee, 0x93, 0x15, 0xcd, 0x5a, 0xed, 0xaf, 0x42, 0x89, 0xeb, 0x98, 0xeb, 0x1c, 0x49, 0x3a, 0x3f,
-	0x64, 0x5f, 0xaa, 0x20, 0x41, 0x6f, 0x41, 0xdd, 0x76, 0xcc, 0x33, 0xd3, 0xd2, 0x7b, 0x42, 0x8e,
-	0x05, 0x2a, 0xc7, 0x24, 0xf3, 0xaf, 0x09, 0x52, 0x26, 0x3b, 0x65, 0x17, 0x9a, 0x91, 0xbd, 0x04,
-	0x1a, 0x92, 0x96, 0x91, 0x19, 0xbb, 0x0c, 0x45, 0x87, 0x5b, 0x9c, 0x53, 0xc7, 0xfe, 0xa9, 0xd5,
-	0xb3, 0x75, 0x63, 0xd6, 0x72, 0x51, 0xfe, 0x96, 0x81, 0x56, 0x6c, 0x8e, 0xeb, 0xb0, 0x28, 0x69,
-	0xe7, 0xd9, 0xf1, 0x0a, 0xb8, 0xbc, 0x29, 0xfd, 0x08, 0x6e, 0xf0, 0xfd, 0x74, 0xad, 0x53, 0x7b,
-	0xe6, 0xf2, 0x7a, 0xd7, 0x0f, 0x4f, 0x8c, 0x7d, 0xa2, 0x6a, 0xc7, 0x6f, 0x50, 0xd1, 0x7c, 0x83,
-	0x0f, 0xe5, 0xb7, 0xd9, 0x2d, 0xf4, 0xab, 0x8c, 0x6f, 0x86, 0xe1, 0xb4, 0x38, 0x5b, 0xb5, 0x46,
-	0x14, 0x95, 0x9d, 0x5c, 0x51, 0xff, 0xcc, 0xc0, 0x32, 0x49, 0x85, 0x7c, 0x91, 0xee, 0x04, 0x12,
-	0x58, 0x86, 0xe2, 0xc0, 0xc1, 0xa7, 0xe6, 0x05, 0x97, 0x01, 0xff, 0x43, 0x6b, 0x50, 0x71, 0x3d,
-	0xdd, 0xf1, 0x34, 0xfd, 0x94, 0x88, 0x9f, 0x5a, 0x8b, 0x0a, 0x14, 0xb4, 0x45, 0x20, 0x24, 0x37,
-	0x62, 0xcb, 0xd0, 0x8e, 0xf1, 0x29, 0x49, 0xb4, 0x79, 0x96, 0x1b, 0xb1, 0x65, 0x6c, 0x53, 0x00,
-	0xc9, 0xf2, 0x0e, 0x26, 0x75, 0x80, 0xf9, 0x94, 0x45, 0xf1, 0xb2, 0x1a, 0x00, 0x82, 0xca, 0xa0,
-	0x28, 0x57, 0x06, 0xab, 0x00, 0x44, 0x52, 0xda, 0x69, 0x4f, 0x3f, 0x73, 0x69, 0x21, 0x5d, 0x52,
-	0xe7, 0x08, 0xe4, 0x5d, 0x02, 0xa0, 0x61, 0x3a, 0xbc, 0xbb, 0x40, 0xfa, 0x6f, 0x87, 0x0b, 0x84,
-	0xfb, 0x81, 0xc8, 0x53, 0x46, 0xb4, 0xc7, 0x94, 0x0b, 0x2d, 0x0c, 0x79, 0x51, 0xac, 0x53, 0x13,
-	0xc9, 0x48, 0x26, 0x32, 0x9d, 0xe3, 0xad, 0xc0, 0x9c, 0xe9, 0x6a, 0x5c, 0xca, 0x39, 0x3a, 0x45,
-	0xd9, 0x74, 0x0f, 0xe9, 0xbf, 0xf2, 0x19, 0x31, 0xa9, 0x84, 0x7a, 0x84, 0x6c, 0x6a, 0x0d, 0x2a,
-	0x4c, 0x4b, 0x9a, 0x54, 0x99, 0x00, 0x03, 0xed, 0x4f, 0x50, 0x9f, 0xac, 0x90, 0x58, 0x97, 0x54,
-	0x99, 0x1c, 0xf4, 0x0c, 0x65, 0x09, 0xd0, 0xa1, 0x63, 0xff, 0x18, 0x9f, 0xc8, 0x4e, 0xad, 0xbc,
-	0x09, 0x8b, 0x21, 0x28, 0xaf, 0xbf, 0xee, 0x40, 0x75, 0xc0, 0xc0, 0x9a, 0xab, 0xf7, 0x84, 0x0d,
-	0x55, 0x38, 0xec, 0x48, 0xef, 0x79, 0xca, 0x2f, 0x4b, 0x50, 0x3c, 0x38, 0x26, 0xbf, 0xa9, 0xb6,
-	0x76, 0x0f, 0x6a, 0x41, 0x9a, 0x97, 0xfc, 0x6e, 0xde, 0x87, 0x1e, 0x72, 0x07, 0x7c, 0x8a, 0x1d,
-	0x37, 0x28, 0x0f, 0xc5, 0x2f, 0x7a, 0x00, 0x45, 0xd7, 0xd3, 0xbd, 0xa1, 0x4b, 0xed, 0x8d, 0x1c,
-	0x57, 0x7c, 0x35, 0xb3, 0xa9, 0xdb, 0x47, 0x14, 0xad, 0x72, 0x32, 0xf4, 0x1a, 0xcc, 0xb9, 0x9e,
-	0x83, 0xf5, 0x3e, 0x91, 0x4f, 0x81, 0x3a, 0x52, 0x83, 0x3b, 0x52, 0xf9, 0x88, 0x22, 0xba, 0x1d,
-	0xb5, 0xcc, 0x48, 0xba, 0x46, 0xe4, 0x10, 0x56, 0xbc, 0xdc, 0xf9, 0x77, 0x8b, 0xcc, 0x49, 0x66,
-	0x27, 0x3c, 0x4a, 0x53, 0xf0, 0x28, 0xb3, 0x61, 0x5b, 0xa4, 0xec, 0x63, 0xe5, 0x09, 0xa6, 0x3c,
-	0xca, 0xd3, 0xac, 0x83, 0x8f, 0xdb, 0xf2, 0xd0, 0x23, 0x68, 0x06, 0xd2, 0x26, 0x72, 0x32, 0x74,
-	0x4f, 0xd7, 0x2c, 0xdb, 0x3a, 0xc1, 0xcd, 0x39, 0x2a, 0x8a, 0x79, 0x2e, 0x8a, 0xc2, 0x3e, 0x01,
-	0xaa, 0xcb, 0x3e, 0xf9, 0x1e, 0xa7, 0xa6, 0x70, 0xf4, 0x1a, 0xa0, 0x38, 0xa3, 0x26, 0x50, 0xd5,
-	0x2d, 0xc4, 0xc6, 0xa0, 0x57, 0x01, 0x9d, 0x9a, 0x17, 0xd1, 0x42, 0xae, 0x42, 0x43, 0x69, 0x83,
-	0x62, 0xe4, 0x0a, 0x6e, 0x17, 0x16, 0xe2, 0x47, 0xc2, 0xea, 0xf8, 0x12, 0xb2, 0xe1, 0x44, 0xcf,
-	0x82, 0x1f, 0xc1, 0x8d, 0xe4, 0x33, 0xe0, 0xfc, 0x84, 0x67, 0xc0, 0x25, 0x9c, 0x72, 0xf8, 0xf3,
-	0x6c, 0x4f, 0xef, 0xb1, 0x6d, 0xd4, 0xe8, 0x36, 0xe6, 0x28, 0x84, 0xae, 0x7f, 0x0d, 0x2a, 0xa6,
-	0xd5, 0x33, 0x2d, 0xcc, 0xf0, 0x75, 0x8a, 0x07, 0x06, 0x12, 0x04, 0x0e, 0xee, 0xdb, 0x1e, 0x27,
-	0x68, 0x30, 0x02, 0x06, 0x22, 0x04, 0xca, 0x07, 0x50, 0x64, 0x56, 0x8b, 0x2a, 0x50, 0xea, 0xee,
-	0x7f, 0xbc, 0xf5, 0xb8, 0xdb, 0x69, 0xbc, 0x80, 0xe6, 0x61, 0xee, 0xa3, 0xc3, 0xc7, 0x07, 0x5b,
-	0x9d, 0xee, 0xfe, 0xa3, 0x46, 0x06, 0xd5, 0x00, 0x76, 0x0e, 0xf6, 0xf6, 0xba, 0x1f, 0x7e, 0x48,
-	0xfe, 0xb3, 0x04, 0xcd, 0xff, 0x1f, 0x76, 0x1a, 0x39, 0x54, 0x85, 0x72, 0xe7, 0xe1, 0xe3, 0x87,
-	0x14, 0x99, 0x57, 0xfe, 0x9e, 0x05, 0xc4, 0x1c, 0x62, 0x1b, 0x9f, 0x99, 0x96, 0x74, 0x4e, 0xbb,
-	0x1e, 0xbf, 0x0c, 0xdb, 0x6b, 0xfe, 0x72, 0xf6, 0x9a, 0x68, 0x09, 0xa5, 0x99, 0x5a, 0x42, 0xf9,
-	0x2a, 0x96, 0xa0, 0xfc, 0x31, 0x0b, 0x8b, 0x21, 0xa9, 0xf2, 0xe0, 0x78, 0x6d, 0x62, 0x0d, 0x45,
-	0xaf, 0xfc, 0xd8, 0xe8, 0x95, 0x28, 0xc0, 0xc2, 0x4c, 0x05, 0x58, 0xbc, 0x92, 0x00, 0xff, 0x90,
-	0x11, 0x02, 0x0c, 0x9d, 0x48, 0xc2, 0xfb, 0xcc, 0x8c, 0xdd, 0xe7, 0xa8, 0xc0, 0x96, 0xbd, 0x7a,
-	0x60, 0xcb, 0xa5, 0x04, 0x36, 0x65, 0x19, 0x96, 0xc2, 0xab, 0xe7, 0xc7, 0xfc, 0x27, 0xd0, 0x60,
-	0x70, 0xa9, 0x7b, 0x73, 0x5d, 0x36, 0xa1, 0xbc, 0x03, 0x0b, 0xd2, 0x64, 0x41, 0x0b, 0xc8, 0xa6,
-	0xc0, 0x78, 0x0b, 0x88, 0x11, 0xab, 0x1c, 0xaf, 0xfc, 0x2c, 0x2b, 0xc6, 0x47, 0x1a, 0x38, 0x89,
-	0xab, 0x7d, 0x05, 0x1a, 0xd2, 0x6a, 0xe5, 0x32, 0xb1, 0x1e, 0xac, 0x97, 0xd5, 0x8b, 0x21, 0x52,
-	0xde, 0x0d, 0xca, 0x45, 0x48, 0x77, 0x58, 0x5b, 0x28, 0x54, 0x1a, 0xe6, 0x53, 0x4b, 0xc3, 0x82,
-	0x5c, 0x1a, 0x76, 0xa1, 0xce, 0x76, 0xa0, 0x99, 0xd6, 0x49, 0x6f, 0x68, 0xe0, 0xc0, 0x16, 0x23,
-	0x5b, 0x15, 0xad, 0xa0, 0x2e, 0xa7, 0x53, 0x6b, 0x6c, 0xa0, 0xf8, 0x57, 0x3e, 0x15, 0xb1, 0x71,
-	0xc2, 0x0e, 0x53, 0x98, 0xed, 0xa8, 0x0e, 0xd3, 0x9f, 0x72, 0x50, 0x0b, 0x53, 0x27, 0xe8, 0x3b,
-	0x33, 0x46, 0xdf, 0xd9, 0xb4, 0x92, 0x27, 0x37, 0x59, 0xc9, 0x13, 0xae, 0x61, 0xf2, 0x33, 0xa8,
-	0x61, 0x0a, 0x33, 0xa8, 0x61, 0x8a, 0xb3, 0xaf, 0x61, 0x4a, 0x57, 0x77, 0xf5, 0x72, 0x9a, 0xab,
-	0x7f, 0x0f, 0x96, 0x93, 0xad, 0x09, 0xb5, 0xa0, 0xec, 0x0f, 0xcf, 0xb0, 0x5a, 0x5e, 0xfc, 0x2b,
-	0x2e, 0x34, 0xa5, 0xfc, 0x10, 0x6e, 0xb2, 0x5e, 0x5b, 0x40, 0x78, 0x0f, 0x6e, 0x25, 0x4c, 0xca,
-	0xad, 0x7a, 0xba, 0xc8, 0x1a, 0xf0, 0x7a, 0xd7, 0xb4, 0x4c, 0xf7, 0x3c, 0xbc, 0x83, 0x29, 0x79,
-	0xdd, 0x86, 0x56, 0x12, 0x2f, 0x1e, 0x33, 0xff, 0x93, 0x85, 0xca, 0x91, 0xee, 0x89, 0x71, 0xd7,
-	0x97, 0x43, 0xaf, 0xd4, 0x9b, 0xec, 0xc2, 0x3c, 0xf5, 0x09, 0x92, 0x05, 0x0d, 0xdd, 0xc3, 0x53,
-	0xb9, 0x42, 0x55, 0x0c, 0xed, 0xe8, 0x1e, 0x46, 0x7b, 0x50, 0x0f, 0x3a, 0x8e, 0x8c, 0xd9, 0x34,
-	0x3e, 0x51, 0x0b, 0x06, 0x53, 0x76, 0x0f, 0x60, 0xd1, 0xd5, 0x3d, 0xdc, 0xeb, 0x99, 0xb4, 0xb0,
-	0x3c, 0xb3, 0x74, 0x6f, 0xe8, 0xf0, 0xba, 0x5e, 0x45, 0x3e, 0xea, 0x48, 0x60, 0x94, 0x7f, 0x67,
-	0xa1, 0xc4, 0xeb, 0xee, 0x69, 0xf3, 0xed, 0xf7, 0xa1, 0x3c, 0xb0, 0x5d, 0xd3, 0x13, 0xd1, 0xa9,
-	0xb2, 0x79, 0x2b, 0x08, 0x42, 0x9c, 0xe7, 0x21, 0x27, 0x50, 0x7d, 0x52, 0xf4, 0x0e, 0x2c, 0x06,
-	0xaa, 0x7b, 0x82, 0x9f, 0x71, 0xb7, 0xcd, 0x25, 0xb9, 0x6d, 0xe0, 0x82, 0xef, 0xe3, 0x67, 0xcc,
-	0x63, 0xef, 0xc2, 0x7c, 0x68, 0x38, 0x6f, 0x31, 0x54, 0x65, 0x4a, 0xd4, 0x86, 0x45, 0x52, 0x55,
-	0x4b, 0xdd, 0x63, 0xea, 0x98, 0xac, 0x6b, 0xbc, 0x40, 0x50, 0x7e, 0xdb, 0xb8, 0x43, 0xce, 0x26,
-	0x9b, 0x7e, 0x61, 0x83, 0x0d, 0x8d, 0xd7, 0xed, 0x74, 0x04, 0xbb, 0xd4, 0x09, 0x16, 0xdc, 0xa5,
-	0x38, 0x3a, 0xe6, 0x65, 0x28, 0xd2, 0x96, 0xad, 0xdb, 0x2c, 0xd1, 0xd4, 0x50, 0x0f, 0x36, 0x4f,
-	0x7b, 0x31, 0x2a, 0x47, 0x2b, 0xbb, 0x50, 0xa0, 0x00, 0x72, 0xe0, 0x67, 0x4d, 0x5e, 0x6b, 0xd8,
-	0xa7, 0xf2, 0x2d, 0xa8, 0x65, 0x0a, 0xd8, 0x1f, 0xf6, 0x91, 0x02, 0x79, 0xcb, 0x36, 0x44, 0xa5,
-	0x52, 0xe3, 0x72, 0x28, 0xee, 0xdb, 0x06, 0xee, 0x76, 0x54, 0x8a, 0x53, 0x76, 0xa1, 0x1e, 0x91,
-	0x2b, 0x39, 0x46, 0x90, 0x83, 0x3d, 0x61, 0x79, 0xcc, 0x3b, 0x9d, 0x05, 0x95, 0x9e, 0xfe, 0xf7,
-	0x29, 0x84, 0xe4, 0x4d, 0xd3, 0x32, 0xf0, 0x85, 0xb8, 0x6c, 0xa1, 0x3f, 0xca, 0x6f, 0x33, 0xb0,
-	0xc8, 0x59, 0x85, 0x8e, 0x02, 0xcf, 0xc7, 0x04, 0xee, 0x43, 0xbd, 0xaf, 0x5f, 0x68, 0xb4, 0xbf,
-	0xcb, 0x7a, 0x62, 0xbc, 0xa5, 0x36, 0xdf, 0xd7, 0x2f, 0x82, 0x16, 0x98, 0xf2, 0xd7, 0x0c, 0x2c,
-	0x85, 0x57, 0xc9, 0xe3, 0xd7, 0xeb, 0x00, 0xe2, 0x14, 0xe9, 0xaf, 0x73, 0x81, 0xaf, 0x73, 0x4e,
-	0x34, 0x0d, 0x3b, 0xea, 0x1c, 0x27, 0xea, 0x26, 0xb7, 0xe1, 0xb2, 0xb3, 0x68, 0xc3, 0x4d, 0xd1,
-	0x2f, 0xfd, 0x5d, 0xd6, 0xdf, 0x4e, 0xb8, 0xd0, 0x9d, 0x7e, 0x3b, 0x29, 0x4e, 0x94, 0xbd, 0xac,
-	0x13, 0xe5, 0x26, 0x77, 0xa2, 0x7c, 0x9a, 0x13, 0x3d, 0x82, 0xf9, 0xe1, 0xa0, 0x67, 0xeb, 0x86,
-	0xe6, 0x60, 0x77, 0xd8, 0xf3, 0x78, 0x1f, 0x5f, 0x89, 0x5b, 0x04, 0x91, 0xd1, 0x47, 0x03, 0xde,
-	0x00, 0x1f, 0xf6, 0x3c, 0xb5, 0x3a, 0x94, 0xfe, 0x94, 0x5f, 0x04, 0xfd, 0xd4, 0x18, 0xe9, 0x68,
-	0x27, 0x7a, 0x19, 0x4a, 0xf4, 0x3e, 0xcc, 0xbf, 0x45, 0x89, 0xfa, 0x51, 0x91, 0xa0, 0xbb, 0x06,
-	0xba, 0x07, 0xf9, 0x73, 0xdd, 0x3d, 0xe7, 0x6f, 0x19, 0x16, 0xc4, 0x55, 0x03, 0x9d, 0x6e, 0x57,
-	0x77, 0xcf, 0x55, 0x8a, 0x56, 0xfe, 0x97, 0x85, 0x2a, 0x49, 0x47, 0x42, 0x05, 0x68, 0x33, 0xea,
-	0x1f, 0x95, 0xcd, 0x1b, 0xd2, 0xfe, 0x82, 0xcc, 0x25, 0x39, 0x49, 0xc4, 0x45, 0xb3, 0xe9, 0x2e,
-	0x9a, 0x93, 0x5c, 0x34, 0x7e, 0x2f, 0x54, 0x98, 0xe0, 0x5e, 0xe8, 0x03, 0xb8, 0xe1, 0xdf, 0xa6,
-	0x48, 0xee, 0x45, 0xaa, 0xe2, 0x09, 0x6c, 0x7d, 0x51, 0x8c, 0x0d, 0x60, 0x6e, 0x3c, 0xd9, 0x95,
-	0x2e, 0x9d, 0xec, 0x52, 0xb2, 0x53, 0x39, 0x35, 0x3b, 0xdd, 0xf4, 0x6f, 0x18, 0x22, 0x67, 0xab,
-	0xdf, 0x64, 0x7d, 0x13, 0xd9, 0xd3, 0x9f, 0x60, 0x16, 0x96, 0x9f, 0x6f, 0x10, 0x7b, 0x1e, 0x79,
-	0x2c, 0x35, 0x2f, 0x15, 0x52, 0xf3, 0x12, 0xeb, 0xee, 0xc6, 0x24, 0xc3, 0xe5, 0x66, 0xfb, 0xc8,
-	0x84, 0x5a, 0x74, 0x25, 0x26, 0xb7, 0x2b, 0x4b, 0x49, 0xf9, 0x26, 0xb8, 0xf4, 0x4a, 0x2a, 0x44,
-	0xbf, 0x9b, 0x81, 0xfc, 0x57, 0xc1, 0xa6, 0x92, 0x2a, 0xe2, 0xe9, 0x37, 0xf5, 0x36, 0x94, 0x58,
-	0xcc, 0x14, 0x7b, 0x49, 0x09, 0x9a, 0xbe, 0xf4, 0x48, 0xd0, 0x14, 0x43, 0x62, 0xf1, 0x52, 0xa6,
-	0x7a, 0xbe, 0xf1, 0x72, 0x15, 0x56, 0x12, 0xe5, 0xc2, 0xad, 0xef, 0xcb, 0x0c, 0x20, 0x8e, 0x97,
-	0xdb, 0x0c, 0x23, 0xed, 0x6e, 0x1b, 0xea, 0xac, 0x6d, 0xa0, 0x4d, 0x6e, 0x7e, 0x35, 0x36, 0xc2,
-	0x2f, 0x92, 0xfc, 0xde, 0x41, 0x4e, 0xea, 0x1d, 0x28, 0x9f, 0xf9, 0x25, 0x50, 0xe8, 0xc4, 0xff,
-	0x20, 0x7c, 0xe2, 0x8f, 0x4f, 0x33, 0xc9, 0x91, 0x3f, 0xa8, 0xd4, 0xfc, 0x23, 0xbf, 0xec, 0x40,
-	0x99, 0xc9, 0x1d, 0xe8, 0xcb, 0x8c, 0x7f, 0x0d, 0x1a, 0xb9, 0x99, 0x9e, 0x36, 0xce, 0xcd, 0x40,
-	0x92, 0xca, 0xd7, 0xb9, 0xe0, 0x32, 0x35, 0x72, 0x87, 0xfd, 0xdd, 0xf4, 0xe5, 0xf4, 0x10, 0x9b,
-	0x4f, 0x2f, 0xfd, 0xef, 0x40, 0x35, 0xe1, 0x35, 0x4a, 0xc5, 0x95, 0xee, 0x2f, 0x52, 0xb2, 0x43,
-	0xf1, 0xb2, 0xd9, 0xa1, 0x94, 0x90, 0x1d, 0x5e, 0x83, 0xbc, 0x85, 0x2f, 0xc4, 0x45, 0xd0, 0x08,
-	0x2d, 0x52, 0xb2, 0xcd, 0xaf, 0x17, 0xa0, 0xbc, 0xc7, 0x49, 0xd0, 0x1e, 0x54, 0xd9, 0xb3, 0x34,
-	0xfe, 0x60, 0x74, 0x35, 0xfa, 0x74, 0x2a, 0xf4, 0x52, 0xb0, 0xf5, 0x62, 0x1a, 0x9a, 0xeb, 0xbe,
-	0x03, 0x73, 0x8f, 0xb0, 0xc7, 0x79, 0xb5, 0xa2, 0xc4, 0x41, 0x03, 0xb4, 0xb5, 0x92, 0x88, 0xe3,
-	0x5c, 0xf6, 0xa0, 0xca, 0x22, 0x46, 0xda, 0xa2, 0x42, 0x71, 0x36, 0xbe, 0xa8, 0x48, 0x72, 0xd9,
-	0x85, 0x0a, 0xf1, 0x3e, 0x86, 0x73, 0xd1, 0x4a, 0xd2, 0xeb, 0x30, 0xc1, 0xeb, 0x76, 0x32, 0x92,
-	0x73, 0xc2, 0xa4, 0x70, 0xe7, 0x8c, 0xa4, 0x7b, 0x53, 0x74, 0x2f, 0x3a, 0x2a, 0xf1, 0xce, 0xb6,
-	0x75, 0x7f, 0x1c, 0x19, 0x9f, 0xe6, 0x3d, 0xa8, 0xd0, 0x24, 0xc9, 0xef, 0x4b, 0x6f, 0x47, 0x7b,
-	0x7a, 0xf2, 0x51, 0xad, 0xb5, 0x9a, 0x82, 0x0d, 0x64, 0xc9, 0x6a, 0x26, 0xce, 0x2c, 0x46, 0x1e,
-	0x3a, 0x82, 0xc8, 0xb2, 0x4c, 0x6a, 0x66, 0x73, 0x05, 0x73, 0x5e, 0xad, 0x28, 0x71, 0xb2, 0x82,
-	0xe3, 0x0d, 0x69, 0xae, 0x11, 0x86, 0x08, 0x69, 0x24, 0xd6, 0x7c, 0x6e, 0xdd, 0x4e, 0x46, 0x72,
-	0x4e, 0x9f, 0xc3, 0x82, 0x54, 0x4f, 0xf0, 0x75, 0x29, 0x89, 0x22, 0x09, 0x1b, 0xcd, 0xdd, 0x91,
-	0x34, 0x9c, 0xbb, 0x06, 0x48, 0x4e, 0x60, 0x9c, 0x7d, 0x6c, 0x68, 0x42, 0xf2, 0x6f, 0xbd, 0x34,
-	0x9a, 0x28, 0xd0, 0x0e, 0x9d, 0x57, 0xb4, 0x5e, 0x56, 0x63, 0xce, 0x1b, 0xd2, 0xf5, 0x8b, 0x69,
-	0x68, 0xce, 0xee, 0x10, 0xe6, 0x99, 0xbe, 0x04, 0xbf, 0xf8, 0x80, 0xb0, 0xba, 0xd7, 0x52, 0xf1,
-	0x81, 0x7c, 0x83, 0xf2, 0x51, 0x70, 0x8d, 0x57, 0x25, 0xb1, 0xe2, 0x5b, 0x96, 0x6f, 0x6a, 0x19,
-	0x4a, 0xe4, 0x2b, 0x89, 0x5d, 0xb0, 0xbf, 0x9b, 0xbc, 0xcb, 0x54, 0xf9, 0x8e, 0xa8, 0x2b, 0x8f,
-	0x61, 0x51, 0x96, 0xbb, 0x98, 0x21, 0x3e, 0x38, 0x49, 0x85, 0xf7, 0xc6, 0x50, 0xf1, 0x39, 0xde,
-	0x87, 0xaa, 0xfc, 0x88, 0x44, 0x76, 0xd7, 0x78, 0x91, 0xd3, 0x5a, 0x4d, 0xc1, 0x72, 0x66, 0x1f,
-	0x43, 0x5d, 0x24, 0x54, 0xb1, 0xd8, 0xf5, 0xd8, 0x88, 0x48, 0x01, 0xd0, 0xba, 0x33, 0x82, 0x82,
-	0xf3, 0xfd, 0x04, 0x1a, 0x2c, 0x54, 0x73, 0x82, 0x83, 0x9e, 0x91, 0xc0, 0x38, 0xf2, 0xfe, 0x33,
-	0x81, 0x71, 0xec, 0x01, 0xe4, 0x0f, 0xa1, 0x11, 0x32, 0x39, 0x02, 0xbb, 0x33, 0xda, 0xea, 0x08,
-	0x67, 0x65, 0x8c, 0xe1, 0x11, 0x36, 0x47, 0x50, 0x93, 0x1e, 0x7e, 0xd1, 0x17, 0x2f, 0xb1, 0x51,
-	0xe1, 0x17, 0x67, 0xad, 0xf5, 0x14, 0x82, 0x80, 0xa9, 0x06, 0x28, 0x22, 0x60, 0x02, 0xbd, 0x3b,
-	0x4e, 0xc6, 0x84, 0xf9, 0x4b, 0x63, 0xc5, 0xcc, 0x05, 0x12, 0x32, 0xb6, 0x64, 0x81, 0x44, 0x9f,
-	0xa0, 0x25, 0x08, 0x24, 0xfe, 0x86, 0xec, 0x63, 0xa8, 0xcb, 0x96, 0x16, 0xd1, 0x61, 0xf2, 0xcb,
-	0x2e, 0x59, 0x87, 0x69, 0xaf, 0xa3, 0x3e, 0x87, 0x85, 0x70, 0x26, 0x22, 0xc0, 0xd0, 0x82, 0x92,
-	0x5f, 0x20, 0x85, 0x9d, 0x3c, 0xe5, 0x25, 0x11, 0xc9, 0x66, 0xd2, 0x9b, 0x21, 0xd9, 0x3d, 0xe2,
-	0x0f, 0x8c, 0x64, 0xf7, 0x48, 0x78, 0x68, 0xb4, 0x9d, 0xff, 0x2c, 0x3b, 0x38, 0x3e, 0x2e, 0xd2,
-	0x5e, 0xc3, 0x1b, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x22, 0x4e, 0x8c, 0x7a, 0x6d, 0x33, 0x00,
-	0x00,
+	// 3104 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd5, 0x1a, 0xc9, 0x6e, 0x1b, 0xc9,
+	0x75, 0xb8, 0x93, 0x8f, 0x14, 0x49, 0xb5, 0x64, 0x99, 0xa6, 0x2c, 0x2f, 0xed, 0xf1, 0x32, 0x40,
+	0x46, 0x1e, 0x68, 0x12, 0x60, 0x90, 0xcc, 0x00, 0xd1, 0xe2, 0x85, 0x63, 0x5b, 0xd6, 0xb4, 0xec,
+	0x71, 0x62, 0x24, 0x20, 0x5a, 0x62, 0x49, 0xee, 0x98, 0x64, 0x33, 0xdd, 0x4d, 0x8f, 0x9d, 0x53,
+	0x0e, 0x01, 0x82, 0x20, 0x39, 0xe4, 0x96, 0x9c, 0xe6, 0x12, 0xe4, 0x94, 0x2f, 0x08, 0x10, 0xcc,
+	0x35, 0x39, 0x04, 0x39, 0x24, 0xb7, 0x04, 0x98, 0xe4, 0x0b, 0x72, 0x99, 0x73, 0x80, 0xd4, 0xf2,
+	0xaa, 0xbb, 0x7a, 0x13, 0x45, 0x99, 0x32, 0x30, 0x17, 0x89, 0xfd, 0xde, 0xab, 0x57, 0x55, 0x6f,
+	0xaf, 0x57, 0x05, 0xf5, 0x01, 0xf1, 0x4c, 0x6b, 0x78, 0x60, 0xaf, 0x8e, 0x1c, 0xdb, 0xb3, 0xb5,
+	0xb2, 0xfc, 0x6e, 0x37, 0xc9, 0x70, 0xdf, 0x79, 0x35, 0xf2, 0x2c, 0x7b, 0x28, 0x70, 0x6d, 0x38,
+	0xb4, 0x0f, 0x91, 0xae, 0x7d, 0xf1, 0xd0, 0xb6, 0x0f, 0xfb, 0xe4, 0x26, 0xff, 0xda, 0x1b, 0x1f,
+	0xdc, 0xf4, 0xac, 0x01, 0x71, 0x3d, 0x73, 0x30, 0x92, 0xc4, 0x43, 0xbb, 0x47, 0xf0, 0x77, 0x63,
+	0x64, 0x5b, 0x43, 0x8f, 0x38, 0xbd, 0x3d, 0x04, 0xd4, 0x6c, 0xa7, 0x47, 0x1c, 0x57, 0x7c, 0xe9,
+	0x7f, 0xc8, 0x41, 0x71, 0x63, 0xbc, 0xff, 0x9c, 0x78, 0x9a, 0x06, 0xf9, 0xa1, 0x39, 0x20, 0xad,
+	0xcc, 0xa5, 0xcc, 0x8d, 0x9a, 0xc1, 0x7f, 0x6b, 0x1f, 0x40, 0x75, 0x64, 0x7a, 0xcf, 0xba, 0xfb,
+	0xd6, 0xe8, 0x19, 0x71, 0x5a, 0x59, 0x8a, 0xaa, 0xaf, 0x9d, 0x5d, 0x55, 0x96, 0xb7, 0xc9, 0x31,
+	0xbb, 0x63, 0xcb, 0x23, 0x06, 0x30, 0x5a, 0x01, 0xd0, 0x36, 0x01, 0xf6, 0x1d, 0x62, 0x7a, 0xa4,
+	0xd7, 0x35, 0xbd, 0x56, 0x8e, 0x0e, 0xac, 0xae, 0xb5, 0x57, 0xc5, 0xca, 0x57, 0xe5, 0xca, 0x57,
+	0x1f, 0xc9, 0x95, 0x6f, 0x94, 0xff, 0xf2, 0xe5, 0xc5, 0xb7, 0x7e, 0xfd, 0xef, 0x8b, 0x19, 0xa3,
+	0x82, 0xe3, 0xd6, 0x3d, 0xed, 0x3d, 0x58, 0xec, 0x91, 0x03, 0x73, 0xdc, 0xf7, 0xba, 0x2e, 0x39,
+	0x1c, 0x90, 0x21, 0xfd, 0x6f, 0xfd, 0x84, 0xb4, 0xf2, 0x94, 0x5d, 0xce, 0xd0, 0x10, 0xb7, 0x2b,
+	0x50, 0xbb, 0x14, 0xa3, 0x3d, 0x81, 0x73, 0x72, 0x84, 0x43, 0x7a, 0xe3, 0x61, 0xcf, 0x1c, 0xee,
+	0xbf, 0xea, 0xba, 0xfb, 0xcf, 0x08, 0xdd, 0x59, 0x81, 0xaf, 0x62, 0x79, 0x35, 0x10, 0x89, 0xe1,
+	0xd3, 0xec, 0x72, 0x12, 0xe3, 0x2c, 0x8e, 0x8e, 0x22, 0xb4, 0x1e, 0xac, 0x48, 0xc6, 0xc1, 0xee,
+	0xbb, 0x23, 0xd3, 0xa1, 0x62, 0xa2, 0xbc, 0xdc, 0x56, 0x91, 0x33, 0xbf, 0xa4, 0xca, 0xe6, 0x96,
+	0xff, 0x73, 0xc7, 0xa7, 0x33, 0x96, 0x91, 0x4d, 0x12, 0x52, 0x5b, 0x01, 0x2a, 0x43, 0xc7, 0x1b,
+	0x12, 0xa7, 0x6b, 0xf5, 0x5a, 0x25, 0xae, 0x89, 0x0a, 0x42, 0x3a, 0x3d, 0xdd, 0x82, 0xba, 0x50,
+	0xd6, 0x7d, 0xcb, 0xf5, 0x3a, 0x1e, 0x19, 0x24, 0x2a, 0x2d, 0x2c, 0xfa, 0xec, 0x89, 0x44, 0xaf,
+	0x7f, 0x95, 0x85, 0x05, 0x31, 0xd7, 0x26, 0x87, 0x19, 0xe4, 0xc7, 0x63, 0x4a, 0x3f, 0x63, 0x2b,
+	0x49, 0x53, 0x70, 0xee, 0x64, 0x0a, 0xce, 0x9f, 0xa6, 0x82, 0x0b, 0xb3, 0x57, 0x70, 0x31, 0xaa,
+	0xe0, 0xef, 0xc2, 0x62, 0x58, 0xe8, 0xee, 0xc8, 0x1e, 0xba, 0x44, 0xbb, 0x01, 0xc5, 0x3d, 0x0e,
+	0xe7, 0x72, 0xaf, 0xae, 0x35, 0x57, 0xfd, 0xd8, 0x21, 0xe8, 0x0d, 0xc4, 0xeb, 0xd7, 0xa0, 0x29,
+	0x20, 0x77, 0x28, 0x30, 0x5d, 0x67, 0xfa, 0x47, 0x30, 0xaf, 0xd0, 0x4d, 0x3d, 0xcd, 0x3b, 0xd2,
+	0x3a, 0xb6, 0x48, 0x9f, 0x1c, 0x69, 0x1d, 0xfa, 0x92, 0xdc, 0x93, 0x24, 0x15, 0x93, 0xe9, 0x5d,
+	0xb9, 0x02, 0x66, 0xcc, 0x92, 0xc1, 0x12, 0x14, 0xf7, 0xc7, 0x8e, 0x6b, 0x3b, 0xc8, 0x02, 0xbf,
+	0xb4, 0x45, 0x28, 0xf4, 0xad, 0x81, 0x25, 0xcc, 0xb9, 0x60, 0x88, 0x0f, 0xed, 0x3c, 0x54, 0x7a,
+	0x96, 0x43, 0xf6, 0x99, 0x90, 0xb9, 0xcd, 0x14, 0x8c, 0x00, 0xa0, 0x7f, 0x0f, 0x34, 0x75, 0x02,
+	0xdc, 0xe3, 0x2a, 0x14, 0xa8, 0x15, 0x0e, 0x5c, 0x3a, 0x41, 0x8e, 0x6e, 0xb1, 0x15, 0xdd, 0xa2,
+	0x74, 0x2d, 0x43, 0x90, 0xb1, 0x2d, 0x0d, 0x6c, 0x87, 0xf0, 0x89, 0xcb, 0x06, 0xff, 0xad, 0xef,
+	0xc0, 0xb2, 0x20, 0xde, 0x25, 0xde, 0xba, 0xe7, 0x39, 0xd6, 0xde, 0x98, 0xcd, 0x78, 0x94, 0x8f,
+	0x84, 0x15, 0x9f, 0x8d, 0x2a, 0xfe, 0x02, 0x9c, 0x4f, 0xe6, 0x88, 0xc2, 0xfa, 0x59, 0x06, 0x16,
+	0xd6, 0x7b, 0x3d, 0x87, 0xb8, 0x2e, 0xe9, 0x3d, 0x64, 0x11, 0xfc, 0x3e, 0x97, 0xc0, 0x0d, 0x29,
+	0x17, 0xa1, 0x30, 0x6d, 0x15, 0xa3, 0x7b, 0x40, 0x22, 0x65, 0xb5, 0x09, 0x8b, 0xae, 0x67, 0x3b,
+	0xe6, 0x21, 0xe9, 0xb2, 0xf4, 0xd0, 0x35, 0x05, 0x37, 0x8c, 0x0f, 0xf3, 0xab, 0x3c, 0x67, 0x6c,
+	0xd3, 0x3f, 0x38, 0x8d, 0xa1, 0x21, 0xb9, 0x02, 0xd3, 0x3f, 0xcf, 0xc2, 0x12, 0x7a, 0xe3, 0x13,
+	0xc7, 0xf2, 0xf5, 0xfe, 0xb0, 0xdf, 0x63, 0x9a, 0x53, 0x6c, 0xa7, 0x26, 0x2d, 0x85, 0x09, 0x83,
+	0x39, 0x3c, 0x6e, 0x99, 0xff, 0xd6, 0x5a, 0x50, 0x42, 0x77, 0x47, 0x4f, 0x97, 0x9f, 0xda, 0x77,
+	0x00, 0x02, 0xb7, 0x3e, 0x8e, 0x3f, 0x2b, 0xe4, 0x74, 0x70, 0x7b, 0x60, 0xbe, 0x94, 0xee, 0x4b,
+	0xc3, 0x5f, 0x28, 0xa6, 0x14, 0xf8, 0x4c, 0x67, 0x29, 0xc5, 0x2d, 0x49, 0xa0, 0x06, 0x96, 0x2d,
+	0x00, 0xf2, 0x72, 0x64, 0x39, 0x26, 0x37, 0xa6, 0xe2, 0x14, 0x51, 0x53, 0x19, 0xa7, 0xff, 0x3d,
+	0x03, 0x67, 0xc3, 0x02, 0x12, 0x0a, 0x64, 0x12, 0xba, 0x0b, 0x4d, 0x53, 0xaa, 0xb0, 0xcb, 0x95,
+	0x22, 0x8d, 0x70, 0x25, 0x30, 0xc2, 0x04, 0x25, 0x1b, 0x0d, 0x7f, 0x18, 0xff, 0x76, 0xb5, 0xf7,
+	0x61, 0xce, 0xb1, 0x6d, 0xaf, 0x3b, 0xb2, 0xc8, 0x3e, 0xf1, 0xed, 0x69, 0xa3, 0xc1, 0x96, 0xf4,
+	0xcf, 0x2f, 0x2f, 0x96, 0x76, 0x18, 0xbc, 0xb3, 0x65, 0x54, 0x19, 0x95, 0xf8, 0xe8, 0xf1, 0x28,
+	0xed, 0x58, 0x2f, 0x68, 0x58, 0xe9, 0x3e, 0x27, 0xaf, 0xb8, 0xe0, 0x6b, 0x1b, 0x67, 0x71, 0x48,
+	0x83, 0x53, 0xed, 0x08, 0xfc, 0x3d, 0xf2, 0x8a, 0x46, 0x69, 0xff, 0xb7, 0xfe, 0x55, 0xb0, 0xa9,
+	0x4d, 0x7b, 0xc0, 0x56, 0x34, 0x6b, 0xb5, 0x7f, 0x03, 0x4a, 0xa8, 0x63, 0xd4, 0xb9, 0xa6, 0xe8,
+	0x7c, 0x47, 0xfc, 0x32, 0x24, 0x09, 0xd5, 0x73, 0xc3, 0x76, 0xac, 0x43, 0x6b, 0x68, 0xf6, 0xa5,
+	0x1c, 0x0b, 0x5c, 0x8e, 0x49, 0xe6, 0x5f, 0x97, 0xa4, 0x28, 0xbb, 0xeb, 0xd0, 0xb0, 0x7a, 0x64,
+	0x30, 0xb2, 0x3d, 0xc2, 0x32, 0x07, 0x13, 0x85, 0x08, 0xc3, 0x75, 0x05, 0xcc, 0x76, 0x7d, 0x17,
+	0x5a, 0x91, 0x4d, 0x07, 0xaa, 0x54, 0xd6, 0x9b, 0x99, 0xb8, 0x5e, 0xdd, 0x84, 0x73, 0xc8, 0x69,
+	0xcb, 0xfe, 0x6c, 0xd8, 0xb7, 0xcd, 0xde, 0xac, 0x05, 0xa8, 0xff, 0x2d, 0x03, 0xed, 0xd8, 0x1c,
+	0xa7, 0x61, 0x7a, 0xca, 0xce, 0xb3, 0x93, 0x35, 0x75, 0x72, 0x9b, 0xfb, 0x21, 0x9c, 0xc1, 0xfd,
+	0x74, 0xe8, 0xda, 0x66, 0x2e, 0xaf, 0xdb, 0x7e, 0x1c, 0x13, 0xec, 0x13, 0x55, 0x3b, 0x79, 0x83,
+	0x34, 0x89, 0x49, 0xcf, 0x08, 0x25, 0xc2, 0xd9, 0x2d, 0xf4, 0xf3, 0x8c, 0x6f, 0x86, 0xe1, 0xfc,
+	0x39, 0x5b, 0xb5, 0x46, 0x14, 0x95, 0x3d, 0xbe, 0xa2, 0xfe, 0x95, 0x81, 0x25, 0x96, 0x33, 0x71,
+	0x91, 0xee, 0x31, 0x24, 0x40, 0xe1, 0x23, 0x87, 0x1c, 0x58, 0x2f, 0x51, 0x06, 0xf8, 0xa5, 0x5d,
+	0x84, 0x2a, 0x8d, 0xad, 0x8e, 0xd7, 0x35, 0x0f, 0x98, 0xf8, 0xb9, 0xb5, 0x18, 0xc0, 0x41, 0xeb,
+	0x0c, 0xc2, 0x92, 0x28, 0x19, 0xf6, 0xba, 0x7b, 0xe4, 0x80, 0x65, 0xe4, 0xbc, 0x48, 0xa2, 0x14,
+	0xb2, 0xc1, 0x01, 0xac, 0x1c, 0xa0, 0xb9, 0x9f, 0x16, 0x0c, 0xd6, 0x0b, 0x11, 0xee, 0xcb, 0x46,
+	0x00, 0x08, 0x4a, 0x88, 0xa2, 0x5a, 0x42, 0x50, 0x96, 0x4c, 0x52, 0xdd, 0x83, 0xbe, 0x79, 0xe8,
+	0xf2, 0x8a, 0xbb, 0x64, 0x54, 0x18, 0xe4, 0x36, 0x03, 0xf0, 0x78, 0x1e, 0xde, 0x5d, 0x20, 0xfd,
+	0x0f, 0xc3, 0x95, 0xc4, 0xb5, 0x40, 0xe4, 0x29, 0x23, 0x56, 0x27, 0xd4, 0x15, 0x6d, 0x02, 0x79,
+	0x59, 0xd5, 0x73, 0x13, 0xc9, 0x28, 0x26, 0x32, 0x9d, 0xe3, 0x2d, 0x43, 0xc5, 0x72, 0xbb, 0x28,
+	0xe5, 0x1c, 0x9f, 0xa2, 0x6c, 0xb9, 0x3b, 0xfc, 0x5b, 0x7f, 0xca, 0x4c, 0x2a, 0xa1, 0x70, 0x61,
+	0x9b, 0xa2, 0x3a, 0x10, 0x5a, 0xea, 0x2a, 0x25, 0x0c, 0x08, 0xd0, 0xf6, 0x31, 0x0a, 0x99, 0x65,
+	0x16, 0xeb, 0x92, 0x4a, 0x18, 0xca, 0x5c, 0x5f, 0x04, 0x6d, 0xc7, 0xb1, 0x7f, 0x44, 0xeb, 0x33,
+	0xc5, 0xa9, 0xf5, 0x0f, 0x60, 0x21, 0x04, 0xc5, 0x42, 0xed, 0x32, 0xd4, 0x46, 0x02, 0xdc, 0x75,
+	0xcd, 0xbe, 0xb4, 0xa1, 0x2a, 0xc2, 0x76, 0x29, 0x48, 0xff, 0x45, 0x09, 0x8a, 0x0f, 0xf7, 0xd8,
+	0x67, 0xaa, 0xad, 0x5d, 0x85, 0x7a, 0x50, 0x0f, 0x28, 0x7e, 0x37, 0xe7, 0x43, 0x77, 0xd0, 0x01,
+	0x5f, 0xd0, 0xc4, 0x11, 0xd4, 0x91, 0xf2, 0x53, 0xbb, 0x09, 0x45, 0x6a, 0x81, 0xde, 0xd8, 0xe5,
+	0xf6, 0xc6, 0xce, 0x35, 0xbe, 0x9a, 0xc5, 0xd4, 0xab, 0xbb, 0x1c, 0x6d, 0x20, 0x99, 0xf6, 0x2e,
+	0x54, 0x5c, 0x8f, 0x96, 0xef, 0x03, 0x26, 0x9f, 0x02, 0x77, 0xa4, 0x26, 0x3a, 0x52, 0x79, 0x97,
+	0x23, 0x68, 0x66, 0x2e, 0x0b, 0x12, 0x9a, 0x96, 0xc3, 0xa7, 0xb5, 0xe2, 0xc9, 0x0e, 0xca, 0xeb,
+	0x6c, 0x4e, 0x36, 0x3b, 0xe3, 0x51, 0x9a, 0x82, 0x47, 0x59, 0x0c, 0x5b, 0x67, 0xf5, 0xa1, 0xa8,
+	0x63, 0x08, 0xe7, 0x51, 0x9e, 0x66, 0x1d, 0x38, 0x8e, 0x32, 0xb9, 0x03, 0xad, 0x40, 0xda, 0x4c,
+	0x4e, 0x3d, 0x93, 0xfa, 0xd6, 0xd0, 0x1e, 0xee, 0x93, 0x56, 0x85, 0x8b, 0x62, 0x0e, 0x45, 0x51,
+	0xd8, 0x66, 0x40, 0x63, 0xc9, 0x27, 0x7f, 0x80, 0xd4, 0x1c, 0x4e, 0x85, 0xa8, 0xc5, 0x19, 0xb5,
+	0x80, 0xab, 0x6e, 0x3e, 0x36, 0x86, 0x3a, 0x87, 0x46, 0x0d, 0x3b, 0x5a, 0xf1, 0x55, 0x79, 0x28,
+	0x6d, 0x72, 0x8c, 0x5a, 0xea, 0xdd, 0x85, 0xf9, 0xf8, 0xd9, 0xb1, 0x36, 0xb9, 0xd6, 0x6c, 0x3a,
+	0xd1, 0x43, 0xe3, 0x63, 0x38, 0x93, 0x7c, 0x58, 0x9c, 0x3b, 0xe6, 0x61, 0x71, 0x91, 0xa4, 0x9c,
+	0x12, 0x3d, 0xdb, 0xa3, 0xd5, 0x0d, 0xdf, 0x46, 0x9d, 0x6f, 0xa3, 0xc2, 0x21, 0x7c, 0xfd, 0xd4,
+	0x47, 0xad, 0x61, 0xdf, 0x1a, 0x12, 0x81, 0x6f, 0x70, 0x3c, 0x08, 0x90, 0x24, 0x70, 0xc8, 0x80,
+	0x96, 0x32, 0x82, 0xa0, 0x29, 0x08, 0x04, 0x88, 0x11, 0xe8, 0x9f, 0x40, 0x51, 0x58, 0xad, 0x56,
+	0x85, 0x52, 0x67, 0xfb, 0xd3, 0xf5, 0xfb, 0x9d, 0xad, 0xe6, 0x5b, 0xda, 0x1c, 0x54, 0x1e, 0xef,
+	0xdc, 0x7f, 0xb8, 0xbe, 0xd5, 0xd9, 0xbe, 0xd3, 0xcc, 0x68, 0x75, 0x80, 0xcd, 0x87, 0x0f, 0x1e,
+	0x74, 0x1e, 0x3d, 0x62, 0xdf, 0x59, 0x86, 0xc6, 0xef, 0x5b, 0x5b, 0xcd, 0x9c, 0x56, 0x83, 0xf2,
+	0xd6, 0xad, 0xfb, 0xb7, 0x38, 0x32, 0xaf, 0xff, 0x23, 0x0b, 0x9a, 0x70, 0x88, 0x0d, 0x42, 0xeb,
+	0x2d, 0xe5, 0x40, 0x77, 0x3a, 0x7e, 0x19, 0xb6, 0xd7, 0xfc, 0xc9, 0xec, 0x35, 0xd1, 0x12, 0x4a,
+	0x33, 0xb5, 0x84, 0xf2, 0xeb, 0x58, 0x82, 0xfe, 0x45, 0x16, 0x16, 0x42, 0x52, 0xc5, 0xe0, 0x78,
+	0x6a, 0x62, 0x0d, 0x45, 0xaf, 0xfc, 0xc4, 0xe8, 0x95, 0x28, 0xc0, 0xc2, 0x4c, 0x05, 0x58, 0x7c,
+	0x2d, 0x01, 0xfe, 0x29, 0x23, 0x05, 0x18, 0x3a, 0xba, 0x84, 0xf7, 0x99, 0x99, 0xb8, 0xcf, 0xa3,
+	0x02, 0x5b, 0xf6, 0xf5, 0x03, 0x5b, 0x2e, 0x25, 0xb0, 0xb1, 0xe6, 0x49, 0x78, 0xf5, 0xd8, 0x0f,
+	0x78, 0x0e, 0x4d, 0x01, 0x57, 0xda, 0x3c, 0xa7, 0x65, 0x13, 0xac, 0x57, 0xa4, 0x4c, 0x16, 0xf4,
+	0x8a, 0x6c, 0x0e, 0x8c, 0xf7, 0x8a, 0x04, 0xb1, 0x81, 0x78, 0xfd, 0xa7, 0x59, 0x39, 0x3e, 0xd2,
+	0xe9, 0x49, 0x5c, 0xed, 0x3b, 0xd0, 0x54, 0x56, 0xab, 0x96, 0x89, 0x8d, 0x60, 0xbd, 0xa2, 0x5e,
+	0x0c, 0x91, 0x62, 0xdb, 0x28, 0x17, 0x21, 0xdd, 0x14, 0xfd, 0xa3, 0x50, 0x69, 0x98, 0x4f, 0x2d,
+	0x0d, 0x0b, 0x6a, 0x69, 0xd8, 0xa1, 0xc7, 0x4c, 0xbe, 0xec, 0xae, 0x35, 0xdc, 0xef, 0x8f, 0x7b,
+	0x24, 0xb0, 0xc5, 0xc8, 0x56, 0x65, 0xcf, 0xa8, 0x83, 0x74, 0xf4, 0xd0, 0xb9, 0x27, 0xea, 0x19,
+	0xf1, 0xcd, 0x5a, 0x51, 0xaa, 0x04, 0x26, 0xb6, 0xa2, 0xc2, 0x6c, 0x8f, 0x6a, 0x45, 0xfd, 0x39,
+	0x07, 0xf5, 0x30, 0x75, 0x82, 0xbe, 0x33, 0x13, 0xf4, 0x9d, 0x4d, 0x2b, 0x79, 0x72, 0xc7, 0x2b,
+	0x79, 0xc2, 0x35, 0x4c, 0x7e, 0x06, 0x35, 0x4c, 0x61, 0x06, 0x35, 0x4c, 0x71, 0xf6, 0x35, 0x4c,
+	0xe9, 0xf5, 0x5d, 0xbd, 0x9c, 0xe6, 0xea, 0xdf, 0x84, 0xa5, 0x64, 0x6b, 0xd2, 0xda, 0x50, 0xf6,
+	0x87, 0x67, 0x44, 0x2d, 0x2f, 0xbf, 0x75, 0x17, 0x5a, 0x4a, 0x7e, 0x08, 0x77, 0x63, 0x4f, 0x2d,
+	0x20, 0x7c, 0x0c, 0xe7, 0x12, 0x26, 0x45, 0xab, 0x9e, 0x2e, 0xb2, 0x06, 0xbc, 0x6e, 0x5b, 0x43,
+	0xcb, 0x7d, 0x16, 0xde, 0xc1, 0x94, 0xbc, 0xce, 0x43, 0x3b, 0x89, 0x17, 0xc6, 0xcc, 0xff, 0x66,
+	0xa1, 0xba, 0x6b, 0x7a, 0x72, 0xdc, 0xe9, 0xe5, 0xd0, 0xd7, 0x6a, 0x62, 0x76, 0x60, 0x8e, 0xfb,
+	0x04, 0xcb, 0x82, 0x54, 0xc3, 0x64, 0x2a, 0x57, 0xa8, 0xc9, 0xa1, 0x5b, 0x74, 0xa4, 0xf6, 0x00,
+	0x1a, 0x41, 0x6b, 0x52, 0x30, 0x9b, 0xc6, 0x27, 0xea, 0xc1, 0x60, 0xce, 0xee, 0x26, 0x2c, 0xb8,
+	0xf4, 0x7f, 0xbf, 0x6f, 0xf1, 0xc2, 0xf2, 0x70, 0x48, 0xbd, 0xce, 0xc1, 0xba, 0xde, 0xd0, 0x7c,
+	0xd4, 0xae, 0xc4, 0xe8, 0xff, 0xc9, 0x42, 0x09, 0xeb, 0xee, 0x69, 0xf3, 0xed, 0xb7, 0xa0, 0x3c,
+	0xb2, 0x5d, 0xcb, 0x93, 0xd1, 0xa9, 0xba, 0x76, 0x2e, 0x08, 0x42, 0xc8, 0x73, 0x07, 0x09, 0x0c,
+	0x9f, 0x54, 0xfb, 0x08, 0x16, 0x02, 0xd5, 0x3d, 0x27, 0xaf, 0xd0, 0x6d, 0x73, 0x49, 0x6e, 0x1b,
+	0xb8, 0xe0, 0x3d, 0xf2, 0x4a, 0x78, 0xec, 0x15, 0x98, 0x0b, 0x0d, 0xc7, 0x16, 0x43, 0x4d, 0xa5,
+	0xa4, 0x51, 0x7b, 0x81, 0x55, 0xd5, 0x4a, 0x9b, 0x99, 0x3b, 0xa6, 0x68, 0x2f, 0xcf, 0x33, 0x94,
+	0xdf, 0x5f, 0xde, 0x62, 0x67, 0x93, 0x35, 0xbf, 0xb0, 0xa1, 0xa4, 0x58, 0xb7, 0xf3, 0x11, 0xa2,
+	0xed, 0x18, 0x2c, 0xb8, 0xc3, 0x71, 0x7c, 0xcc, 0x75, 0x28, 0xf2, 0xde, 0x2e, 0xeb, 0x48, 0xb0,
+	0xd4, 0xd0, 0x08, 0x36, 0xcf, 0x7b, 0x31, 0x06, 0xa2, 0xf5, 0xbb, 0x50, 0xe0, 0x00, 0x76, 0xe0,
+	0x17, 0xdd, 0xe0, 0xe1, 0x78, 0xc0, 0xe5, 0x5b, 0xa0, 0x62, 0x61, 0x80, 0xed, 0xf1, 0x40, 0xd3,
+	0x21, 0xcf, 0xda, 0xfb, 0x58, 0xa9, 0xd4, 0x51, 0x0e, 0x45, 0xd6, 0xd9, 0xa7, 0x52, 0xe7, 0x38,
+	0xca, 0xa9, 0x11, 0x91, 0x2b, 0x3b, 0x46, 0xb0, 0x83, 0x3d, 0x63, 0xb9, 0x87, 0x9d, 0xce, 0x82,
+	0xc1, 0x4f, 0xff, 0xdb, 0x1c, 0xc2, 0xf2, 0xa6, 0x35, 0xec, 0x91, 0x97, 0xf2, 0x56, 0x86, 0x7f,
+	0xe8, 0xbf, 0xa3, 0x25, 0x17, 0xb2, 0x0a, 0x1d, 0x05, 0xde, 0x8c, 0x09, 0x5c, 0x83, 0x06, 0xbb,
+	0x04, 0xe0, 0x8d, 0x60, 0xd1, 0x13, 0xc3, 0x96, 0xda, 0x1c, 0x05, 0x07, 0x2d, 0x30, 0xfd, 0xaf,
+	0x19, 0x58, 0x0c, 0xaf, 0x12, 0xe3, 0xd7, 0x7b, 0x00, 0xf2, 0x14, 0xe9, 0xaf, 0x73, 0x1e, 0xd7,
+	0x59, 0x91, 0x4d, 0xc3, 0x2d, 0xa3, 0x82, 0x44, 0x9d, 0xe4, 0x36, 0x5c, 0x76, 0x16, 0x6d, 0xb8,
+	0x29, 0xfa, 0xa5, 0xbf, 0xcf, 0xfa, 0xdb, 0x09, 0x17, 0xba, 0xd3, 0x6f, 0x27, 0xc5, 0x89, 0xb2,
+	0x27, 0x75, 0xa2, 0xdc, 0xf1, 0x9d, 0x28, 0x9f, 0xe6, 0x44, 0x77, 0x60, 0x6e, 0x3c, 0x62, 0x5d,
+	0xed, 0x2e, 0x95, 0xd7, 0xb8, 0xef, 0x61, 0xc3, 0x5f, 0x8f, 0x5b, 0x04, 0x93, 0xd1, 0xe3, 0x11,
+	0x36, 0xc0, 0xd9, 0x45, 0x6f, 0x6d, 0xac, 0x7c, 0xe9, 0x3f, 0x0f, 0xfa, 0xa9, 0x31, 0xd2, 0xa3,
+	0x9d, 0xe8, 0x3a, 0x94, 0xf8, 0xc5, 0x99, 0x7f, 0xdd, 0x12, 0xf5, 0xa3, 0x22, 0x43, 0x53, 0xf9,
+	0x5d, 0x85, 0xfc, 0x33, 0xd3, 0x7d, 0x86, 0x8f, 0x1e, 0xe6, 0xe5, 0x9d, 0x04, 0x9f, 0xee, 0x2e,
+	0x45, 0x18, 0x1c, 0xad, 0xff, 0x2f, 0x0b, 0x35, 0x96, 0x8e, 0xa4, 0x0a, 0x68, 0xa0, 0x88, 0xf8,
+	0x47, 0x75, 0xed, 0x8c, 0xb2, 0xbf, 0x20, 0x73, 0x29, 0x4e, 0x12, 0x71, 0xd1, 0x6c, 0xba, 0x8b,
+	0xe6, 0x14, 0x17, 0x8d, 0x5f, 0x20, 0x15, 0x8e, 0x71, 0x81, 0xf4, 0x09, 0x9c, 0xf1, 0xaf, 0x5d,
+	0x14, 0xf7, 0x62, 0x55, 0xf1, 0x31, 0x6c, 0x7d, 0x41, 0x8e, 0x0d, 0x60, 0x6e, 0x3c, 0xd9, 0x95,
+	0x4e, 0x9c, 0xec, 0x52, 0xb2, 0x53, 0x39, 0x35, 0x3b, 0x9d, 0xf5, 0x6f, 0x18, 0x22, 0x67, 0xab,
+	0xdf, 0x66, 0x7d, 0x13, 0x79, 0x60, 0x3e, 0x27, 0x22, 0x2c, 0xbf, 0xd9, 0x20, 0xf6, 0x26, 0xf2,
+	0x58, 0x6a, 0x5e, 0x2a, 0xa4, 0xe6, 0x25, 0xd1, 0xdd, 0x8d, 0x49, 0x06, 0xe5, 0x66, 0xfb, 0xc8,
+	0x84, 0x5a, 0x74, 0x39, 0x26, 0xb7, 0xd7, 0x96, 0x12, 0x6b, 0xce, 0xb7, 0x93, 0x66, 0xfc, 0x5a,
+	0x07, 0xf2, 0x5f, 0x05, 0x9b, 0x4a, 0xaa, 0x88, 0xa7, 0xdf, 0xd4, 0x87, 0x50, 0x12, 0x31, 0x53,
+	0xee, 0x25, 0x25, 0x68, 0xfa, 0xd2, 0x63, 0x41, 0x53, 0x0e, 0x89, 0xc5, 0x4b, 0x95, 0xea, 0xcd,
+	0xc6, 0xcb, 0x15, 0x58, 0x4e, 0x94, 0x0b, 0x5a, 0xdf, 0x2f, 0x33, 0xa0, 0x21, 0x5e, 0x6d, 0x33,
+	0x1c, 0x69, 0x77, 0x1b, 0xd0, 0x10, 0x6d, 0x83, 0xee, 0xf1, 0xcd, 0xaf, 0x2e, 0x46, 0xf8, 0x45,
+	0x92, 0xdf, 0x3b, 0xc8, 0x29, 0xbd, 0x03, 0xfd, 0xa9, 0x5f, 0x02, 0x85, 0x4e, 0xfc, 0x37, 0xc3,
+	0x27, 0xfe, 0xf8, 0x34, 0xc7, 0x39, 0xf2, 0x07, 0x95, 0x9a, 0x7f, 0xe4, 0x57, 0x1d, 0x28, 0x73,
+	0x7c, 0x07, 0xa2, 0x32, 0x5b, 0x4a, 0xbe, 0x99, 0x9e, 0x36, 0xce, 0xcd, 0x40, 0x92, 0xfa, 0x1f,
+	0x73, 0xc1, 0x65, 0x6a, 0xe4, 0x0e, 0xfb, 0xeb, 0xe9, 0xcb, 0xe9, 0x21, 0x36, 0x9f, 0x5e, 0xfa,
+	0x5f, 0x86, 0x5a, 0xc2, 0xb3, 0x95, 0xaa, 0xab, 0xdc, 0x5f, 0xa4, 0x64, 0x87, 0xe2, 0x49, 0xb3,
+	0x43, 0x29, 0x21, 0x3b, 0xbc, 0x4b, 0x8f, 0x0c, 0xe4, 0xa5, 0xbc, 0x08, 0x3a, 0x42, 0x8b, 0x9c,
+	0x8c, 0xb5, 0x59, 0xb1, 0x0f, 0x61, 0x90, 0xbe, 0xbd, 0x6f, 0x9e, 0xf8, 0x26, 0x7c, 0x05, 0x60,
+	0x48, 0x3e, 0xeb, 0x22, 0xbd, 0x28, 0x1d, 0x2b, 0x14, 0x82, 0x8f, 0x54, 0xcf, 0x41, 0x99, 0xa1,
+	0xf9, 0x30, 0x21, 0xc4, 0x12, 0xfd, 0xe6, 0xe7, 0xf1, 0x6f, 0x43, 0x19, 0x85, 0x24, 0x9f, 0x83,
+	0x5c, 0x88, 0xad, 0x1a, 0x17, 0x47, 0xd7, 0x4d, 0xd7, 0x65, 0xf8, 0xf4, 0xfa, 0x7d, 0xbf, 0x78,
+	0x0e, 0x51, 0xa8, 0xf7, 0xf2, 0x99, 0xf0, 0x8b, 0x15, 0xb5, 0x27, 0x23, 0xd6, 0x1f, 0xf4, 0x64,
+	0x96, 0x65, 0x4b, 0x23, 0x90, 0x85, 0x7f, 0x07, 0xba, 0xf6, 0x1b, 0x0d, 0xca, 0x0f, 0x70, 0x59,
+	0xf4, 0x84, 0x5e, 0x13, 0x2f, 0xfd, 0x70, 0x7b, 0x2b, 0xd1, 0xd7, 0x68, 0xa1, 0xc7, 0x97, 0xed,
+	0x0b, 0x69, 0x68, 0xf4, 0x92, 0x2d, 0xa8, 0xdc, 0x21, 0x1e, 0xf2, 0x6a, 0x47, 0x89, 0x83, 0x56,
+	0x71, 0x7b, 0x39, 0x11, 0x87, 0x5c, 0xe8, 0xa2, 0x44, 0x6c, 0x4d, 0x5b, 0x54, 0x28, 0x23, 0xc5,
+	0x17, 0x15, 0x49, 0xc3, 0x77, 0xa1, 0xca, 0xe2, 0x94, 0xc0, 0xb9, 0xda, 0x72, 0xd2, 0x83, 0x3b,
+	0xc9, 0xeb, 0x7c, 0x32, 0x12, 0x39, 0x11, 0xa6, 0x25, 0x64, 0xa4, 0xdc, 0x30, 0x6b, 0x57, 0xa3,
+	0xa3, 0x12, 0x6f, 0xb7, 0xdb, 0xd7, 0x26, 0x91, 0xe1, 0x34, 0x1f, 0x43, 0x95, 0x97, 0x13, 0x78,
+	0xb3, 0x7c, 0x3e, 0xda, 0xfd, 0x54, 0x0f, 0xb5, 0xed, 0x95, 0x14, 0x6c, 0x20, 0x4b, 0x51, 0x5d,
+	0x22, 0xb3, 0x18, 0x79, 0xe8, 0xb0, 0xa6, 0xca, 0x32, 0xa9, 0xed, 0x8f, 0x0a, 0x46, 0x5e, 0xed,
+	0x28, 0x71, 0xb2, 0x82, 0xe3, 0xad, 0x7b, 0xd4, 0x88, 0x40, 0x84, 0x34, 0x12, 0x6b, 0xd3, 0xb7,
+	0xcf, 0x27, 0x23, 0x91, 0xd3, 0x0f, 0x60, 0x5e, 0xa9, 0xbc, 0x70, 0x5d, 0x7a, 0xa2, 0x48, 0xc2,
+	0x46, 0x73, 0xe5, 0x48, 0x1a, 0xe4, 0xde, 0x05, 0x4d, 0x4d, 0xf5, 0xc8, 0x3e, 0x36, 0x34, 0xa1,
+	0x4c, 0x6a, 0xbf, 0x7d, 0x34, 0x51, 0xa0, 0x1d, 0x3e, 0xaf, 0x6c, 0x52, 0xad, 0xc4, 0x02, 0x46,
+	0x48, 0xd7, 0x17, 0xd2, 0xd0, 0xc8, 0x6e, 0x07, 0xe6, 0x84, 0xbe, 0x24, 0xbf, 0xf8, 0x80, 0xb0,
+	0xba, 0x2f, 0xa6, 0xe2, 0x03, 0xf9, 0x06, 0x85, 0xb6, 0xe4, 0x1a, 0xaf, 0xdf, 0x62, 0xc7, 0x14,
+	0x55, 0xbe, 0xa9, 0x05, 0x3b, 0x93, 0xaf, 0x22, 0x76, 0xc9, 0xfe, 0x4a, 0xf2, 0x2e, 0x53, 0xe5,
+	0x7b, 0x44, 0x05, 0xbe, 0x07, 0x0b, 0xaa, 0xdc, 0xe5, 0x0c, 0xf1, 0xc1, 0x49, 0x2a, 0xbc, 0x3a,
+	0x81, 0x0a, 0xe7, 0xb8, 0x07, 0x35, 0xf5, 0xb9, 0x8d, 0xea, 0xae, 0xf1, 0x72, 0xb0, 0xbd, 0x92,
+	0x82, 0x45, 0x66, 0x9f, 0x42, 0x43, 0x96, 0x1e, 0x72, 0xb1, 0x97, 0x62, 0x23, 0x22, 0xa5, 0x52,
+	0xfb, 0xf2, 0x11, 0x14, 0xc8, 0xf7, 0x09, 0x34, 0x45, 0xa8, 0x46, 0x02, 0x96, 0x5b, 0xe2, 0x8c,
+	0x23, 0x4f, 0x6a, 0x13, 0x18, 0xc7, 0xde, 0x94, 0x7e, 0x9f, 0x32, 0x56, 0x4d, 0x8e, 0xc1, 0x2e,
+	0x1f, 0x6d, 0x75, 0x8c, 0xb3, 0x3e, 0xc1, 0xf0, 0x18, 0x9b, 0x5d, 0xa8, 0x2b, 0x4f, 0xe4, 0xf8,
+	0xdb, 0xa0, 0xd8, 0xa8, 0xf0, 0xdb, 0xbc, 0xf6, 0xa5, 0x14, 0x82, 0x80, 0x29, 0x35, 0xb9, 0x88,
+	0x80, 0x19, 0xf4, 0xca, 0x24, 0x19, 0x33, 0xe6, 0x6f, 0x4f, 0x14, 0x33, 0x0a, 0x24, 0x64, 0x6c,
+	0xc9, 0x02, 0x89, 0x3e, 0xd6, 0x4b, 0x10, 0x48, 0xfc, 0xb5, 0x1d, 0x35, 0x0e, 0xd5, 0xd2, 0x22,
+	0x3a, 0x4c, 0x7e, 0x03, 0xa7, 0xea, 0x30, 0xed, 0x1d, 0x19, 0x75, 0xf2, 0x70, 0x26, 0x62, 0xc0,
+	0xd0, 0x82, 0x92, 0xdf, 0x6a, 0x85, 0x9d, 0x3c, 0xe5, 0xcd, 0x15, 0xe3, 0x2e, 0xcb, 0x10, 0x11,
+	0x09, 0x23, 0xdc, 0xd3, 0xaa, 0xb6, 0x78, 0x88, 0x4e, 0xa8, 0x66, 0x58, 0xae, 0x54, 0xde, 0x6e,
+	0xa9, 0xce, 0x17, 0x7f, 0xe8, 0xa5, 0x3a, 0x5f, 0xc2, 0x83, 0xaf, 0x8d, 0xfc, 0xd3, 0xec, 0x68,
+	0x6f, 0xaf, 0xc8, 0x7b, 0x3e, 0xef, 0xff, 0x1f, 0xaf, 0x7b, 0x3e, 0xed, 0x1e, 0x35, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3754,6 +3917,7 @@ type MetainfoClient interface {
 	DeleteSegmentOld(ctx context.Context, in *SegmentDeleteRequestOld, opts ...grpc.CallOption) (*SegmentDeleteResponseOld, error)
 	ListSegmentsOld(ctx context.Context, in *ListSegmentsRequestOld, opts ...grpc.CallOption) (*ListSegmentsResponseOld, error)
 	SetAttributionOld(ctx context.Context, in *SetAttributionRequestOld, opts ...grpc.CallOption) (*SetAttributionResponseOld, error)
+	RelocateObjectOld(ctx context.Context, in *ObjectRelocateRequestOld, opts ...grpc.CallOption) (*ObjectRelocateResponseOld, error)
 	ProjectInfo(ctx context.Context, in *ProjectInfoRequest, opts ...grpc.CallOption) (*ProjectInfoResponse, error)
 }
 
@@ -3990,6 +4154,15 @@ func (c *metainfoClient) SetAttributionOld(ctx context.Context, in *SetAttributi
 	return out, nil
 }
 
+func (c *metainfoClient) RelocateObjectOld(ctx context.Context, in *ObjectRelocateRequestOld, opts ...grpc.CallOption) (*ObjectRelocateResponseOld, error) {
+	out := new(ObjectRelocateResponseOld)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/RelocateObjectOld", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *metainfoClient) ProjectInfo(ctx context.Context, in *ProjectInfoRequest, opts ...grpc.CallOption) (*ProjectInfoResponse, error) {
 	out := new(ProjectInfoResponse)
 	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/ProjectInfo", in, out, opts...)
@@ -4028,6 +4201,7 @@ type MetainfoServer interface {
 	DeleteSegmentOld(context.Context, *SegmentDeleteRequestOld) (*SegmentDeleteResponseOld, error)
 	ListSegmentsOld(context.Context, *ListSegmentsRequestOld) (*ListSegmentsResponseOld, error)
 	SetAttributionOld(context.Context, *SetAttributionRequestOld) (*SetAttributionResponseOld, error)
+	RelocateObjectOld(context.Context, *ObjectRelocateRequestOld) (*ObjectRelocateResponseOld, error)
 	ProjectInfo(context.Context, *ProjectInfoRequest) (*ProjectInfoResponse, error)
 }
 
@@ -4485,6 +4659,24 @@ func _Metainfo_SetAttributionOld_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Metainfo_RelocateObjectOld_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectRelocateRequestOld)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).RelocateObjectOld(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/RelocateObjectOld",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).RelocateObjectOld(ctx, req.(*ObjectRelocateRequestOld))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Metainfo_ProjectInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ProjectInfoRequest)
 	if err := dec(in); err != nil {
@@ -4607,6 +4799,10 @@ var _Metainfo_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetAttributionOld",
 			Handler:    _Metainfo_SetAttributionOld_Handler,
 		},
+		{
+			MethodName: "RelocateObjectOld",
+			Handler:    _Metainfo_RelocateObjectOld_Handler,
+		},
 		{
 			MethodName: "ProjectInfo",
 			Handler:    _Metainfo_ProjectInfo_Handler,
@@ -381,6 +381,7 @@ func (m *PieceDownloadResponse_Chunk) GetData() []byte {
 
 type PieceDeleteRequest struct {
 	Limit                *OrderLimit `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Acknowledge          bool        `protobuf:"varint,2,opt,name=acknowledge,proto3" json:"acknowledge,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
 	XXX_sizecache        int32       `json:"-"`
@@ -417,10 +418,18 @@ func (m *PieceDeleteRequest) GetLimit() *OrderLimit {
 	return nil
 }
 
+func (m *PieceDeleteRequest) GetAcknowledge() bool {
+	if m != nil {
+		return m.Acknowledge
+	}
+	return false
+}
+
 type PieceDeleteResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Acknowledgment       *PieceDeletionAcknowledgment `protobuf:"bytes,1,opt,name=acknowledgment,proto3" json:"acknowledgment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
 }
 
 func (m *PieceDeleteResponse) Reset()         { *m = PieceDeleteResponse{} }
@@ -447,6 +456,62 @@ func (m *PieceDeleteResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PieceDeleteResponse proto.InternalMessageInfo
 
+func (m *PieceDeleteResponse) GetAcknowledgment() *PieceDeletionAcknowledgment {
+	if m != nil {
+		return m.Acknowledgment
+	}
+	return nil
+}
+
+type PieceDeletionAcknowledgment struct {
+	SatelliteId          NodeID    `protobuf:"bytes,1,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	StorageNodeId        NodeID    `protobuf:"bytes,2,opt,name=storage_node_id,json=storageNodeId,proto3,customtype=NodeID" json:"storage_node_id"`
+	PieceId              PieceID   `protobuf:"bytes,3,opt,name=piece_id,json=pieceId,proto3,customtype=PieceID" json:"piece_id"`
+	DeletedAt            time.Time `protobuf:"bytes,4,opt,name=deleted_at,json=deletedAt,proto3,stdtime" json:"deleted_at"`
+	Signature            []byte    `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *PieceDeletionAcknowledgment) Reset()         { *m = PieceDeletionAcknowledgment{} }
+func (m *PieceDeletionAcknowledgment) String() string { return proto.CompactTextString(m) }
+func (*PieceDeletionAcknowledgment) ProtoMessage()    {}
+func (*PieceDeletionAcknowledgment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23ff32dd550c2439, []int{6}
+}
+func (m *PieceDeletionAcknowledgment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PieceDeletionAcknowledgment.Unmarshal(m, b)
+}
+func (m *PieceDeletionAcknowledgment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PieceDeletionAcknowledgment.Marshal(b, m, deterministic)
+}
+func (m *PieceDeletionAcknowledgment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PieceDeletionAcknowledgment.Merge(m, src)
+}
+func (m *PieceDeletionAcknowledgment) XXX_Size() int {
+	return xxx_messageInfo_PieceDeletionAcknowledgment.Size(m)
+}
+func (m *PieceDeletionAcknowledgment) XXX_DiscardUnknown() {
+	xxx_messageInfo_PieceDeletionAcknowledgment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PieceDeletionAcknowledgment proto.InternalMessageInfo
+
+func (m *PieceDeletionAcknowledgment) GetDeletedAt() time.Time {
+	if m != nil {
+		return m.DeletedAt
+	}
+	return time.Time{}
+}
+
+func (m *PieceDeletionAcknowledgment) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
 type RetainRequest struct {
 	CreationDate         time.Time `protobuf:"bytes,1,opt,name=creation_date,json=creationDate,proto3,stdtime" json:"creation_date"`
 	Filter               []byte    `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
@@ -459,7 +524,7 @@ func (m *RetainRequest) Reset()         { *m = RetainRequest{} }
 func (m *RetainRequest) String() string { return proto.CompactTextString(m) }
 func (*RetainRequest) ProtoMessage()    {}
 func (*RetainRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23ff32dd550c2439, []int{6}
+	return fileDescriptor_23ff32dd550c2439, []int{7}
 }
 func (m *RetainRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RetainRequest.Unmarshal(m, b)
@@ -503,7 +568,7 @@ func (m *RetainResponse) Reset()         { *m = RetainResponse{} }
 func (m *RetainResponse) String() string { return proto.CompactTextString(m) }
 func (*RetainResponse) ProtoMessage()    {}
 func (*RetainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23ff32dd550c2439, []int{7}
+	return fileDescriptor_23ff32dd550c2439, []int{8}
 }
 func (m *RetainResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RetainResponse.Unmarshal(m, b)
@@ -533,6 +598,7 @@ func init() {
 	proto.RegisterType((*PieceDownloadResponse_Chunk)(nil), "piecestore.PieceDownloadResponse.Chunk")
 	proto.RegisterType((*PieceDeleteRequest)(nil), "piecestore.PieceDeleteRequest")
 	proto.RegisterType((*PieceDeleteResponse)(nil), "piecestore.PieceDeleteResponse")
+	proto.RegisterType((*PieceDeletionAcknowledgment)(nil), "piecestore.PieceDeletionAcknowledgment")
 	proto.RegisterType((*RetainRequest)(nil), "piecestore.RetainRequest")
 	proto.RegisterType((*RetainResponse)(nil), "piecestore.RetainResponse")
 }
@@ -540,40 +606,49 @@ func init() {
 func init() { proto.RegisterFile("piecestore2.proto", fileDescriptor_23ff32dd550c2439) }
 
 var fileDescriptor_23ff32dd550c2439 = []byte{
-	// 513 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0xcd, 0x6e, 0x13, 0x3d,
-	0x14, 0xcd, 0xe4, 0x67, 0xd4, 0xef, 0x7e, 0x09, 0xa2, 0x86, 0xa2, 0x60, 0x09, 0x52, 0x86, 0xbf,
-	0xac, 0xa6, 0x28, 0xdd, 0xa1, 0x52, 0xa4, 0x92, 0x05, 0x08, 0x10, 0x95, 0xa1, 0x1b, 0x36, 0x95,
-	0x93, 0xb9, 0x49, 0x2d, 0x26, 0xe3, 0x61, 0xec, 0x08, 0xa9, 0xaf, 0xc0, 0x86, 0x47, 0x62, 0xc9,
-	0x53, 0xc0, 0x82, 0xc7, 0x60, 0x83, 0xc6, 0x3f, 0x2d, 0x93, 0x86, 0x44, 0x20, 0xb1, 0x9a, 0xb1,
-	0xef, 0x39, 0xf7, 0x1e, 0x9f, 0x63, 0xc3, 0x66, 0x2e, 0x70, 0x8c, 0x4a, 0xcb, 0x02, 0x07, 0x71,
-	0x5e, 0x48, 0x2d, 0x09, 0x9c, 0x6f, 0x51, 0x98, 0xca, 0xa9, 0xb4, 0xfb, 0xb4, 0x37, 0x95, 0x72,
-	0x9a, 0xe2, 0x8e, 0x59, 0x8d, 0xe6, 0x93, 0x1d, 0x2d, 0x66, 0xa8, 0x34, 0x9f, 0xe5, 0x0e, 0xd0,
-	0x96, 0x45, 0x82, 0x85, 0xb2, 0xab, 0xe8, 0x47, 0x00, 0xe4, 0xb0, 0xec, 0x74, 0x94, 0xa7, 0x92,
-	0x27, 0x0c, 0xdf, 0xcf, 0x51, 0x69, 0xd2, 0x87, 0x56, 0x2a, 0x66, 0x42, 0x77, 0x83, 0xed, 0xa0,
-	0xff, 0xff, 0x80, 0xc4, 0x8e, 0xf4, 0xaa, 0xfc, 0xbc, 0x28, 0x2b, 0xcc, 0x02, 0xc8, 0x6d, 0x68,
-	0x99, 0x5a, 0xb7, 0x6e, 0x90, 0x9d, 0x0a, 0x92, 0xd9, 0x1a, 0x79, 0x08, 0xad, 0xf1, 0xc9, 0x3c,
-	0x7b, 0xd7, 0x6d, 0x18, 0xd0, 0x9d, 0xf8, 0x5c, 0x7c, 0x7c, 0x71, 0x7a, 0xfc, 0xa4, 0xc4, 0x32,
-	0x4b, 0x21, 0x77, 0xa1, 0x99, 0xc8, 0x0c, 0xbb, 0x4d, 0x43, 0xdd, 0xf4, 0xfd, 0x0d, 0xed, 0x29,
-	0x57, 0x27, 0xcc, 0x94, 0xe9, 0x2e, 0xb4, 0x0c, 0x8d, 0x5c, 0x83, 0x50, 0x4e, 0x26, 0x0a, 0xad,
-	0xf6, 0x06, 0x73, 0x2b, 0x42, 0xa0, 0x99, 0x70, 0xcd, 0x8d, 0xce, 0x36, 0x33, 0xff, 0xd1, 0x1e,
-	0x5c, 0xa9, 0x8c, 0x57, 0xb9, 0xcc, 0x14, 0x9e, 0x8d, 0x0c, 0x56, 0x8e, 0x8c, 0xbe, 0x07, 0x70,
-	0xd5, 0xec, 0x0d, 0xe5, 0x87, 0xec, 0x1f, 0xba, 0xb7, 0x57, 0x75, 0xef, 0xde, 0x05, 0xf7, 0x16,
-	0xe6, 0x57, 0xfc, 0xa3, 0xfb, 0xeb, 0x8c, 0xb9, 0x01, 0x60, 0x90, 0xc7, 0x4a, 0x9c, 0xa2, 0x11,
-	0xd2, 0x60, 0xff, 0x99, 0x9d, 0xd7, 0xe2, 0x14, 0xa3, 0x8f, 0x01, 0x6c, 0x2d, 0x4c, 0x71, 0x36,
-	0x3d, 0xf2, 0xba, 0xec, 0x31, 0xef, 0xaf, 0xd0, 0x65, 0x19, 0x55, 0x61, 0x7f, 0x95, 0xd8, 0xbe,
-	0xbb, 0xae, 0x43, 0x4c, 0x51, 0xe3, 0x1f, 0x1b, 0x1e, 0x6d, 0xb9, 0xc4, 0x3d, 0xdf, 0x0a, 0x8b,
-	0x0a, 0xe8, 0x30, 0xd4, 0x5c, 0x64, 0xbe, 0xe3, 0x33, 0xe8, 0x8c, 0x0b, 0xe4, 0x5a, 0xc8, 0xec,
-	0x38, 0xe1, 0xda, 0xdf, 0x05, 0x1a, 0xdb, 0xe7, 0x15, 0xfb, 0xe7, 0x15, 0xbf, 0xf1, 0xcf, 0xeb,
-	0x60, 0xe3, 0xcb, 0xd7, 0x5e, 0xed, 0xd3, 0xb7, 0x5e, 0xc0, 0xda, 0x9e, 0x3a, 0xe4, 0x1a, 0xcb,
-	0xe3, 0x4d, 0x44, 0xaa, 0x5d, 0xc8, 0x6d, 0xe6, 0x56, 0xd1, 0x65, 0xb8, 0xe4, 0x67, 0x5a, 0x15,
-	0x83, 0xcf, 0x75, 0x80, 0xc3, 0x33, 0x0f, 0xc9, 0x4b, 0x08, 0xed, 0xc5, 0x24, 0x37, 0x57, 0x3f,
-	0x18, 0xda, 0xfb, 0x6d, 0xdd, 0x9d, 0xaf, 0xd6, 0x0f, 0xc8, 0x11, 0x6c, 0xf8, 0x40, 0xc8, 0xf6,
-	0xba, 0x3b, 0x44, 0x6f, 0xad, 0x4d, 0xb3, 0x6c, 0xfa, 0x20, 0x20, 0xcf, 0x21, 0xb4, 0x66, 0x2e,
-	0x51, 0x59, 0x49, 0x69, 0x89, 0xca, 0x85, 0x14, 0x6a, 0xe4, 0x31, 0x84, 0xd6, 0x13, 0x72, 0xfd,
-	0x57, 0x70, 0x25, 0x1b, 0x4a, 0x97, 0x95, 0x6c, 0x8b, 0x83, 0xe6, 0xdb, 0x7a, 0x3e, 0x1a, 0x85,
-	0x26, 0x9e, 0xdd, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x1c, 0xd5, 0x7e, 0x72, 0x38, 0x05, 0x00,
-	0x00,
+	// 665 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4d, 0x53, 0x13, 0x4d,
+	0x10, 0x66, 0xf3, 0x45, 0x68, 0x12, 0x78, 0x19, 0x5e, 0xad, 0xb8, 0x7e, 0x04, 0xd7, 0x2f, 0xca,
+	0xc3, 0xa2, 0x50, 0xe5, 0xc1, 0x42, 0x2d, 0x20, 0x07, 0x53, 0x7e, 0x40, 0x8d, 0x72, 0xf1, 0x12,
+	0x87, 0x4c, 0x67, 0xd9, 0x62, 0xb3, 0x13, 0x77, 0x27, 0x45, 0x15, 0x7f, 0xc1, 0x8b, 0x37, 0xff,
+	0x8e, 0x47, 0x7f, 0x83, 0x07, 0x3c, 0xf8, 0x33, 0xbc, 0x58, 0xdb, 0xb3, 0x4b, 0x58, 0x3e, 0x82,
+	0x5a, 0xe5, 0x29, 0x99, 0xee, 0xe7, 0xe9, 0x7e, 0xe6, 0xe9, 0xed, 0x81, 0xb9, 0x81, 0x8f, 0x5d,
+	0x8c, 0xb5, 0x8a, 0x70, 0xd9, 0x1d, 0x44, 0x4a, 0x2b, 0x06, 0xa3, 0x90, 0x0d, 0x9e, 0xf2, 0x94,
+	0x89, 0xdb, 0x4d, 0x4f, 0x29, 0x2f, 0xc0, 0x25, 0x3a, 0xed, 0x0c, 0x7b, 0x4b, 0xda, 0xef, 0x63,
+	0xac, 0x45, 0x7f, 0x90, 0x02, 0x6a, 0x2a, 0x92, 0x18, 0xc5, 0xe6, 0xe4, 0xfc, 0xb4, 0x80, 0x6d,
+	0x25, 0x95, 0xb6, 0x07, 0x81, 0x12, 0x92, 0xe3, 0x87, 0x21, 0xc6, 0x9a, 0x2d, 0x42, 0x39, 0xf0,
+	0xfb, 0xbe, 0x6e, 0x58, 0x0b, 0xd6, 0xe2, 0xf4, 0x32, 0x73, 0x53, 0xd2, 0x66, 0xf2, 0xf3, 0x32,
+	0xc9, 0x70, 0x03, 0x60, 0xb7, 0xa0, 0x4c, 0xb9, 0x46, 0x81, 0x90, 0xf5, 0x1c, 0x92, 0x9b, 0x1c,
+	0x7b, 0x0c, 0xe5, 0xee, 0xee, 0x30, 0xdc, 0x6b, 0x14, 0x09, 0x74, 0xdb, 0x1d, 0x89, 0x77, 0x4f,
+	0x77, 0x77, 0x37, 0x12, 0x2c, 0x37, 0x14, 0x76, 0x07, 0x4a, 0x52, 0x85, 0xd8, 0x28, 0x11, 0x75,
+	0x2e, 0xab, 0x4f, 0xb4, 0xe7, 0x22, 0xde, 0xe5, 0x94, 0xb6, 0x57, 0xa0, 0x4c, 0x34, 0x76, 0x19,
+	0x2a, 0xaa, 0xd7, 0x8b, 0xd1, 0x68, 0x2f, 0xf2, 0xf4, 0xc4, 0x18, 0x94, 0xa4, 0xd0, 0x82, 0x74,
+	0xd6, 0x38, 0xfd, 0x77, 0x56, 0x61, 0x3e, 0xd7, 0x3e, 0x1e, 0xa8, 0x30, 0xc6, 0xa3, 0x96, 0xd6,
+	0xd8, 0x96, 0xce, 0x0f, 0x0b, 0xfe, 0xa7, 0x58, 0x4b, 0xed, 0x87, 0xff, 0xd0, 0xbd, 0xd5, 0xbc,
+	0x7b, 0x77, 0x4f, 0xb9, 0x77, 0xa2, 0x7f, 0xce, 0x3f, 0xfb, 0xe9, 0x45, 0xc6, 0x5c, 0x07, 0x20,
+	0x64, 0x27, 0xf6, 0x0f, 0x90, 0x84, 0x14, 0xf9, 0x14, 0x45, 0xde, 0xf8, 0x07, 0xe8, 0x7c, 0xb4,
+	0xe0, 0xd2, 0x89, 0x2e, 0xa9, 0x4d, 0x4f, 0x32, 0x5d, 0xe6, 0x9a, 0xf7, 0xc6, 0xe8, 0x32, 0x8c,
+	0xbc, 0xb0, 0xbf, 0x9a, 0xd8, 0xfb, 0xf4, 0x73, 0x6d, 0x61, 0x80, 0x1a, 0xff, 0xdc, 0xf0, 0x05,
+	0x98, 0x16, 0xdd, 0xbd, 0x50, 0xed, 0x07, 0x28, 0x3d, 0x73, 0xdb, 0x2a, 0x3f, 0x1e, 0x72, 0x7a,
+	0x30, 0x9f, 0xeb, 0x90, 0x5e, 0x76, 0x13, 0x66, 0x46, 0xa8, 0x3e, 0x86, 0xfa, 0xfc, 0x5b, 0x27,
+	0x44, 0x5f, 0x85, 0x6b, 0x39, 0x38, 0x3f, 0x41, 0x77, 0x3e, 0x17, 0xe0, 0xea, 0x18, 0x3c, 0x7b,
+	0x08, 0xb5, 0x58, 0x68, 0x0c, 0x02, 0x5f, 0x63, 0xc7, 0x97, 0xd4, 0xae, 0xb6, 0x3e, 0xf3, 0xf5,
+	0xb0, 0x39, 0xf1, 0xed, 0xb0, 0x59, 0x79, 0xad, 0x24, 0xb6, 0x5b, 0x7c, 0xfa, 0x08, 0xd3, 0x96,
+	0xec, 0x11, 0xcc, 0x26, 0x3a, 0x84, 0x87, 0x9d, 0x50, 0x49, 0x62, 0x15, 0xce, 0x64, 0xd5, 0x53,
+	0x18, 0x1d, 0x25, 0xbb, 0x0f, 0x55, 0xba, 0x44, 0x42, 0x28, 0x12, 0x61, 0x36, 0x25, 0x4c, 0x92,
+	0xc2, 0x76, 0x8b, 0x4f, 0x12, 0xa0, 0x2d, 0xd9, 0x06, 0x80, 0x24, 0x67, 0x64, 0x47, 0xe8, 0x74,
+	0x29, 0x6d, 0xd7, 0x3c, 0x3a, 0x6e, 0xf6, 0xe8, 0xb8, 0x6f, 0xb3, 0x47, 0x67, 0xbd, 0x9a, 0x54,
+	0xfa, 0xf4, 0xbd, 0x69, 0xf1, 0xa9, 0x94, 0xb7, 0xa6, 0xd9, 0x35, 0x98, 0x8a, 0x7d, 0x2f, 0x14,
+	0x7a, 0x18, 0x61, 0xa3, 0x4c, 0xe3, 0x1d, 0x05, 0x9c, 0x08, 0xea, 0x1c, 0xb5, 0xf0, 0xc3, 0x6c,
+	0xbc, 0x6d, 0xa8, 0x77, 0x23, 0x14, 0x89, 0x49, 0x1d, 0x29, 0x74, 0xb6, 0x98, 0xbf, 0xd7, 0xb6,
+	0x96, 0x51, 0x5b, 0x42, 0x63, 0xf2, 0xad, 0xf5, 0xfc, 0x40, 0xa7, 0x1b, 0x57, 0xe3, 0xe9, 0xc9,
+	0xf9, 0x0f, 0x66, 0xb2, 0x9e, 0x66, 0xe0, 0xcb, 0x5f, 0x0a, 0x00, 0x5b, 0x47, 0xa3, 0x65, 0xaf,
+	0xa0, 0x62, 0x5e, 0x09, 0x76, 0x63, 0xfc, 0xeb, 0x65, 0x37, 0xcf, 0xcd, 0x9b, 0xca, 0xce, 0xc4,
+	0xa2, 0xc5, 0xb6, 0xa1, 0x9a, 0x6d, 0x07, 0x5b, 0xb8, 0x68, 0xa1, 0xed, 0x9b, 0x17, 0xae, 0x56,
+	0x52, 0xf4, 0x81, 0xc5, 0x5e, 0x40, 0xc5, 0x7c, 0xb7, 0x67, 0xa8, 0xcc, 0xad, 0x8c, 0xdd, 0x3c,
+	0x37, 0x9f, 0x15, 0x64, 0xcf, 0xa0, 0x62, 0x3c, 0x61, 0x57, 0x8e, 0x83, 0x73, 0xb3, 0xb1, 0xed,
+	0xb3, 0x52, 0xa6, 0xc4, 0x7a, 0xe9, 0x5d, 0x61, 0xb0, 0xb3, 0x53, 0xa1, 0xf1, 0xac, 0xfc, 0x1a,
+	0x00, 0xc5, 0xe9, 0xc8, 0xa7, 0xc5, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -71,6 +71,7 @@ type StreamInfo struct {
 	SegmentsSize         int64    `protobuf:"varint,2,opt,name=segments_size,json=segmentsSize,proto3" json:"segments_size,omitempty"`
 	LastSegmentSize      int64    `protobuf:"varint,3,opt,name=last_segment_size,json=lastSegmentSize,proto3" json:"last_segment_size,omitempty"`
 	Metadata             []byte   `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	SegmentSizes         []int64  `protobuf:"varint,5,rep,packed,name=segment_sizes,json=segmentSizes,proto3" json:"segment_sizes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -128,6 +129,13 @@ func (m *StreamInfo) GetMetadata() []byte {
 	return nil
 }
 
+func (m *StreamInfo) GetSegmentSizes() []int64 {
+	if m != nil {
+		return m.SegmentSizes
+	}
+	return nil
+}
+
 type StreamMeta struct {
 	EncryptedStreamInfo  []byte       `protobuf:"bytes,1,opt,name=encrypted_stream_info,json=encryptedStreamInfo,proto3" json:"encrypted_stream_info,omitempty"`
 	EncryptionType       int32        `protobuf:"varint,2,opt,name=encryption_type,json=encryptionType,proto3" json:"encryption_type,omitempty"`
@@ -199,24 +207,25 @@ func init() {
 func init() { proto.RegisterFile("streams.proto", fileDescriptor_c6bbf8af0ec331d6) }
 
 var fileDescriptor_c6bbf8af0ec331d6 = []byte{
-	// 304 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x51, 0xcb, 0x4e, 0xc3, 0x30,
-	0x10, 0x54, 0x5f, 0x50, 0xb6, 0x29, 0x05, 0x03, 0x52, 0x04, 0x17, 0x14, 0x0e, 0x20, 0x84, 0x7a,
-	0x28, 0x3f, 0x80, 0x7a, 0x43, 0x08, 0x2a, 0x25, 0x9c, 0xb8, 0x58, 0x4e, 0xba, 0x41, 0x51, 0x1a,
-	0x3b, 0x8a, 0xcd, 0xc1, 0xfd, 0x21, 0x3e, 0x8b, 0x5f, 0x41, 0xb6, 0xf3, 0x82, 0xe3, 0xce, 0x8c,
-	0x66, 0x67, 0x76, 0x61, 0x2e, 0x55, 0x85, 0xac, 0x90, 0xcb, 0xb2, 0x12, 0x4a, 0x90, 0xc3, 0x7a,
-	0x0c, 0x36, 0x30, 0x8b, 0xf0, 0xb3, 0x40, 0xae, 0x5e, 0x51, 0x31, 0x72, 0x03, 0x73, 0xe4, 0x49,
-	0xa5, 0x4b, 0x85, 0x5b, 0x9a, 0xa3, 0xf6, 0x07, 0xd7, 0x83, 0x3b, 0x2f, 0xf4, 0x5a, 0xf0, 0x05,
-	0x35, 0xb9, 0x82, 0xa3, 0x1c, 0x35, 0xe5, 0x82, 0x27, 0xe8, 0x0f, 0xad, 0x60, 0x9a, 0xa3, 0x7e,
-	0x33, 0x73, 0xf0, 0x3d, 0x00, 0x88, 0xac, 0xf9, 0x33, 0x4f, 0x05, 0x79, 0x00, 0xc2, 0xbf, 0x8a,
-	0x18, 0x2b, 0x2a, 0x52, 0x2a, 0xdd, 0x26, 0x69, 0x5d, 0x47, 0xe1, 0x89, 0x63, 0x36, 0x69, 0x9d,
-	0x40, 0x9a, 0xf5, 0x8d, 0x86, 0xca, 0x6c, 0xef, 0xdc, 0x47, 0xa1, 0xd7, 0x80, 0x51, 0xb6, 0x47,
-	0x72, 0x0f, 0xa7, 0x3b, 0x26, 0x55, 0xe3, 0xe6, 0x84, 0x23, 0x2b, 0x5c, 0x18, 0xa2, 0x76, 0xb3,
-	0xda, 0x4b, 0x98, 0x16, 0xa8, 0xd8, 0x96, 0x29, 0xe6, 0x8f, 0x5d, 0xd2, 0x66, 0x0e, 0x7e, 0xda,
-	0xa4, 0xb6, 0xfa, 0x0a, 0x2e, 0xba, 0xea, 0xee, 0x3c, 0x34, 0xe3, 0xa9, 0xa8, 0x4f, 0x70, 0xd6,
-	0x92, 0xbd, 0x76, 0xb7, 0xb0, 0xa8, 0xe1, 0x4c, 0x70, 0xaa, 0x74, 0xe9, 0x12, 0x4f, 0xc2, 0xe3,
-	0x0e, 0x7e, 0xd7, 0x25, 0xf6, 0xcc, 0x8d, 0x30, 0xde, 0x89, 0x24, 0xef, 0x72, 0x4f, 0x5a, 0xf3,
-	0x4c, 0xf0, 0xb5, 0xe1, 0x6c, 0xf6, 0xa7, 0x7f, 0x3d, 0x4d, 0x70, 0x5b, 0x62, 0xb6, 0x3a, 0x5f,
-	0x36, 0xef, 0xec, 0x3d, 0xef, 0x4f, 0x7b, 0x03, 0xac, 0xc7, 0x1f, 0xc3, 0x32, 0x8e, 0x0f, 0xec,
-	0xcb, 0x1f, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xdf, 0x5e, 0x32, 0x31, 0x03, 0x02, 0x00, 0x00,
+	// 311 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5d, 0x52, 0x4b, 0x4e, 0xc3, 0x30,
+	0x14, 0x54, 0x9a, 0x06, 0xca, 0x6b, 0x4a, 0xc1, 0x80, 0x14, 0xc1, 0xa6, 0x0a, 0x0b, 0x10, 0x42,
+	0x5d, 0x94, 0x0b, 0xa0, 0xee, 0x10, 0x82, 0x4a, 0x09, 0x2b, 0x36, 0x56, 0x92, 0x3a, 0x28, 0x6a,
+	0x63, 0x47, 0xb1, 0x59, 0x84, 0x33, 0x72, 0x07, 0xae, 0x82, 0x3f, 0x71, 0x12, 0x58, 0xbe, 0x99,
+	0xf1, 0x3c, 0xcf, 0xd8, 0x30, 0xe3, 0xa2, 0x26, 0x49, 0xc9, 0x97, 0x55, 0xcd, 0x04, 0x43, 0x87,
+	0xed, 0x18, 0x6e, 0x60, 0x1a, 0x93, 0x8f, 0x92, 0x50, 0xf1, 0x42, 0x44, 0x82, 0xae, 0x61, 0x46,
+	0x68, 0x56, 0x37, 0x95, 0x20, 0x5b, 0xbc, 0x23, 0x4d, 0xe0, 0x2c, 0x9c, 0x5b, 0x3f, 0xf2, 0x3b,
+	0xf0, 0x99, 0x34, 0xe8, 0x0a, 0x8e, 0x24, 0x85, 0x29, 0xa3, 0x19, 0x09, 0x46, 0x5a, 0x30, 0x91,
+	0xc0, 0xab, 0x9a, 0xc3, 0x6f, 0x07, 0x20, 0xd6, 0xe6, 0x4f, 0x34, 0x67, 0xe8, 0x1e, 0x10, 0xfd,
+	0x2c, 0x53, 0x52, 0x63, 0x96, 0x63, 0x6e, 0x36, 0x71, 0xed, 0xea, 0x46, 0x27, 0x86, 0xd9, 0xe4,
+	0xed, 0x0d, 0xb8, 0x5a, 0x6f, 0x35, 0x98, 0x17, 0x5f, 0xc6, 0xdd, 0x8d, 0x7c, 0x0b, 0xc6, 0x12,
+	0x43, 0x77, 0x70, 0xba, 0x4f, 0xb8, 0xb0, 0x6e, 0x46, 0xe8, 0x6a, 0xe1, 0x5c, 0x11, 0xad, 0x9b,
+	0xd6, 0x5e, 0xc2, 0xa4, 0x94, 0xb9, 0xb6, 0x89, 0x48, 0x82, 0xb1, 0xb9, 0xa9, 0x9d, 0x07, 0xcb,
+	0xb4, 0x05, 0x0f, 0xbc, 0x85, 0x3b, 0x58, 0xa6, 0xce, 0xf3, 0xf0, 0xa7, 0x8b, 0xa3, 0xfb, 0x59,
+	0xc1, 0x45, 0xdf, 0x8f, 0xe9, 0x10, 0x17, 0x32, 0x67, 0xdb, 0xd3, 0x59, 0x47, 0x0e, 0x2a, 0xb8,
+	0x81, 0x79, 0x0b, 0x17, 0x8c, 0x62, 0xd1, 0x54, 0x26, 0x96, 0x17, 0x1d, 0xf7, 0xf0, 0x9b, 0x44,
+	0x07, 0xe6, 0x4a, 0x98, 0xee, 0x59, 0xb6, 0xeb, 0xc3, 0x79, 0x9d, 0xb9, 0x24, 0xd7, 0x8a, 0xd3,
+	0x01, 0x1f, 0xff, 0x95, 0xa1, 0xd2, 0xe9, 0xa4, 0xd3, 0xd5, 0xf9, 0xd2, 0xbe, 0xf9, 0xe0, 0x85,
+	0xff, 0x54, 0xa4, 0x80, 0xf5, 0xf8, 0x7d, 0x54, 0xa5, 0xe9, 0x81, 0xfe, 0x17, 0x0f, 0xbf, 0x78,
+	0x90, 0x7b, 0x99, 0x28, 0x02, 0x00, 0x00,
 }
@@ -9,6 +9,7 @@ import (
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
+	_ "crypto/sha512" // registers crypto.SHA512 for HashAndSignWith
 	"math/big"
 	"reflect"
 )
@@ -136,6 +137,55 @@ func HashAndSign(key crypto.PrivateKey, data []byte) ([]byte, error) {
 	return signature, nil
 }
 
+// HashAndSignWith signs a digest of the given data computed with hash and
+// returns the new signature.
+func HashAndSignWith(key crypto.PrivateKey, hash crypto.Hash, data []byte) ([]byte, error) {
+	if !hash.Available() {
+		return nil, ErrSign.New("hash function %d is not available", hash)
+	}
+	digest := hash.New()
+	_, _ = digest.Write(data)
+
+	switch key := key.(type) {
+	case *ecdsa.PrivateKey:
+		return signECDSAWithoutHashing(key, digest.Sum(nil))
+	case *rsa.PrivateKey:
+		signature, err := key.Sign(rand.Reader, digest.Sum(nil), &rsa.PSSOptions{
+			SaltLength: StorjPSSSaltLength,
+			Hash:       hash,
+		})
+		return signature, ErrSign.Wrap(err)
+	}
+	return nil, ErrUnsupportedKey.New("%T", key)
+}
+
+// HashAndVerifySignatureWith checks that signature was made by the private key
+// corresponding to the given public key, over a digest of the given data
+// computed with hash. It returns an error if verification fails, or nil
+// otherwise.
+func HashAndVerifySignatureWith(key crypto.PublicKey, hash crypto.Hash, data, signature []byte) error {
+	if !hash.Available() {
+		return ErrVerifySignature.New("hash function %d is not available", hash)
+	}
+	digest := hash.New()
+	_, _ = digest.Write(data)
+
+	switch key := key.(type) {
+	case *ecdsa.PublicKey:
+		return verifyECDSASignatureWithoutHashing(key, digest.Sum(nil), signature)
+	case *rsa.PublicKey:
+		err := rsa.VerifyPSS(key, hash, digest.Sum(nil), signature, &rsa.PSSOptions{
+			SaltLength: StorjPSSSaltLength,
+			Hash:       hash,
+		})
+		if err != nil {
+			return ErrVerifySignature.New("signature is not valid")
+		}
+		return nil
+	}
+	return ErrUnsupportedKey.New("%T", key)
+}
+
 // PublicKeyEqual returns true if two public keys are the same.
 func PublicKeyEqual(a, b crypto.PublicKey) bool {
 	switch aConcrete := a.(type) {
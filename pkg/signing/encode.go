@@ -108,3 +108,13 @@ func EncodeSegmentID(ctx context.Context, segmentID *pb.SatSegmentID) (_ []byte,
 	segmentID.SatelliteSignature = signature
 	return out, err
 }
+
+// EncodePieceDeletionAcknowledgment encodes piece deletion acknowledgment into bytes for signing.
+func EncodePieceDeletionAcknowledgment(ctx context.Context, ack *pb.PieceDeletionAcknowledgment) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	signature := ack.Signature
+	ack.Signature = nil
+	out, err := proto.Marshal(ack)
+	ack.Signature = signature
+	return out, err
+}
@@ -16,6 +16,27 @@ import (
 
 var mon = monkit.Package()
 
+// Algorithm identifies the hash function over which data is signed.
+type Algorithm string
+
+const (
+	// SHA256 signs SHA-256 digests, it's what HashAndSign uses.
+	SHA256 Algorithm = "sha256"
+	// SHA512 signs SHA-512 digests.
+	SHA512 Algorithm = "sha512"
+)
+
+// Hash returns the hash function of the algorithm.
+func (algorithm Algorithm) Hash() (crypto.Hash, error) {
+	switch algorithm {
+	case SHA256:
+		return crypto.SHA256, nil
+	case SHA512:
+		return crypto.SHA512, nil
+	}
+	return 0, Error.New("unknown signature algorithm %q", string(algorithm))
+}
+
 // PrivateKey implements a signer and signee using a crypto.PrivateKey.
 type PrivateKey struct {
 	Self storj.NodeID
@@ -46,6 +67,27 @@ func (private *PrivateKey) HashAndVerifySignature(ctx context.Context, data, sig
 	return pkcrypto.HashAndVerifySignature(pub, data, signature)
 }
 
+// HashAndSignWith hashes the data with algorithm and signs with the used key.
+func (private *PrivateKey) HashAndSignWith(ctx context.Context, algorithm Algorithm, data []byte) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	hash, err := algorithm.Hash()
+	if err != nil {
+		return nil, err
+	}
+	return pkcrypto.HashAndSignWith(private.Key, hash, data)
+}
+
+// HashAndVerifySignatureWith hashes the data with algorithm and verifies that the signature belongs to the PrivateKey.
+func (private *PrivateKey) HashAndVerifySignatureWith(ctx context.Context, algorithm Algorithm, data, signature []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	hash, err := algorithm.Hash()
+	if err != nil {
+		return err
+	}
+	pub := pkcrypto.PublicKeyFromPrivate(private.Key)
+	return pkcrypto.HashAndVerifySignatureWith(pub, hash, data, signature)
+}
+
 // PublicKey implements a signee using crypto.PublicKey.
 type PublicKey struct {
 	Self storj.NodeID
@@ -68,3 +110,13 @@ func (public *PublicKey) HashAndVerifySignature(ctx context.Context, data, signa
 	defer mon.Task()(&ctx)(&err)
 	return pkcrypto.HashAndVerifySignature(public.Key, data, signature)
 }
+
+// HashAndVerifySignatureWith hashes the data with algorithm and verifies that the signature belongs to the PublicKey.
+func (public *PublicKey) HashAndVerifySignatureWith(ctx context.Context, algorithm Algorithm, data, signature []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	hash, err := algorithm.Hash()
+	if err != nil {
+		return err
+	}
+	return pkcrypto.HashAndVerifySignatureWith(public.Key, hash, data, signature)
+}
@@ -22,6 +22,12 @@ type Signer interface {
 	HashAndVerifySignature(ctx context.Context, data, signature []byte) error
 }
 
+// AlgorithmSigner is a Signer which is also able to sign with other algorithms than its default one.
+type AlgorithmSigner interface {
+	Signer
+	HashAndSignWith(ctx context.Context, algorithm Algorithm, data []byte) ([]byte, error)
+}
+
 // SignOrderLimit signs the order limit using the specified signer.
 // Signer is a satellite.
 func SignOrderLimit(ctx context.Context, satellite Signer, unsigned *pb.OrderLimit) (_ *pb.OrderLimit, err error) {
@@ -40,6 +46,29 @@ func SignOrderLimit(ctx context.Context, satellite Signer, unsigned *pb.OrderLim
 	return &signed, nil
 }
 
+// SignOrderLimitWith signs the order limit using the specified signer and algorithm.
+// Signer is a satellite.
+func SignOrderLimitWith(ctx context.Context, satellite Signer, algorithm Algorithm, unsigned *pb.OrderLimit) (_ *pb.OrderLimit, err error) {
+	defer mon.Task()(&ctx)(&err)
+	signer, ok := satellite.(AlgorithmSigner)
+	if !ok {
+		return nil, Error.New("%T is unable to sign with %q", satellite, string(algorithm))
+	}
+
+	bytes, err := EncodeOrderLimit(ctx, unsigned)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	signed := *unsigned
+	signed.SatelliteSignature, err = signer.HashAndSignWith(ctx, algorithm, bytes)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &signed, nil
+}
+
 // SignUplinkOrder signs the order using the specified signer.
 // Signer is an uplink.
 func SignUplinkOrder(ctx context.Context, privateKey storj.PiecePrivateKey, unsigned *pb.Order) (_ *pb.Order, err error) {
@@ -145,3 +174,21 @@ func SignSegmentID(ctx context.Context, signer Signer, unsigned *pb.SatSegmentID
 
 	return &signed, nil
 }
+
+// SignPieceDeletionAcknowledgment signs the piece deletion acknowledgment using the specified signer
+// Signer is a storage node
+func SignPieceDeletionAcknowledgment(ctx context.Context, signer Signer, unsigned *pb.PieceDeletionAcknowledgment) (_ *pb.PieceDeletionAcknowledgment, err error) {
+	defer mon.Task()(&ctx)(&err)
+	bytes, err := EncodePieceDeletionAcknowledgment(ctx, unsigned)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	signed := *unsigned
+	signed.Signature, err = signer.HashAndSign(ctx, bytes)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &signed, nil
+}
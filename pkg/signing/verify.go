@@ -27,6 +27,28 @@ func VerifyOrderLimitSignature(ctx context.Context, satellite Signee, signed *pb
 	return satellite.HashAndVerifySignature(ctx, bytes, signed.SatelliteSignature)
 }
 
+// AlgorithmSignee is a Signee which is also able to verify signatures made with other algorithms than its default one.
+type AlgorithmSignee interface {
+	Signee
+	HashAndVerifySignatureWith(ctx context.Context, algorithm Algorithm, data, signature []byte) error
+}
+
+// VerifyOrderLimitSignatureWith verifies that the signature inside order limit was made with algorithm and belongs to the satellite.
+func VerifyOrderLimitSignatureWith(ctx context.Context, satellite Signee, algorithm Algorithm, signed *pb.OrderLimit) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	signee, ok := satellite.(AlgorithmSignee)
+	if !ok {
+		return Error.New("%T is unable to verify %q signatures", satellite, string(algorithm))
+	}
+
+	bytes, err := EncodeOrderLimit(ctx, signed)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return signee.HashAndVerifySignatureWith(ctx, algorithm, bytes, signed.SatelliteSignature)
+}
+
 // VerifyOrderSignature verifies that the signature inside order is valid and belongs to the uplink.
 func VerifyOrderSignature(ctx context.Context, uplink Signee, signed *pb.Order) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -104,3 +126,14 @@ func VerifySegmentID(ctx context.Context, satellite Signee, signed *pb.SatSegmen
 
 	return satellite.HashAndVerifySignature(ctx, bytes, signed.SatelliteSignature)
 }
+
+// VerifyPieceDeletionAcknowledgment verifies that the signature inside piece deletion acknowledgment is valid and belongs to the storage node
+func VerifyPieceDeletionAcknowledgment(ctx context.Context, storageNode Signee, signed *pb.PieceDeletionAcknowledgment) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	bytes, err := EncodePieceDeletionAcknowledgment(ctx, signed)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return storageNode.HashAndVerifySignature(ctx, bytes, signed.Signature)
+}
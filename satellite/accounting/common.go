@@ -4,6 +4,7 @@
 package accounting
 
 import (
+	"strconv"
 	"time"
 
 	"storj.io/storj/pkg/storj"
@@ -32,3 +33,37 @@ type CSVRow struct {
 	Wallet           string
 	Disqualified     *time.Time
 }
+
+// CSVHeader is the header of the payment info CSV, matching the fields returned by CSVRow.Record
+var CSVHeader = []string{
+	"nodeID",
+	"nodeCreationDate",
+	"byte-hours:AtRest",
+	"bytes:BWRepair-GET",
+	"bytes:BWRepair-PUT",
+	"bytes:BWAudit",
+	"bytes:BWPut",
+	"bytes:BWGet",
+	"walletAddress",
+	"disqualified",
+}
+
+// Record formats the row as a CSV record
+func (row *CSVRow) Record() []string {
+	dqStr := ""
+	if row.Disqualified != nil {
+		dqStr = row.Disqualified.Format("2006-01-02")
+	}
+	return []string{
+		row.NodeID.String(),
+		row.NodeCreationDate.Format("2006-01-02"),
+		strconv.FormatFloat(row.AtRestTotal, 'f', 5, 64),
+		strconv.FormatInt(row.GetRepairTotal, 10),
+		strconv.FormatInt(row.PutRepairTotal, 10),
+		strconv.FormatInt(row.GetAuditTotal, 10),
+		strconv.FormatInt(row.PutTotal, 10),
+		strconv.FormatInt(row.GetTotal, 10),
+		row.Wallet,
+		dqStr,
+	}
+}
@@ -5,6 +5,7 @@ package accounting
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"github.com/skyrings/skyring-common/tools/uuid"
@@ -53,6 +54,17 @@ type NodeSpaceUsage struct {
 	TimeStamp time.Time
 }
 
+// NodePaymentTotals is the summed usage of a single node over a period of time
+type NodePaymentTotals struct {
+	NodeID         storj.NodeID
+	AtRestTotal    float64
+	GetTotal       int64
+	PutTotal       int64
+	GetAuditTotal  int64
+	GetRepairTotal int64
+	PutRepairTotal int64
+}
+
 // StoragenodeAccounting stores information about bandwidth and storage usage for storage nodes
 type StoragenodeAccounting interface {
 	// SaveTallies records tallies of data at rest
@@ -61,6 +73,8 @@ type StoragenodeAccounting interface {
 	GetTallies(ctx context.Context) ([]*StoragenodeStorageTally, error)
 	// GetTalliesSince retrieves all tallies since latestRollup
 	GetTalliesSince(ctx context.Context, latestRollup time.Time) ([]*StoragenodeStorageTally, error)
+	// GetTalliesSinceForNode retrieves all tallies for a single node since latestRollup
+	GetTalliesSinceForNode(ctx context.Context, nodeID storj.NodeID, latestRollup time.Time) ([]*StoragenodeStorageTally, error)
 	// GetBandwidthSince retrieves all bandwidth rollup entires since latestRollup
 	GetBandwidthSince(ctx context.Context, latestRollup time.Time) ([]*StoragenodeBandwidthRollup, error)
 	// SaveRollup records tally and bandwidth rollup aggregations to the database
@@ -69,6 +83,10 @@ type StoragenodeAccounting interface {
 	LastTimestamp(ctx context.Context, timestampType string) (time.Time, error)
 	// QueryPaymentInfo queries Nodes and Accounting_Rollup on nodeID
 	QueryPaymentInfo(ctx context.Context, start time.Time, end time.Time) ([]*CSVRow, error)
+	// WritePaymentInfoCSV streams the rows of QueryPaymentInfo as CSV into w
+	WritePaymentInfoCSV(ctx context.Context, w io.Writer, start time.Time, end time.Time) error
+	// QueryNodePaymentTotals returns the summed usage totals of nodeID for given period
+	QueryNodePaymentTotals(ctx context.Context, nodeID storj.NodeID, start time.Time, end time.Time) (*NodePaymentTotals, error)
 	// QueryNodeDailySpaceUsage returns slice of NodeSpaceUsage for given period
 	QueryNodeDailySpaceUsage(ctx context.Context, nodeID storj.NodeID, start time.Time, end time.Time) ([]NodeSpaceUsage, error)
 	// DeleteTalliesBefore deletes all tallies prior to some time
@@ -4,6 +4,8 @@
 package accounting_test
 
 import (
+	"bytes"
+	"encoding/csv"
 	"fmt"
 	"testing"
 	"time"
@@ -13,9 +15,11 @@ import (
 
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/satellitedb/satellitedbtest"
 )
 
@@ -41,6 +45,176 @@ func TestSaveBucketTallies(t *testing.T) {
 	})
 }
 
+func TestGetTalliesSinceForNode(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		sdb := db.StoragenodeAccounting()
+		nodeA, nodeB := testrand.NodeID(), testrand.NodeID()
+
+		since := time.Now().Add(-2 * time.Hour)
+		for i := 0; i < 3; i++ {
+			latestTally := since.Add(time.Duration(i) * time.Minute)
+			err := sdb.SaveTallies(ctx, latestTally, map[storj.NodeID]float64{
+				nodeA: float64(i + 1),
+				nodeB: float64(10 * (i + 1)),
+			})
+			require.NoError(t, err)
+		}
+
+		all, err := sdb.GetTalliesSince(ctx, since)
+		require.NoError(t, err)
+		require.Len(t, all, 6)
+
+		tallies, err := sdb.GetTalliesSinceForNode(ctx, nodeA, since)
+		require.NoError(t, err)
+		require.Len(t, tallies, 3)
+		for i, tally := range tallies {
+			require.Equal(t, nodeA, tally.NodeID)
+			require.Equal(t, float64(i+1), tally.DataTotal)
+		}
+
+		tallies, err = sdb.GetTalliesSinceForNode(ctx, testrand.NodeID(), since)
+		require.NoError(t, err)
+		require.Len(t, tallies, 0)
+	})
+}
+
+func TestSaveRollupIdempotent(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		sdb := db.StoragenodeAccounting()
+		nodeID := testrand.NodeID()
+
+		err := db.OverlayCache().UpdateAddress(ctx, &pb.Node{Id: nodeID}, overlay.NodeSelectionConfig{})
+		require.NoError(t, err)
+
+		now := time.Now().UTC()
+		day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()).Add(-24 * time.Hour)
+		stats := accounting.RollupStats{
+			day: {
+				nodeID: &accounting.Rollup{NodeID: nodeID, StartTime: day, PutTotal: 100, AtRestTotal: 1000},
+			},
+		}
+
+		err = sdb.SaveRollup(ctx, day, stats)
+		require.NoError(t, err)
+
+		// saving the same interval again must not double the rollups
+		err = sdb.SaveRollup(ctx, day, stats)
+		require.NoError(t, err)
+
+		rows, err := sdb.QueryPaymentInfo(ctx, day, day.Add(24*time.Hour))
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		require.Equal(t, int64(100), rows[0].PutTotal)
+		require.Equal(t, float64(1000), rows[0].AtRestTotal)
+
+		lastRollup, err := sdb.LastTimestamp(ctx, accounting.LastRollup)
+		require.NoError(t, err)
+		require.True(t, day.Equal(lastRollup))
+	})
+}
+
+func TestQueryNodePaymentTotals(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		sdb := db.StoragenodeAccounting()
+		nodeA, nodeB := testrand.NodeID(), testrand.NodeID()
+
+		now := time.Now().UTC()
+		start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()).Add(-5 * 24 * time.Hour)
+
+		stats := make(accounting.RollupStats)
+		for i := 0; i < 5; i++ {
+			day := start.Add(time.Duration(i) * 24 * time.Hour)
+			stats[day] = map[storj.NodeID]*accounting.Rollup{
+				nodeA: {NodeID: nodeA, StartTime: day, AtRestTotal: 1.5, GetTotal: 1, PutTotal: 2, GetAuditTotal: 3, GetRepairTotal: 4, PutRepairTotal: 5},
+				nodeB: {NodeID: nodeB, StartTime: day, AtRestTotal: 100, GetTotal: 100, PutTotal: 100, GetAuditTotal: 100, GetRepairTotal: 100, PutRepairTotal: 100},
+			}
+		}
+		err := sdb.SaveRollup(ctx, start.Add(5*24*time.Hour), stats)
+		require.NoError(t, err)
+
+		// only the first four days fall into the period
+		totals, err := sdb.QueryNodePaymentTotals(ctx, nodeA, start, start.Add(4*24*time.Hour))
+		require.NoError(t, err)
+		require.Equal(t, &accounting.NodePaymentTotals{
+			NodeID:         nodeA,
+			AtRestTotal:    6,
+			GetTotal:       4,
+			PutTotal:       8,
+			GetAuditTotal:  12,
+			GetRepairTotal: 16,
+			PutRepairTotal: 20,
+		}, totals)
+
+		totals, err = sdb.QueryNodePaymentTotals(ctx, testrand.NodeID(), start, start.Add(4*24*time.Hour))
+		require.NoError(t, err)
+		require.Zero(t, totals.AtRestTotal)
+		require.Zero(t, totals.PutTotal)
+	})
+}
+
+func TestWritePaymentInfoCSV(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		sdb := db.StoragenodeAccounting()
+
+		now := time.Now().UTC()
+		start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()).Add(-3 * 24 * time.Hour)
+
+		stats := make(accounting.RollupStats)
+		for i := 0; i < 5; i++ {
+			nodeID := testrand.NodeID()
+			err := db.OverlayCache().UpdateAddress(ctx, &pb.Node{Id: nodeID}, overlay.NodeSelectionConfig{})
+			require.NoError(t, err)
+
+			for d := 0; d < 2; d++ {
+				day := start.Add(time.Duration(d) * 24 * time.Hour)
+				if stats[day] == nil {
+					stats[day] = make(map[storj.NodeID]*accounting.Rollup)
+				}
+				stats[day][nodeID] = &accounting.Rollup{
+					NodeID:      nodeID,
+					StartTime:   day,
+					AtRestTotal: float64(i * 1000),
+					GetTotal:    int64(i),
+					PutTotal:    int64(d),
+				}
+			}
+		}
+		err := sdb.SaveRollup(ctx, start.Add(2*24*time.Hour), stats)
+		require.NoError(t, err)
+
+		end := start.Add(2 * 24 * time.Hour)
+		rows, err := sdb.QueryPaymentInfo(ctx, start, end)
+		require.NoError(t, err)
+		require.Len(t, rows, 5)
+
+		var expected bytes.Buffer
+		w := csv.NewWriter(&expected)
+		require.NoError(t, w.Write(accounting.CSVHeader))
+		for _, row := range rows {
+			require.NoError(t, w.Write(row.Record()))
+		}
+		w.Flush()
+		require.NoError(t, w.Error())
+
+		var streamed bytes.Buffer
+		err = sdb.WritePaymentInfoCSV(ctx, &streamed, start, end)
+		require.NoError(t, err)
+		require.Equal(t, expected.String(), streamed.String())
+	})
+}
+
 func createBucketStorageTallies(projectID uuid.UUID) (map[string]*accounting.BucketTally, []accounting.BucketTally, error) {
 	bucketTallies := make(map[string]*accounting.BucketTally)
 	var expectedTallies []accounting.BucketTally
@@ -0,0 +1,35 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package accounting
+
+import (
+	"math"
+
+	"storj.io/storj/internal/currency"
+	"storj.io/storj/internal/memory"
+)
+
+// PayoutRates contains the rates, in cents per terabyte, used to compute a storage node payout
+type PayoutRates struct {
+	// AtRestTBHour is the rate for storing one terabyte for one hour
+	AtRestTBHour float64
+	// EgressTB is the rate for one terabyte of egress bandwidth
+	EgressTB float64
+	// AuditTB is the rate for one terabyte of audit bandwidth
+	AuditTB float64
+	// RepairTB is the rate for one terabyte of repair egress bandwidth
+	RepairTB float64
+}
+
+// ComputePayout applies rates to the totals of row and returns the payout, rounded to the nearest cent
+func ComputePayout(row CSVRow, rates PayoutRates) currency.USD {
+	tb := memory.TB.Float64()
+
+	cents := row.AtRestTotal / tb * rates.AtRestTBHour
+	cents += float64(row.GetTotal) / tb * rates.EgressTB
+	cents += float64(row.GetAuditTotal) / tb * rates.AuditTB
+	cents += float64(row.GetRepairTotal) / tb * rates.RepairTB
+
+	return currency.Cents(int(math.Round(cents)))
+}
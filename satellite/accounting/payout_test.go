@@ -0,0 +1,45 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package accounting_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"storj.io/storj/internal/currency"
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/satellite/accounting"
+)
+
+func TestComputePayout(t *testing.T) {
+	rates := accounting.PayoutRates{
+		AtRestTBHour: 0.2,
+		EgressTB:     2000,
+		AuditTB:      1000,
+		RepairTB:     1000,
+	}
+
+	tests := []struct {
+		row      accounting.CSVRow
+		expected currency.USD
+	}{
+		{accounting.CSVRow{}, currency.Cents(0)},
+		{accounting.CSVRow{AtRestTotal: 720 * memory.TB.Float64()}, currency.Cents(144)},
+		{accounting.CSVRow{GetTotal: memory.TB.Int64()}, currency.Dollars(20)},
+		{accounting.CSVRow{GetAuditTotal: memory.TB.Int64() / 2}, currency.Dollars(5)},
+		{accounting.CSVRow{GetRepairTotal: memory.TB.Int64(), PutRepairTotal: memory.TB.Int64()}, currency.Dollars(10)},
+		{accounting.CSVRow{
+			AtRestTotal:    720 * memory.TB.Float64(),
+			GetTotal:       memory.TB.Int64(),
+			GetAuditTotal:  memory.TB.Int64() / 2,
+			GetRepairTotal: memory.TB.Int64(),
+			PutTotal:       memory.TB.Int64(),
+		}, currency.Cents(3644)},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, accounting.ComputePayout(test.row, rates))
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"context"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/storage"
+)
+
+// ImplausibleCapacity is a node whose self-reported free disk can't be right.
+type ImplausibleCapacity struct {
+	NodeID   storj.NodeID
+	FreeDisk int64
+}
+
+// CapacityChecker periodically cross-checks the free disk that nodes report
+// against the largest disk a node can plausibly have, and flags the nodes
+// whose claims are implausible.
+type CapacityChecker struct {
+	log         *zap.Logger
+	overlay     *overlay.Cache
+	maxFreeDisk memory.Size
+
+	Loop sync2.Cycle
+
+	mu      sync.Mutex
+	flagged map[storj.NodeID]ImplausibleCapacity
+}
+
+// NewCapacityChecker creates a CapacityChecker running every config.CapacityCheckInterval.
+func NewCapacityChecker(log *zap.Logger, overlay *overlay.Cache, config Config) *CapacityChecker {
+	return &CapacityChecker{
+		log:         log,
+		overlay:     overlay,
+		maxFreeDisk: config.MaxFreeDisk,
+
+		Loop: *sync2.NewCycle(config.CapacityCheckInterval),
+
+		flagged: make(map[storj.NodeID]ImplausibleCapacity),
+	}
+}
+
+// Run runs the capacity checker loop
+func (checker *CapacityChecker) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return checker.Loop.Run(ctx, func(ctx context.Context) error {
+		_, err := checker.Check(ctx)
+		if err != nil {
+			checker.log.Error("capacity check failed", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close halts the capacity checker loop
+func (checker *CapacityChecker) Close() error {
+	checker.Loop.Close()
+	return nil
+}
+
+// Check goes through all nodes which aren't disqualified and returns the ones
+// reporting a negative free disk or more free disk than the configured maximum.
+// The returned nodes replace the previously flagged ones.
+func (checker *CapacityChecker) Check(ctx context.Context) (implausible []ImplausibleCapacity, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var offset int64
+	for {
+		nodes, more, err := checker.overlay.Paginate(ctx, offset, storage.LookupLimit)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		for _, node := range nodes {
+			if node.Disqualified != nil {
+				continue
+			}
+			if checker.plausible(node.Capacity.FreeDisk) {
+				continue
+			}
+
+			checker.log.Warn("node reports implausible free disk",
+				zap.Stringer("node id", node.Id),
+				zap.Int64("free disk", node.Capacity.FreeDisk))
+			mon.Meter("implausible_capacity").Mark(1)
+			implausible = append(implausible, ImplausibleCapacity{
+				NodeID:   node.Id,
+				FreeDisk: node.Capacity.FreeDisk,
+			})
+		}
+
+		if !more {
+			break
+		}
+		offset += int64(len(nodes))
+	}
+
+	flagged := make(map[storj.NodeID]ImplausibleCapacity, len(implausible))
+	for _, node := range implausible {
+		flagged[node.NodeID] = node
+	}
+
+	checker.mu.Lock()
+	checker.flagged = flagged
+	checker.mu.Unlock()
+
+	return implausible, nil
+}
+
+// Flagged returns whether the node was found reporting an implausible free disk by the last check.
+func (checker *CapacityChecker) Flagged(nodeID storj.NodeID) bool {
+	checker.mu.Lock()
+	defer checker.mu.Unlock()
+
+	_, flagged := checker.flagged[nodeID]
+	return flagged
+}
+
+func (checker *CapacityChecker) plausible(freeDisk int64) bool {
+	if freeDisk < 0 {
+		return false
+	}
+	return checker.maxFreeDisk <= 0 || freeDisk <= checker.maxFreeDisk.Int64()
+}
@@ -0,0 +1,75 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestCapacityChecker(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		cache := overlay.NewCache(zaptest.NewLogger(t), db.OverlayCache(), overlay.Config{})
+
+		addNode := func(freeDisk int64) storj.NodeID {
+			nodeID := testrand.NodeID()
+			require.NoError(t, cache.Put(ctx, nodeID, pb.Node{Id: nodeID, Address: &pb.NodeAddress{Address: "127.0.0.1:0"}}))
+			_, err := cache.UpdateNodeInfo(ctx, nodeID, &pb.InfoResponse{
+				Type:     pb.NodeType_STORAGE,
+				Capacity: &pb.NodeCapacity{FreeDisk: freeDisk},
+			})
+			require.NoError(t, err)
+			return nodeID
+		}
+
+		honest := addNode(2 * memory.TB.Int64())
+		full := addNode(0)
+		impossible := addNode(50 * memory.PB.Int64())
+		negative := addNode(-1)
+
+		checker := audit.NewCapacityChecker(zaptest.NewLogger(t), cache, audit.Config{
+			CapacityCheckInterval: time.Hour,
+			MaxFreeDisk:           100 * memory.TB,
+		})
+
+		implausible, err := checker.Check(ctx)
+		require.NoError(t, err)
+		require.Len(t, implausible, 2)
+
+		assert.False(t, checker.Flagged(honest))
+		assert.False(t, checker.Flagged(full))
+		assert.True(t, checker.Flagged(impossible))
+		assert.True(t, checker.Flagged(negative))
+
+		// a node is no longer flagged once it reports a plausible free disk
+		_, err = cache.UpdateNodeInfo(ctx, impossible, &pb.InfoResponse{
+			Type:     pb.NodeType_STORAGE,
+			Capacity: &pb.NodeCapacity{FreeDisk: memory.TB.Int64()},
+		})
+		require.NoError(t, err)
+
+		implausible, err = checker.Check(ctx)
+		require.NoError(t, err)
+		require.Len(t, implausible, 1)
+		assert.Equal(t, negative, implausible[0].NodeID)
+		assert.False(t, checker.Flagged(impossible))
+	})
+}
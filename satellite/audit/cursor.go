@@ -104,6 +104,38 @@ func getRandomStripe(ctx context.Context, pointer *pb.Pointer) (index int64, err
 	return randomStripeIndex, nil
 }
 
+// getRandomStripes returns up to count distinct random stripe indexes of pointer, excluding the index exclude
+func getRandomStripes(ctx context.Context, pointer *pb.Pointer, count int, exclude int64) (indexes []int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if count <= 0 {
+		return nil, nil
+	}
+
+	redundancy, err := eestream.NewRedundancyStrategyFromProto(pointer.GetRemote().GetRedundancy())
+	if err != nil {
+		return nil, err
+	}
+
+	numStripes := pointer.GetSegmentSize() / int64(redundancy.StripeSize())
+	if int64(count) > numStripes-1 {
+		count = int(numStripes - 1)
+	}
+
+	var src cryptoSource
+	rnd := rand.New(src)
+	seen := map[int64]bool{exclude: true}
+	for len(indexes) < count {
+		index := rnd.Int63n(numStripes)
+		if seen[index] {
+			continue
+		}
+		seen[index] = true
+		indexes = append(indexes, index)
+	}
+
+	return indexes, nil
+}
+
 // getRandomValidPointer attempts to get a random remote pointer from a list. If it sees expired pointers in the process of looking, deletes them
 func (cursor *Cursor) getRandomValidPointer(ctx context.Context, pointerItems []*pb.ListResponse_Item) (pointer *pb.Pointer, path storj.Path, err error) {
 	defer mon.Task()(&ctx)(&err)
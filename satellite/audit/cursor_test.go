@@ -12,6 +12,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"storj.io/storj/internal/memory"
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/testplanet"
 	"storj.io/storj/internal/testrand"
@@ -190,3 +191,30 @@ func makePointer(expiration time.Time) *pb.Pointer {
 		SegmentSize: int64(10),
 	}
 }
+
+func TestStripeCount(t *testing.T) {
+	config := audit.Config{
+		StripeBytes:          16 * memory.MiB,
+		MaxStripesPerSegment: 4,
+	}
+
+	for _, test := range []struct {
+		segmentSize memory.Size
+		expected    int
+	}{
+		{0, 1},
+		{1 * memory.KiB, 1},
+		{16 * memory.MiB, 1},
+		{16*memory.MiB + 1, 2},
+		{32 * memory.MiB, 2},
+		{48 * memory.MiB, 3},
+		{64 * memory.MiB, 4},
+		{1 * memory.GiB, 4},
+	} {
+		require.Equal(t, test.expected, config.StripeCount(test.segmentSize.Int64()), test.segmentSize.String())
+	}
+
+	// disabled scaling always audits a single stripe
+	require.Equal(t, 1, audit.Config{}.StripeCount(memory.GiB.Int64()))
+	require.Equal(t, 1, audit.Config{StripeBytes: memory.MiB, MaxStripesPerSegment: 1}.StripeCount(memory.GiB.Int64()))
+}
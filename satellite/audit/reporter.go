@@ -32,6 +32,22 @@ type Report struct {
 	Fails         storj.NodeIDList
 	Offlines      storj.NodeIDList
 	PendingAudits []*PendingAudit
+	// Contained lists the nodes whose shares could not be downloaded because of
+	// a timeout or an unexpected error. They are only informational: the nodes
+	// are put into containment through PendingAudits.
+	Contained storj.NodeIDList
+	// Corruptions holds where the share of a node in Fails diverged from the
+	// erasure corrected share, for the nodes which returned altered data.
+	Corruptions map[storj.NodeID]*Corruption
+}
+
+// Corruption is the byte range in which a share diverged from the erasure
+// corrected share.
+type Corruption struct {
+	// Offset is the first altered byte within the share.
+	Offset int64
+	// Length is the size of the byte range covering all altered bytes of the share.
+	Length int64
 }
 
 // NewReporter instantiates a reporter
@@ -65,6 +81,14 @@ func (reporter *Reporter) RecordAudits(ctx context.Context, req *Report) (_ *Rep
 		zap.Int("pending", len(pendingAudits)),
 	)
 
+	// where the shares were altered is kept for resolving node disputes
+	for _, nodeID := range fails {
+		if corruption, ok := req.Corruptions[nodeID]; ok {
+			reporter.log.Info("Audit failed with an altered share", zap.Stringer("Node ID", nodeID),
+				zap.Int64("offset", corruption.Offset), zap.Int64("length", corruption.Length))
+		}
+	}
+
 	var errlist errs.Group
 
 	tries := 0
@@ -105,11 +129,22 @@ func (reporter *Reporter) RecordAudits(ctx context.Context, req *Report) (_ *Rep
 
 	err = errlist.Err()
 	if tries >= reporter.maxRetries && err != nil {
+		var corruptions map[storj.NodeID]*Corruption
+		for _, nodeID := range fails {
+			if corruption, ok := req.Corruptions[nodeID]; ok {
+				if corruptions == nil {
+					corruptions = make(map[storj.NodeID]*Corruption)
+				}
+				corruptions[nodeID] = corruption
+			}
+		}
+
 		return &Report{
 			Successes:     successes,
 			Fails:         fails,
 			Offlines:      offlines,
 			PendingAudits: pendingAudits,
+			Corruptions:   corruptions,
 		}, errs.Combine(Error.New("some nodes failed to be updated in overlay"), err)
 	}
 	return nil, nil
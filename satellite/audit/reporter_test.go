@@ -8,6 +8,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
 
 	"storj.io/storj/internal/memory"
 	"storj.io/storj/internal/testcontext"
@@ -81,3 +83,34 @@ func TestRecordAuditsAtLeastOnce(t *testing.T) {
 		require.EqualValues(t, 1, node.Reputation.AuditCount)
 	})
 }
+
+func TestReportCorruptions(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		err := planet.Satellites[0].Audit.Service.Close()
+		require.NoError(t, err)
+
+		nodeID := planet.StorageNodes[0].ID()
+
+		report := audit.Report{
+			Fails:       []storj.NodeID{nodeID},
+			Corruptions: map[storj.NodeID]*audit.Corruption{nodeID: {Offset: 16, Length: 32}},
+		}
+		overlay := planet.Satellites[0].Overlay.Service
+		containment := planet.Satellites[0].DB.Containment()
+		core, logs := observer.New(zap.InfoLevel)
+
+		reporter := audit.NewReporter(zap.New(core), overlay, containment, 1, 3)
+		failed, err := reporter.RecordAudits(ctx, &report)
+		require.NoError(t, err)
+		assert.Zero(t, failed)
+
+		// the altered byte range of the failed node is logged
+		entries := logs.FilterField(zap.Stringer("Node ID", nodeID)).All()
+		require.Len(t, entries, 1)
+		fields := entries[0].ContextMap()
+		assert.EqualValues(t, 16, fields["offset"])
+		assert.EqualValues(t, 32, fields["length"])
+	})
+}
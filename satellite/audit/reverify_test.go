@@ -4,21 +4,27 @@
 package audit_test
 
 import (
+	"context"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 
 	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testblobs"
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/testplanet"
 	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/peertls/tlsopts"
 	"storj.io/storj/pkg/pkcrypto"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/pkg/transport"
 	"storj.io/storj/satellite/audit"
+	"storj.io/storj/storagenode"
 )
 
 func TestReverifySuccess(t *testing.T) {
@@ -330,8 +336,10 @@ func TestReverifyOfflineDialTimeout(t *testing.T) {
 			planet.Satellites[0].DB.Containment(),
 			planet.Satellites[0].Orders.Service,
 			planet.Satellites[0].Identity,
-			minBytesPerSecond,
-			5*time.Second)
+			audit.VerifierConfig{
+				MinBytesPerSecond:  minBytesPerSecond,
+				MinDownloadTimeout: 5 * time.Second,
+			})
 
 		pieces := stripe.Segment.GetRemote().GetRemotePieces()
 
@@ -469,3 +477,213 @@ func TestReverifyModifiedSegment(t *testing.T) {
 		require.True(t, audit.ErrContainedNotFound.Has(err))
 	})
 }
+
+func TestReverifyContainedMaxReverifyCount(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			NewStorageNodeDB: func(index int, db storagenode.DB, log *zap.Logger) (storagenode.DB, error) {
+				return testblobs.NewSlowDB(log.Named("slowdb"), db), nil
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+
+		// - uploads random data
+		// - uses the cursor to get a stripe
+		// - creates one pending audit for the node holding a piece for that stripe
+		// - makes downloads from the node time out
+		// - calls reverify and expects the node to stay contained
+		// - records the report, which increments the reverify count up to the maximum
+		// - calls reverify again and expects recording the report to fail the node
+
+		storageNodeDB := planet.StorageNodes[0].DB.(*testblobs.SlowDB)
+		audits := planet.Satellites[0].Audit.Service
+		err := audits.Close()
+		require.NoError(t, err)
+
+		ul := planet.Uplinks[0]
+		testData := testrand.Bytes(8 * memory.KiB)
+
+		err = ul.Upload(ctx, planet.Satellites[0], "testbucket", "test/path", testData)
+		require.NoError(t, err)
+
+		stripe, _, err := audits.Cursor.NextStripe(ctx)
+		require.NoError(t, err)
+
+		verifier := audit.NewVerifier(
+			planet.Satellites[0].Log.Named("verifier"),
+			planet.Satellites[0].Metainfo.Service,
+			planet.Satellites[0].Transport,
+			planet.Satellites[0].Overlay.Service,
+			planet.Satellites[0].DB.Containment(),
+			planet.Satellites[0].Orders.Service,
+			planet.Satellites[0].Identity,
+			audit.VerifierConfig{
+				MinBytesPerSecond:  100 * memory.KiB,
+				MinDownloadTimeout: 150 * time.Millisecond,
+			})
+		reporter := audit.NewReporter(planet.Satellites[0].Log.Named("reporter"), planet.Satellites[0].Overlay.Service, planet.Satellites[0].DB.Containment(), 0, 1)
+
+		nodeID := stripe.Segment.GetRemote().GetRemotePieces()[0].NodeId
+		pending := &audit.PendingAudit{
+			NodeID:            nodeID,
+			PieceID:           stripe.Segment.GetRemote().RootPieceId,
+			StripeIndex:       stripe.Index,
+			ShareSize:         stripe.Segment.GetRemote().GetRedundancy().GetErasureShareSize(),
+			ExpectedShareHash: pkcrypto.SHA256Hash(nil),
+			ReverifyCount:     0,
+			Path:              stripe.SegmentPath,
+		}
+
+		containment := planet.Satellites[0].DB.Containment()
+
+		err = containment.IncrementPending(ctx, pending)
+		require.NoError(t, err)
+
+		// make downloads on storage node slower than the timeout on the satellite for downloading shares
+		storageNodeDB.SetLatency(200 * time.Millisecond)
+
+		report, err := verifier.Reverify(ctx, stripe)
+		require.NoError(t, err)
+		require.Len(t, report.Fails, 0)
+		require.Len(t, report.PendingAudits, 1)
+		require.Equal(t, nodeID, report.PendingAudits[0].NodeID)
+
+		before, err := planet.Satellites[0].Overlay.Service.Get(ctx, nodeID)
+		require.NoError(t, err)
+
+		_, err = reporter.RecordAudits(ctx, report)
+		require.NoError(t, err)
+
+		// the node is still contained after the first reverification
+		stillPending, err := containment.Get(ctx, nodeID)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, stillPending.ReverifyCount)
+
+		// the verifier keeps reporting the node as contained, the reporter fails it
+		report, err = verifier.Reverify(ctx, stripe)
+		require.NoError(t, err)
+		require.Len(t, report.Fails, 0)
+		require.Len(t, report.PendingAudits, 1)
+		require.EqualValues(t, 1, report.PendingAudits[0].ReverifyCount)
+
+		_, err = reporter.RecordAudits(ctx, report)
+		require.NoError(t, err)
+
+		after, err := planet.Satellites[0].Overlay.Service.Get(ctx, nodeID)
+		require.NoError(t, err)
+		require.Equal(t, before.Reputation.AuditCount+1, after.Reputation.AuditCount)
+		require.Equal(t, before.Reputation.AuditSuccessCount, after.Reputation.AuditSuccessCount)
+
+		_, err = containment.Get(ctx, nodeID)
+		require.True(t, audit.ErrContainedNotFound.Has(err))
+	})
+}
+
+// cancelingFetcher is a ShareFetcher returning data for the first share and
+// canceling the reverification while downloading the second share
+type cancelingFetcher struct {
+	data   []byte
+	cancel func()
+
+	mu    sync.Mutex
+	calls int
+}
+
+func (fetcher *cancelingFetcher) FetchShare(ctx context.Context, limit *pb.AddressedOrderLimit, piecePrivateKey storj.PiecePrivateKey, stripeIndex int64, shareSize int32) ([]byte, error) {
+	fetcher.mu.Lock()
+	fetcher.calls++
+	calls := fetcher.calls
+	fetcher.mu.Unlock()
+
+	if calls > 1 {
+		fetcher.cancel()
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	return append([]byte{}, fetcher.data...), nil
+}
+
+func TestReverifyCanceled(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 5, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+
+		// - uploads random data
+		// - uses the cursor to get a stripe
+		// - creates a pending audit for every node holding a piece for that stripe
+		// - reverifies the nodes one at a time and cancels while downloading the second share
+		// - expects the first node to succeed and the other nodes to be skipped
+
+		audits := planet.Satellites[0].Audit.Service
+		err := audits.Close()
+		require.NoError(t, err)
+
+		ul := planet.Uplinks[0]
+		testData := testrand.Bytes(8 * memory.KiB)
+
+		err = ul.Upload(ctx, planet.Satellites[0], "testbucket", "test/path", testData)
+		require.NoError(t, err)
+
+		stripe, _, err := audits.Cursor.NextStripe(ctx)
+		require.NoError(t, err)
+
+		pieces := stripe.Segment.GetRemote().GetRemotePieces()
+		require.True(t, len(pieces) > 2)
+
+		reverifyCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		fetcher := &cancelingFetcher{
+			data:   testrand.Bytes(32),
+			cancel: cancel,
+		}
+
+		verifier := audit.NewVerifier(
+			planet.Satellites[0].Log.Named("verifier"),
+			planet.Satellites[0].Metainfo.Service,
+			planet.Satellites[0].Transport,
+			planet.Satellites[0].Overlay.Service,
+			planet.Satellites[0].DB.Containment(),
+			planet.Satellites[0].Orders.Service,
+			planet.Satellites[0].Identity,
+			audit.VerifierConfig{
+				MinBytesPerSecond:       100 * memory.KiB,
+				MinDownloadTimeout:      5 * time.Second,
+				MaxConcurrentReverifies: 1,
+				Fetcher:                 fetcher,
+			})
+
+		containment := planet.Satellites[0].DB.Containment()
+		for _, piece := range pieces {
+			err = containment.IncrementPending(ctx, &audit.PendingAudit{
+				NodeID:            piece.NodeId,
+				PieceID:           stripe.Segment.GetRemote().RootPieceId,
+				StripeIndex:       stripe.Index,
+				ShareSize:         stripe.Segment.GetRemote().GetRedundancy().GetErasureShareSize(),
+				ExpectedShareHash: pkcrypto.SHA256Hash(fetcher.data),
+				ReverifyCount:     0,
+				Path:              stripe.SegmentPath,
+			})
+			require.NoError(t, err)
+		}
+
+		report, err := verifier.Reverify(reverifyCtx, stripe)
+		require.NoError(t, err)
+
+		require.Len(t, report.Successes, 1)
+		require.Equal(t, pieces[0].NodeId, report.Successes[0])
+		require.Len(t, report.Fails, 0)
+		require.Len(t, report.Offlines, 0)
+		require.Len(t, report.PendingAudits, 0)
+
+		// no more shares were downloaded after the cancellation
+		require.Equal(t, 2, fetcher.calls)
+
+		// the skipped nodes stay contained
+		for _, piece := range pieces[1:] {
+			_, err = containment.Get(ctx, piece.NodeId)
+			require.NoError(t, err)
+		}
+	})
+}
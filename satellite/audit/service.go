@@ -30,11 +30,55 @@ type Config struct {
 	MinBytesPerSecond  memory.Size   `help:"the minimum acceptable bytes that storage nodes can transfer per second to the satellite" default:"128B"`
 	MinDownloadTimeout time.Duration `help:"the minimum duration for downloading a share from storage nodes before timing out" default:"25s"`
 	MaxReverifyCount   int           `help:"limit above which we consider an audit is failed" default:"3"`
+	ShareTolerance     int           `help:"the number of bytes of a share that may differ from the reconstructed share without failing the audit" default:"0"`
+	DialRetries        int           `help:"how many more times a failed dial to a storage node is attempted when downloading a share" default:"0"`
+	DialRetryBackoff   time.Duration `help:"how long to wait before attempting a failed dial to a storage node again" default:"200ms"`
+
+	MaxConcurrentAudits     int `help:"the number of segments audited at the same time on every interval" default:"1"`
+	MaxConcurrentDownloads  int `help:"the maximum number of shares downloaded at the same time for a single stripe, 0 means no limit" default:"0"`
+	MaxConcurrentReverifies int `help:"the maximum number of contained nodes reverified at the same time for a single stripe, 0 means no limit" default:"0"`
+
+	StripeBytes          memory.Size `help:"amount of segment data covered by one audited stripe, larger segments get more stripes audited, 0 audits a single stripe" default:"0"`
+	MaxStripesPerSegment int         `help:"the maximum number of stripes audited in a single segment" default:"1"`
+
+	CapacityCheckInterval time.Duration `help:"how frequently the free disk reported by nodes is checked for plausibility" default:"1h"`
+	MaxFreeDisk           memory.Size   `help:"the most free disk a node can plausibly report, nodes reporting more or a negative free disk are flagged, 0 means no limit" default:"100TB"`
+}
+
+// VerifierConfig returns the settings of the Verifier of the audit service.
+func (config Config) VerifierConfig() VerifierConfig {
+	return VerifierConfig{
+		MinBytesPerSecond:       config.MinBytesPerSecond,
+		MinDownloadTimeout:      config.MinDownloadTimeout,
+		ShareTolerance:          config.ShareTolerance,
+		DialRetries:             config.DialRetries,
+		DialRetryBackoff:        config.DialRetryBackoff,
+		MaxConcurrentDownloads:  config.MaxConcurrentDownloads,
+		MaxConcurrentReverifies: config.MaxConcurrentReverifies,
+	}
+}
+
+// StripeCount returns the number of stripes to audit in a segment of segmentSize.
+// One stripe is audited for every StripeBytes of segment data, rounded up, but
+// always at least one and at most MaxStripesPerSegment.
+func (config Config) StripeCount(segmentSize int64) int {
+	if config.StripeBytes <= 0 || config.MaxStripesPerSegment <= 1 {
+		return 1
+	}
+	count := (segmentSize + config.StripeBytes.Int64() - 1) / config.StripeBytes.Int64()
+	if count < 1 {
+		return 1
+	}
+	if count > int64(config.MaxStripesPerSegment) {
+		return config.MaxStripesPerSegment
+	}
+	return int(count)
 }
 
 // Service helps coordinate Cursor and Verifier to run the audit process continuously
 type Service struct {
-	log *zap.Logger
+	log    *zap.Logger
+	config Config
 
 	Cursor   *Cursor
 	Verifier *Verifier
@@ -47,11 +91,18 @@ type Service struct {
 func NewService(log *zap.Logger, config Config, metainfo *metainfo.Service,
 	orders *orders.Service, transport transport.Client, overlay *overlay.Cache,
 	containment Containment, identity *identity.FullIdentity) (*Service, error) {
+	if config.MaxConcurrentAudits < 1 {
+		config.MaxConcurrentAudits = 1
+	}
+
+	verifier := NewVerifier(log.Named("audit:verifier"), metainfo, transport, overlay, containment, orders, identity, config.VerifierConfig())
+
 	return &Service{
-		log: log,
+		log:    log,
+		config: config,
 
 		Cursor:   NewCursor(metainfo),
-		Verifier: NewVerifier(log.Named("audit:verifier"), metainfo, transport, overlay, containment, orders, identity, config.MinBytesPerSecond, config.MinDownloadTimeout),
+		Verifier: verifier,
 		Reporter: NewReporter(log.Named("audit:reporter"), overlay, containment, config.MaxRetriesStatDB, int32(config.MaxReverifyCount)),
 
 		Loop: *sync2.NewCycle(config.Interval),
@@ -64,10 +115,18 @@ func (service *Service) Run(ctx context.Context) (err error) {
 	service.log.Info("Audit cron is starting up")
 
 	return service.Loop.Run(ctx, func(ctx context.Context) error {
-		err := service.process(ctx)
-		if err != nil {
-			service.log.Error("process", zap.Error(err))
+		// audit MaxConcurrentAudits segments at the same time, waiting for
+		// all of them so that stopping the loop waits for running audits
+		limiter := sync2.NewLimiter(service.config.MaxConcurrentAudits)
+		for i := 0; i < service.config.MaxConcurrentAudits; i++ {
+			limiter.Go(ctx, func() {
+				err := service.process(ctx)
+				if err != nil {
+					service.log.Error("process", zap.Error(err))
+				}
+			})
 		}
+		limiter.Wait()
 		return nil
 	})
 }
@@ -126,16 +185,116 @@ func (service *Service) process(ctx context.Context) (err error) {
 		}
 	}
 
-	report, err = service.Verifier.Verify(ctx, stripe, skip)
+	// larger segments get more of their stripes audited
+	stripes := []*Stripe{stripe}
+	indexes, err := getRandomStripes(ctx, stripe.Segment, service.config.StripeCount(stripe.Segment.GetSegmentSize())-1, stripe.Index)
 	if err != nil {
 		errlist.Add(err)
 	}
+	for _, index := range indexes {
+		stripes = append(stripes, &Stripe{
+			Index:       index,
+			Segment:     stripe.Segment,
+			SegmentPath: stripe.SegmentPath,
+		})
+	}
+
+	// the outcomes of all stripes are combined, so that every node is audited
+	// once per segment
+	var reports []*Report
+	for _, stripe := range stripes {
+		report, verifyErr := service.Verifier.Verify(ctx, stripe, skip)
+		if verifyErr != nil {
+			errlist.Add(verifyErr)
+		}
+		if report != nil {
+			reports = append(reports, report)
+
+			// nodes which didn't succeed aren't asked for more shares
+			for _, nodeID := range report.Offlines {
+				skip[nodeID] = true
+			}
+			for _, nodeID := range report.Fails {
+				skip[nodeID] = true
+			}
+			for _, pending := range report.PendingAudits {
+				skip[pending.NodeID] = true
+			}
+		}
+
+		if ErrSegmentDeleted.Has(verifyErr) {
+			break
+		}
+	}
 
 	// TODO(moby) we need to decide if we want to do something with nodes that the reporter failed to update
-	_, err = service.Reporter.RecordAudits(ctx, report)
+	_, err = service.Reporter.RecordAudits(ctx, combineReports(reports))
 	if err != nil {
 		errlist.Add(err)
 	}
 
 	return errlist.Err()
 }
+
+// combineReports combines the reports of the stripes of a segment into a report
+// with one outcome per node. Every node keeps its worst outcome: failed, then
+// pending, then offline and only then successful.
+func combineReports(reports []*Report) *Report {
+	if len(reports) == 0 {
+		return nil
+	}
+
+	combined := &Report{}
+	reported := make(map[storj.NodeID]bool)
+	add := func(list storj.NodeIDList, nodeID storj.NodeID) storj.NodeIDList {
+		if reported[nodeID] {
+			return list
+		}
+		reported[nodeID] = true
+		return append(list, nodeID)
+	}
+
+	for _, report := range reports {
+		for _, nodeID := range report.Fails {
+			combined.Fails = add(combined.Fails, nodeID)
+		}
+		for nodeID, corruption := range report.Corruptions {
+			if combined.Corruptions == nil {
+				combined.Corruptions = make(map[storj.NodeID]*Corruption)
+			}
+			if _, ok := combined.Corruptions[nodeID]; !ok {
+				combined.Corruptions[nodeID] = corruption
+			}
+		}
+	}
+	for _, report := range reports {
+		for _, pending := range report.PendingAudits {
+			if !reported[pending.NodeID] {
+				reported[pending.NodeID] = true
+				combined.PendingAudits = append(combined.PendingAudits, pending)
+			}
+		}
+	}
+	for _, report := range reports {
+		for _, nodeID := range report.Offlines {
+			combined.Offlines = add(combined.Offlines, nodeID)
+		}
+	}
+	for _, report := range reports {
+		for _, nodeID := range report.Successes {
+			combined.Successes = add(combined.Successes, nodeID)
+		}
+	}
+
+	contained := make(map[storj.NodeID]bool)
+	for _, report := range reports {
+		for _, nodeID := range report.Contained {
+			if !contained[nodeID] {
+				contained[nodeID] = true
+				combined.Contained = append(combined.Contained, nodeID)
+			}
+		}
+	}
+
+	return combined
+}
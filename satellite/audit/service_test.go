@@ -0,0 +1,316 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vivint/infectious"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testidentity"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/signing"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/orders"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+	"storj.io/storj/storage/teststore"
+)
+
+// concurrencyFetcher tracks how many shares of a single node are fetched at
+// the same time. Every fetch of the node waits a while for wait fetches to
+// overlap, so that concurrent audits are noticed.
+type concurrencyFetcher struct {
+	*fakeFetcher
+	node storj.NodeID
+	wait int
+
+	mu      sync.Mutex
+	current int
+	max     int
+	fetched int
+}
+
+func (fetcher *concurrencyFetcher) FetchShare(ctx context.Context, limit *pb.AddressedOrderLimit, piecePrivateKey storj.PiecePrivateKey, stripeIndex int64, shareSize int32) ([]byte, error) {
+	if limit.GetLimit().StorageNodeId == fetcher.node {
+		fetcher.mu.Lock()
+		fetcher.current++
+		fetcher.fetched++
+		if fetcher.current > fetcher.max {
+			fetcher.max = fetcher.current
+		}
+		fetcher.mu.Unlock()
+
+		for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); {
+			fetcher.mu.Lock()
+			overlapped := fetcher.max >= fetcher.wait
+			fetcher.mu.Unlock()
+			if overlapped {
+				break
+			}
+			time.Sleep(time.Millisecond)
+		}
+
+		fetcher.mu.Lock()
+		fetcher.current--
+		fetcher.mu.Unlock()
+	}
+	return fetcher.fakeFetcher.FetchShare(ctx, limit, piecePrivateKey, stripeIndex, shareSize)
+}
+
+// stripeFetcher records the stripes of the shares fetched from every node
+type stripeFetcher struct {
+	*fakeFetcher
+
+	mu      sync.Mutex
+	stripes map[storj.NodeID][]int64
+}
+
+func (fetcher *stripeFetcher) FetchShare(ctx context.Context, limit *pb.AddressedOrderLimit, piecePrivateKey storj.PiecePrivateKey, stripeIndex int64, shareSize int32) ([]byte, error) {
+	nodeID := limit.GetLimit().StorageNodeId
+
+	fetcher.mu.Lock()
+	fetcher.stripes[nodeID] = append(fetcher.stripes[nodeID], stripeIndex)
+	fetcher.mu.Unlock()
+
+	return fetcher.fakeFetcher.FetchShare(ctx, limit, piecePrivateKey, stripeIndex, shareSize)
+}
+
+func TestServiceMaxConcurrentAudits(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		const (
+			required      = 1
+			total         = 2
+			shareSize     = 256
+			maxConcurrent = 3
+		)
+
+		log := zaptest.NewLogger(t)
+		id := testidentity.MustPregeneratedIdentity(0, storj.LatestIDVersion())
+
+		cache := overlay.NewCache(log, db.OverlayCache(), overlay.Config{
+			Node:                 overlay.NodeSelectionConfig{OnlineWindow: time.Hour},
+			UpdateStatsBatchSize: 100,
+		})
+		metainfoService := metainfo.NewService(log, teststore.New(), db.Buckets())
+		ordersService := orders.NewService(log, signing.SignerFromFullIdentity(id), cache, db.Orders(), time.Hour, &pb.NodeAddress{}, 0.05)
+
+		service, err := audit.NewService(log, audit.Config{
+			MinBytesPerSecond:   128 * memory.B,
+			MinDownloadTimeout:  time.Second,
+			MaxReverifyCount:    3,
+			Interval:            time.Hour,
+			MaxConcurrentAudits: maxConcurrent,
+		}, metainfoService, ordersService, nil, cache, db.Containment(), id)
+		require.NoError(t, err)
+
+		fec, err := infectious.NewFEC(required, total)
+		require.NoError(t, err)
+
+		shares := make(map[int][]byte)
+		err = fec.Encode(testrand.Bytes(required*shareSize), func(share infectious.Share) {
+			shares[share.Number] = append([]byte{}, share.Data...)
+		})
+		require.NoError(t, err)
+
+		fetcher := &concurrencyFetcher{
+			fakeFetcher: &fakeFetcher{
+				shares: make(map[storj.NodeID][]byte),
+				errors: make(map[storj.NodeID]error),
+			},
+			wait: maxConcurrent,
+		}
+
+		var pieces []*pb.RemotePiece
+		for i := 0; i < total; i++ {
+			nodeID := testrand.NodeID()
+			err := cache.Put(ctx, nodeID, pb.Node{Id: nodeID, Address: &pb.NodeAddress{Address: "127.0.0.1:0"}})
+			require.NoError(t, err)
+			_, err = cache.UpdateUptime(ctx, nodeID, true)
+			require.NoError(t, err)
+
+			pieces = append(pieces, &pb.RemotePiece{PieceNum: int32(i), NodeId: nodeID})
+			fetcher.shares[nodeID] = shares[i]
+		}
+		// every audit fetches exactly one share from this node
+		fetcher.node = pieces[0].NodeId
+		service.Verifier = audit.NewVerifier(log, metainfoService, nil, cache, db.Containment(), ordersService, id, audit.VerifierConfig{
+			MinBytesPerSecond:  128 * memory.B,
+			MinDownloadTimeout: time.Second,
+			Fetcher:            fetcher,
+		})
+
+		path := storj.JoinPaths(testrand.UUID().String(), "s0", "bucket", "object")
+		err = metainfoService.Put(ctx, path, &pb.Pointer{
+			Type: pb.Pointer_REMOTE,
+			Remote: &pb.RemoteSegment{
+				RootPieceId: testrand.PieceID(),
+				Redundancy: &pb.RedundancyScheme{
+					Type:             pb.RedundancyScheme_RS,
+					MinReq:           required,
+					Total:            total,
+					RepairThreshold:  required,
+					SuccessThreshold: total,
+					ErasureShareSize: shareSize,
+				},
+				RemotePieces: pieces,
+			},
+			SegmentSize: int64(required * shareSize),
+		})
+		require.NoError(t, err)
+
+		// the loop audits once when it starts and once more when triggered
+		ctx.Go(func() error {
+			return service.Run(ctx)
+		})
+		service.Loop.TriggerWait()
+		service.Loop.Stop()
+
+		// every cycle audits the segment maxConcurrent times at once
+		assert.Equal(t, 2*maxConcurrent, fetcher.fetched)
+		assert.Equal(t, maxConcurrent, fetcher.max)
+	})
+}
+
+func TestServiceAuditsMultipleStripes(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		const (
+			required   = 2
+			total      = 6
+			shareSize  = 256
+			stripeSize = required * shareSize
+			numStripes = 8
+			maxStripes = 3
+		)
+
+		log := zaptest.NewLogger(t)
+		id := testidentity.MustPregeneratedIdentity(0, storj.LatestIDVersion())
+
+		cache := overlay.NewCache(log, db.OverlayCache(), overlay.Config{
+			Node:                 overlay.NodeSelectionConfig{OnlineWindow: time.Hour},
+			UpdateStatsBatchSize: 100,
+		})
+		metainfoService := metainfo.NewService(log, teststore.New(), db.Buckets())
+		ordersService := orders.NewService(log, signing.SignerFromFullIdentity(id), cache, db.Orders(), time.Hour, &pb.NodeAddress{}, 0.05)
+
+		// the segment is large enough for 4 stripes to be audited, but it is capped to maxStripes
+		service, err := audit.NewService(log, audit.Config{
+			MinBytesPerSecond:    128 * memory.B,
+			MinDownloadTimeout:   time.Second,
+			MaxReverifyCount:     3,
+			Interval:             time.Hour,
+			StripeBytes:          2 * stripeSize,
+			MaxStripesPerSegment: maxStripes,
+		}, metainfoService, ordersService, nil, cache, db.Containment(), id)
+		require.NoError(t, err)
+
+		fec, err := infectious.NewFEC(required, total)
+		require.NoError(t, err)
+
+		shares := make(map[int][]byte)
+		err = fec.Encode(testrand.Bytes(stripeSize), func(share infectious.Share) {
+			shares[share.Number] = append([]byte{}, share.Data...)
+		})
+		require.NoError(t, err)
+
+		fetcher := &stripeFetcher{
+			fakeFetcher: &fakeFetcher{
+				shares: make(map[storj.NodeID][]byte),
+				errors: make(map[storj.NodeID]error),
+			},
+			stripes: make(map[storj.NodeID][]int64),
+		}
+
+		var pieces []*pb.RemotePiece
+		for i := 0; i < total; i++ {
+			nodeID := testrand.NodeID()
+			err := cache.Put(ctx, nodeID, pb.Node{Id: nodeID, Address: &pb.NodeAddress{Address: "127.0.0.1:0"}})
+			require.NoError(t, err)
+			_, err = cache.UpdateUptime(ctx, nodeID, true)
+			require.NoError(t, err)
+
+			pieces = append(pieces, &pb.RemotePiece{PieceNum: int32(i), NodeId: nodeID})
+			fetcher.shares[nodeID] = shares[i]
+		}
+
+		// piece 1 is corrupted in every stripe
+		corrupted := pieces[1].NodeId
+		fetcher.shares[corrupted][0]++
+
+		service.Verifier = audit.NewVerifier(log, metainfoService, nil, cache, db.Containment(), ordersService, id, audit.VerifierConfig{
+			MinBytesPerSecond:  128 * memory.B,
+			MinDownloadTimeout: time.Second,
+			Fetcher:            fetcher,
+		})
+
+		path := storj.JoinPaths(testrand.UUID().String(), "s0", "bucket", "object")
+		err = metainfoService.Put(ctx, path, &pb.Pointer{
+			Type: pb.Pointer_REMOTE,
+			Remote: &pb.RemoteSegment{
+				RootPieceId: testrand.PieceID(),
+				Redundancy: &pb.RedundancyScheme{
+					Type:             pb.RedundancyScheme_RS,
+					MinReq:           required,
+					Total:            total,
+					RepairThreshold:  required + 1,
+					SuccessThreshold: total,
+					ErasureShareSize: shareSize,
+				},
+				RemotePieces: pieces,
+			},
+			SegmentSize: numStripes * stripeSize,
+		})
+		require.NoError(t, err)
+
+		// a stopped loop audits a single time when it is run
+		service.Loop.Stop()
+		require.NoError(t, service.Run(ctx))
+
+		for _, piece := range pieces {
+			stripes := fetcher.stripes[piece.NodeId]
+			if piece.NodeId == corrupted {
+				// the failed node is skipped for the remaining stripes
+				assert.Len(t, stripes, 1)
+			} else {
+				assert.Len(t, stripes, maxStripes)
+			}
+
+			distinct := make(map[int64]bool)
+			for _, index := range stripes {
+				assert.True(t, index >= 0 && index < numStripes, "stripe %d", index)
+				distinct[index] = true
+			}
+			assert.Len(t, distinct, len(stripes))
+
+			// every node is audited once per segment, however many of its
+			// stripes are verified
+			dossier, err := cache.Get(ctx, piece.NodeId)
+			require.NoError(t, err)
+			assert.EqualValues(t, 1, dossier.Reputation.AuditCount)
+			if piece.NodeId == corrupted {
+				assert.EqualValues(t, 1, dossier.Reputation.AuditFailureStreak)
+			} else {
+				assert.EqualValues(t, 0, dossier.Reputation.AuditFailureStreak)
+			}
+		}
+	})
+}
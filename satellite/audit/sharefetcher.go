@@ -0,0 +1,90 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+	"storj.io/storj/uplink/piecestore"
+)
+
+// ShareFetcher downloads a single share of a piece from a storage node.
+//
+// Errors returned by FetchShare are classified by the Verifier in the same
+// way as errors from piecestore, e.g. a NotFound RPC error fails the node.
+type ShareFetcher interface {
+	FetchShare(ctx context.Context, limit *pb.AddressedOrderLimit, piecePrivateKey storj.PiecePrivateKey, stripeIndex int64, shareSize int32) ([]byte, error)
+}
+
+// piecestoreFetcher is the default ShareFetcher, which downloads shares using piecestore
+type piecestoreFetcher struct {
+	log       *zap.Logger
+	transport transport.Client
+
+	// dialRetries is how many more times a failed dial is attempted, waiting dialBackoff in between
+	dialRetries int
+	dialBackoff time.Duration
+}
+
+// FetchShare dials the storage node of limit and downloads the share at stripeIndex
+func (fetcher *piecestoreFetcher) FetchShare(ctx context.Context, limit *pb.AddressedOrderLimit, piecePrivateKey storj.PiecePrivateKey, stripeIndex int64, shareSize int32) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	storageNodeID := limit.GetLimit().StorageNodeId
+	log := fetcher.log.Named(storageNodeID.String())
+	target := &pb.Node{Id: storageNodeID, Address: limit.GetStorageNodeAddress()}
+
+	ps, err := fetcher.dial(ctx, target, log)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() {
+		err := ps.Close()
+		if err != nil {
+			fetcher.log.Error("audit verifier failed to close conn to node: %+v", zap.Error(err))
+		}
+	}()
+
+	offset := int64(shareSize) * stripeIndex
+
+	downloader, err := ps.Download(ctx, limit.GetLimit(), piecePrivateKey, offset, int64(shareSize))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, downloader.Close()) }()
+
+	buf := make([]byte, shareSize)
+	_, err = io.ReadFull(downloader, buf)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
+
+// dial dials the storage node, retrying failed dials up to dialRetries times.
+// Only dialing is retried: downloading the share again could hide a missing
+// piece. The retries stop when ctx is done, returning the last dial error.
+func (fetcher *piecestoreFetcher) dial(ctx context.Context, target *pb.Node, log *zap.Logger) (ps *piecestore.Client, err error) {
+	for attempt := 0; ; attempt++ {
+		ps, err = piecestore.Dial(ctx, fetcher.transport, target, log, piecestore.DefaultConfig)
+		if err == nil || attempt >= fetcher.dialRetries || ctx.Err() != nil {
+			return ps, err
+		}
+
+		log.Debug("dial failed, retrying", zap.Int("attempt", attempt+1), zap.Error(err))
+		if !sync2.Sleep(ctx, fetcher.dialBackoff) {
+			return nil, err
+		}
+	}
+}
@@ -0,0 +1,409 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vivint/infectious"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testidentity"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pkcrypto"
+	"storj.io/storj/pkg/signing"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/orders"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+	"storj.io/storj/storage/teststore"
+)
+
+// fakeFetcher is a ShareFetcher returning predefined shares or errors per node
+type fakeFetcher struct {
+	shares map[storj.NodeID][]byte
+	errors map[storj.NodeID]error
+}
+
+func (fetcher *fakeFetcher) FetchShare(ctx context.Context, limit *pb.AddressedOrderLimit, piecePrivateKey storj.PiecePrivateKey, stripeIndex int64, shareSize int32) ([]byte, error) {
+	nodeID := limit.GetLimit().StorageNodeId
+	if err, ok := fetcher.errors[nodeID]; ok {
+		return nil, err
+	}
+	return append([]byte{}, fetcher.shares[nodeID]...), nil
+}
+
+func TestVerifyWithShareFetcher(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		const (
+			required  = 2
+			total     = 6
+			shareSize = 256
+		)
+
+		log := zaptest.NewLogger(t)
+		id := testidentity.MustPregeneratedIdentity(0, storj.LatestIDVersion())
+
+		cache := overlay.NewCache(log, db.OverlayCache(), overlay.Config{
+			Node: overlay.NodeSelectionConfig{OnlineWindow: time.Hour},
+		})
+		metainfoService := metainfo.NewService(log, teststore.New(), db.Buckets())
+		ordersService := orders.NewService(log, signing.SignerFromFullIdentity(id), cache, db.Orders(), time.Hour, &pb.NodeAddress{}, 0.05)
+
+		fec, err := infectious.NewFEC(required, total)
+		require.NoError(t, err)
+
+		shares := make(map[int][]byte)
+		err = fec.Encode(testrand.Bytes(required*shareSize), func(share infectious.Share) {
+			shares[share.Number] = append([]byte{}, share.Data...)
+		})
+		require.NoError(t, err)
+
+		fetcher := &fakeFetcher{
+			shares: make(map[storj.NodeID][]byte),
+			errors: make(map[storj.NodeID]error),
+		}
+
+		var pieces []*pb.RemotePiece
+		for i := 0; i < total; i++ {
+			nodeID := testrand.NodeID()
+			err := cache.Put(ctx, nodeID, pb.Node{Id: nodeID, Address: &pb.NodeAddress{Address: "127.0.0.1:0"}})
+			require.NoError(t, err)
+			_, err = cache.UpdateUptime(ctx, nodeID, true)
+			require.NoError(t, err)
+
+			pieces = append(pieces, &pb.RemotePiece{PieceNum: int32(i), NodeId: nodeID})
+			fetcher.shares[nodeID] = shares[i]
+		}
+
+		// piece 1 is corrupted, piece 2 times out during download
+		fetcher.shares[pieces[1].NodeId][0]++
+		fetcher.errors[pieces[2].NodeId] = status.Error(codes.DeadlineExceeded, "download timeout")
+
+		path := storj.JoinPaths(testrand.UUID().String(), "s0", "bucket", "object")
+		err = metainfoService.Put(ctx, path, &pb.Pointer{
+			Type: pb.Pointer_REMOTE,
+			Remote: &pb.RemoteSegment{
+				RootPieceId: testrand.PieceID(),
+				Redundancy: &pb.RedundancyScheme{
+					Type:             pb.RedundancyScheme_RS,
+					MinReq:           required,
+					Total:            total,
+					RepairThreshold:  required + 1,
+					SuccessThreshold: total,
+					ErasureShareSize: shareSize,
+				},
+				RemotePieces: pieces,
+			},
+			SegmentSize: int64(required * shareSize),
+		})
+		require.NoError(t, err)
+
+		pointer, err := metainfoService.Get(ctx, path)
+		require.NoError(t, err)
+
+		verifier := audit.NewVerifier(log, metainfoService, nil, cache, db.Containment(), ordersService, id, audit.VerifierConfig{MinBytesPerSecond: 128 * memory.B, MinDownloadTimeout: time.Second, Fetcher: fetcher})
+
+		report, err := verifier.Verify(ctx, &audit.Stripe{Index: 0, Segment: pointer, SegmentPath: path}, nil)
+		require.NoError(t, err)
+
+		assert.ElementsMatch(t, storj.NodeIDList{pieces[0].NodeId, pieces[3].NodeId, pieces[4].NodeId, pieces[5].NodeId}, report.Successes)
+		assert.Equal(t, storj.NodeIDList{pieces[1].NodeId}, report.Fails)
+		assert.Len(t, report.Offlines, 0)
+		require.Len(t, report.PendingAudits, 1)
+		assert.Equal(t, pieces[2].NodeId, report.PendingAudits[0].NodeID)
+		assert.Equal(t, pkcrypto.SHA256Hash(shares[2]), report.PendingAudits[0].ExpectedShareHash)
+		assert.Equal(t, map[storj.NodeID]*audit.Corruption{
+			pieces[1].NodeId: {Offset: 0, Length: 1},
+		}, report.Corruptions)
+	})
+}
+
+func TestVerifyCorruptionPerNode(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		const (
+			required  = 2
+			total     = 6
+			shareSize = 256
+		)
+
+		log := zaptest.NewLogger(t)
+		id := testidentity.MustPregeneratedIdentity(0, storj.LatestIDVersion())
+
+		cache := overlay.NewCache(log, db.OverlayCache(), overlay.Config{
+			Node: overlay.NodeSelectionConfig{OnlineWindow: time.Hour},
+		})
+		metainfoService := metainfo.NewService(log, teststore.New(), db.Buckets())
+		ordersService := orders.NewService(log, signing.SignerFromFullIdentity(id), cache, db.Orders(), time.Hour, &pb.NodeAddress{}, 0.05)
+
+		fec, err := infectious.NewFEC(required, total)
+		require.NoError(t, err)
+
+		shares := make(map[int][]byte)
+		err = fec.Encode(testrand.Bytes(required*shareSize), func(share infectious.Share) {
+			shares[share.Number] = append([]byte{}, share.Data...)
+		})
+		require.NoError(t, err)
+
+		fetcher := &fakeFetcher{
+			shares: make(map[storj.NodeID][]byte),
+			errors: make(map[storj.NodeID]error),
+		}
+
+		var pieces []*pb.RemotePiece
+		for i := 0; i < total; i++ {
+			nodeID := testrand.NodeID()
+			err := cache.Put(ctx, nodeID, pb.Node{Id: nodeID, Address: &pb.NodeAddress{Address: "127.0.0.1:0"}})
+			require.NoError(t, err)
+			_, err = cache.UpdateUptime(ctx, nodeID, true)
+			require.NoError(t, err)
+
+			pieces = append(pieces, &pb.RemotePiece{PieceNum: int32(i), NodeId: nodeID})
+			fetcher.shares[nodeID] = shares[i]
+		}
+
+		// the shares of two nodes are altered at different offsets
+		fetcher.shares[pieces[1].NodeId][10]++
+		fetcher.shares[pieces[1].NodeId][12]++
+		fetcher.shares[pieces[4].NodeId][200]++
+
+		path := storj.JoinPaths(testrand.UUID().String(), "s0", "bucket", "object")
+		err = metainfoService.Put(ctx, path, &pb.Pointer{
+			Type: pb.Pointer_REMOTE,
+			Remote: &pb.RemoteSegment{
+				RootPieceId: testrand.PieceID(),
+				Redundancy: &pb.RedundancyScheme{
+					Type:             pb.RedundancyScheme_RS,
+					MinReq:           required,
+					Total:            total,
+					RepairThreshold:  required + 1,
+					SuccessThreshold: total,
+					ErasureShareSize: shareSize,
+				},
+				RemotePieces: pieces,
+			},
+			SegmentSize: int64(required * shareSize),
+		})
+		require.NoError(t, err)
+
+		pointer, err := metainfoService.Get(ctx, path)
+		require.NoError(t, err)
+
+		verifier := audit.NewVerifier(log, metainfoService, nil, cache, db.Containment(), ordersService, id, audit.VerifierConfig{MinBytesPerSecond: 128 * memory.B, MinDownloadTimeout: time.Second, Fetcher: fetcher})
+
+		report, err := verifier.Verify(ctx, &audit.Stripe{Index: 0, Segment: pointer, SegmentPath: path}, nil)
+		require.NoError(t, err)
+
+		assert.ElementsMatch(t, storj.NodeIDList{pieces[1].NodeId, pieces[4].NodeId}, report.Fails)
+		assert.Equal(t, map[storj.NodeID]*audit.Corruption{
+			pieces[1].NodeId: {Offset: 10, Length: 3},
+			pieces[4].NodeId: {Offset: 200, Length: 1},
+		}, report.Corruptions)
+		assert.Len(t, report.PendingAudits, 0)
+	})
+}
+
+// recordingSink records the counts of audit outcomes by name
+type recordingSink struct {
+	counts map[string]int64
+}
+
+func (sink *recordingSink) Count(name string, value int64) {
+	sink.counts[name] += value
+}
+
+func TestVerifierMetricsSink(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		const (
+			required  = 2
+			total     = 5
+			shareSize = 256
+		)
+
+		log := zaptest.NewLogger(t)
+		id := testidentity.MustPregeneratedIdentity(0, storj.LatestIDVersion())
+
+		cache := overlay.NewCache(log, db.OverlayCache(), overlay.Config{
+			Node: overlay.NodeSelectionConfig{OnlineWindow: time.Hour},
+		})
+		metainfoService := metainfo.NewService(log, teststore.New(), db.Buckets())
+		ordersService := orders.NewService(log, signing.SignerFromFullIdentity(id), cache, db.Orders(), time.Hour, &pb.NodeAddress{}, 0.05)
+
+		fec, err := infectious.NewFEC(required, total)
+		require.NoError(t, err)
+
+		shares := make(map[int][]byte)
+		err = fec.Encode(testrand.Bytes(required*shareSize), func(share infectious.Share) {
+			shares[share.Number] = append([]byte{}, share.Data...)
+		})
+		require.NoError(t, err)
+
+		fetcher := &fakeFetcher{
+			shares: make(map[storj.NodeID][]byte),
+			errors: make(map[storj.NodeID]error),
+		}
+
+		var pieces []*pb.RemotePiece
+		for i := 0; i < total; i++ {
+			nodeID := testrand.NodeID()
+			err := cache.Put(ctx, nodeID, pb.Node{Id: nodeID, Address: &pb.NodeAddress{Address: "127.0.0.1:0"}})
+			require.NoError(t, err)
+			_, err = cache.UpdateUptime(ctx, nodeID, true)
+			require.NoError(t, err)
+
+			pieces = append(pieces, &pb.RemotePiece{PieceNum: int32(i), NodeId: nodeID})
+			fetcher.shares[nodeID] = shares[i]
+		}
+
+		// piece 1 is corrupted, piece 2 times out during download
+		fetcher.shares[pieces[1].NodeId][0]++
+		fetcher.errors[pieces[2].NodeId] = status.Error(codes.DeadlineExceeded, "download timeout")
+
+		path := storj.JoinPaths(testrand.UUID().String(), "s0", "bucket", "object")
+		err = metainfoService.Put(ctx, path, &pb.Pointer{
+			Type: pb.Pointer_REMOTE,
+			Remote: &pb.RemoteSegment{
+				RootPieceId: testrand.PieceID(),
+				Redundancy: &pb.RedundancyScheme{
+					Type:             pb.RedundancyScheme_RS,
+					MinReq:           required,
+					Total:            total,
+					RepairThreshold:  required + 1,
+					SuccessThreshold: total,
+					ErasureShareSize: shareSize,
+				},
+				RemotePieces: pieces,
+			},
+			SegmentSize: int64(required * shareSize),
+		})
+		require.NoError(t, err)
+
+		pointer, err := metainfoService.Get(ctx, path)
+		require.NoError(t, err)
+
+		sink := &recordingSink{counts: make(map[string]int64)}
+		verifier := audit.NewVerifier(log, metainfoService, nil, cache, db.Containment(), ordersService, id, audit.VerifierConfig{MinBytesPerSecond: 128 * memory.B, MinDownloadTimeout: time.Second, Fetcher: fetcher, Metrics: sink})
+
+		report, err := verifier.Verify(ctx, &audit.Stripe{Index: 0, Segment: pointer, SegmentPath: path}, nil)
+		require.NoError(t, err)
+		require.Len(t, report.Successes, 3)
+		require.Len(t, report.Fails, 1)
+		require.Len(t, report.PendingAudits, 1)
+
+		// the sink receives the values observed by monkit
+		assert.Equal(t, map[string]int64{
+			"audit_success_nodes":       int64(len(report.Successes)),
+			"audit_fail_nodes":          int64(len(report.Fails)),
+			"audit_offline_nodes":       int64(len(report.Offlines)),
+			"audit_contained_nodes":     int64(len(report.PendingAudits)),
+			"audit_total_nodes":         total,
+			"audit_total_pointer_nodes": total,
+		}, sink.counts)
+	})
+}
+
+func TestVerifyNotEnoughSharesReportsBreakdown(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		const (
+			required  = 3
+			total     = 6
+			shareSize = 256
+		)
+
+		log := zaptest.NewLogger(t)
+		id := testidentity.MustPregeneratedIdentity(0, storj.LatestIDVersion())
+
+		cache := overlay.NewCache(log, db.OverlayCache(), overlay.Config{
+			Node: overlay.NodeSelectionConfig{OnlineWindow: time.Hour},
+		})
+		metainfoService := metainfo.NewService(log, teststore.New(), db.Buckets())
+		ordersService := orders.NewService(log, signing.SignerFromFullIdentity(id), cache, db.Orders(), time.Hour, &pb.NodeAddress{}, 0.05)
+
+		fetcher := &fakeFetcher{
+			shares: make(map[storj.NodeID][]byte),
+			errors: make(map[storj.NodeID]error),
+		}
+
+		var pieces []*pb.RemotePiece
+		for i := 0; i < total; i++ {
+			nodeID := testrand.NodeID()
+
+			// piece 0 is on a node unknown to the overlay, so no order limit can be created for it
+			if i > 0 {
+				err := cache.Put(ctx, nodeID, pb.Node{Id: nodeID, Address: &pb.NodeAddress{Address: "127.0.0.1:0"}})
+				require.NoError(t, err)
+				_, err = cache.UpdateUptime(ctx, nodeID, true)
+				require.NoError(t, err)
+			}
+
+			pieces = append(pieces, &pb.RemotePiece{PieceNum: int32(i), NodeId: nodeID})
+			fetcher.shares[nodeID] = testrand.BytesInt(shareSize)
+		}
+
+		// piece 1 is missing, pieces 2 and 3 time out and piece 4 fails unexpectedly,
+		// which leaves only piece 5 to audit
+		fetcher.errors[pieces[1].NodeId] = status.Error(codes.NotFound, "piece not found")
+		fetcher.errors[pieces[2].NodeId] = status.Error(codes.DeadlineExceeded, "download timeout")
+		fetcher.errors[pieces[3].NodeId] = status.Error(codes.DeadlineExceeded, "download timeout")
+		fetcher.errors[pieces[4].NodeId] = status.Error(codes.Internal, "unexpected")
+
+		path := storj.JoinPaths(testrand.UUID().String(), "s0", "bucket", "object")
+		err := metainfoService.Put(ctx, path, &pb.Pointer{
+			Type: pb.Pointer_REMOTE,
+			Remote: &pb.RemoteSegment{
+				RootPieceId: testrand.PieceID(),
+				Redundancy: &pb.RedundancyScheme{
+					Type:             pb.RedundancyScheme_RS,
+					MinReq:           required,
+					Total:            total,
+					RepairThreshold:  required + 1,
+					SuccessThreshold: total,
+					ErasureShareSize: shareSize,
+				},
+				RemotePieces: pieces,
+			},
+			SegmentSize: int64(required * shareSize),
+		})
+		require.NoError(t, err)
+
+		pointer, err := metainfoService.Get(ctx, path)
+		require.NoError(t, err)
+
+		verifier := audit.NewVerifier(log, metainfoService, nil, cache, db.Containment(), ordersService, id, audit.VerifierConfig{MinBytesPerSecond: 128 * memory.B, MinDownloadTimeout: time.Second, Fetcher: fetcher})
+
+		report, err := verifier.Verify(ctx, &audit.Stripe{Index: 0, Segment: pointer, SegmentPath: path}, nil)
+		require.True(t, audit.ErrNotEnoughShares.Has(err), "unexpected error: %+v", err)
+		require.NotNil(t, report)
+
+		assert.Len(t, report.Successes, 0)
+		assert.Equal(t, storj.NodeIDList{pieces[0].NodeId}, report.Offlines)
+		assert.Equal(t, storj.NodeIDList{pieces[1].NodeId}, report.Fails)
+		assert.Equal(t, storj.NodeIDList{pieces[2].NodeId, pieces[3].NodeId, pieces[4].NodeId}, report.Contained)
+		assert.Len(t, report.PendingAudits, 0)
+	})
+}
@@ -6,7 +6,8 @@ package audit
 import (
 	"bytes"
 	"context"
-	"io"
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/vivint/infectious"
@@ -26,7 +27,6 @@ import (
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/storage"
-	"storj.io/storj/uplink/piecestore"
 )
 
 var (
@@ -57,10 +57,74 @@ type Verifier struct {
 	containment        Containment
 	minBytesPerSecond  memory.Size
 	minDownloadTimeout time.Duration
+	fetcher            ShareFetcher
+	shareTolerance     int
+	metrics            MetricsSink
+
+	// maxConcurrentDownloads caps the shares downloaded at the same time by DownloadShares, 0 means no limit
+	maxConcurrentDownloads int
+	// maxConcurrentReverifies caps the contained nodes reverified at the same time by Reverify, 0 means no limit
+	maxConcurrentReverifies int
 }
 
-// NewVerifier creates a Verifier
-func NewVerifier(log *zap.Logger, metainfo *metainfo.Service, transport transport.Client, overlay *overlay.Cache, containment Containment, orders *orders.Service, id *identity.FullIdentity, minBytesPerSecond memory.Size, minDownloadTimeout time.Duration) *Verifier {
+// MetricsSink receives the outcome counters of audits and reverifications
+// alongside monkit, for reporting them to another metrics system.
+type MetricsSink interface {
+	// Count is called with the number of nodes of an outcome of a single
+	// audit or reverification. The name is the one of the monkit value.
+	Count(name string, value int64)
+}
+
+// VerifierConfig contains the settings of a Verifier.
+type VerifierConfig struct {
+	// MinBytesPerSecond and MinDownloadTimeout determine the time allotted for
+	// downloading a share. It is the share size divided by MinBytesPerSecond,
+	// but never less than MinDownloadTimeout. A non-positive MinDownloadTimeout
+	// is replaced with defaultMinDownloadTimeout, so that tiny shares never end
+	// up with a zero or negative timeout.
+	MinBytesPerSecond  memory.Size
+	MinDownloadTimeout time.Duration
+
+	// ShareTolerance is how many bytes of a share may differ from the corrected
+	// share without its node failing the audit, 0 fails nodes for any altered byte.
+	ShareTolerance int
+
+	// DialRetries is how many more times the default ShareFetcher attempts a
+	// failed dial to a storage node, waiting DialRetryBackoff in between. The
+	// retries never exceed the time allotted for downloading a share.
+	DialRetries      int
+	DialRetryBackoff time.Duration
+
+	// MaxConcurrentDownloads caps the shares downloaded at the same time by DownloadShares, 0 means no limit
+	MaxConcurrentDownloads int
+	// MaxConcurrentReverifies caps the contained nodes reverified at the same time by Reverify, 0 means no limit
+	MaxConcurrentReverifies int
+
+	// Fetcher downloads shares from storage nodes, nil uses piecestore.
+	// DialRetries has no effect on it.
+	Fetcher ShareFetcher
+	// Metrics receives the audit outcome counters alongside monkit, it is optional.
+	Metrics MetricsSink
+}
+
+// defaultMinDownloadTimeout is used by NewVerifier when MinDownloadTimeout is not positive
+const defaultMinDownloadTimeout = 25 * time.Second
+
+// NewVerifier creates a Verifier.
+func NewVerifier(log *zap.Logger, metainfo *metainfo.Service, transport transport.Client, overlay *overlay.Cache, containment Containment, orders *orders.Service, id *identity.FullIdentity, config VerifierConfig) *Verifier {
+	minDownloadTimeout := config.MinDownloadTimeout
+	if minDownloadTimeout <= 0 {
+		minDownloadTimeout = defaultMinDownloadTimeout
+	}
+	fetcher := config.Fetcher
+	if fetcher == nil {
+		fetcher = &piecestoreFetcher{
+			log:         log,
+			transport:   transport,
+			dialRetries: config.DialRetries,
+			dialBackoff: config.DialRetryBackoff,
+		}
+	}
 	return &Verifier{
 		log:                log,
 		metainfo:           metainfo,
@@ -69,14 +133,56 @@ func NewVerifier(log *zap.Logger, metainfo *metainfo.Service, transport transpor
 		transport:          transport,
 		overlay:            overlay,
 		containment:        containment,
-		minBytesPerSecond:  minBytesPerSecond,
+		minBytesPerSecond:  config.MinBytesPerSecond,
 		minDownloadTimeout: minDownloadTimeout,
+		fetcher:            fetcher,
+		shareTolerance:     config.ShareTolerance,
+		metrics:            config.Metrics,
+
+		maxConcurrentDownloads:  config.MaxConcurrentDownloads,
+		maxConcurrentReverifies: config.MaxConcurrentReverifies,
 	}
 }
 
+// countOutcome reports the number of nodes of an outcome to monkit and to the metrics sink.
+func (verifier *Verifier) countOutcome(name string, value int) {
+	mon.Meter(name + "_global").Mark(value)
+	mon.IntVal(name).Observe(int64(value))
+	if verifier.metrics != nil {
+		verifier.metrics.Count(name, int64(value))
+	}
+}
+
+// VerifySummary counts the outcomes of the nodes of a single verified stripe.
+type VerifySummary struct {
+	// TotalInPointer is the number of pieces of the segment.
+	TotalInPointer int
+	NumSuccessful  int
+	NumFailed      int
+	NumOffline     int
+	NumContained   int
+
+	// AuditedPercentage is the ratio of audited nodes to TotalInPointer,
+	// the other percentages are ratios to the number of audited nodes.
+	AuditedPercentage    float64
+	OfflinePercentage    float64
+	SuccessfulPercentage float64
+	FailedPercentage     float64
+	ContainedPercentage  float64
+}
+
 // Verify downloads shares then verifies the data correctness at the given stripe
 func (verifier *Verifier) Verify(ctx context.Context, stripe *Stripe, skip map[storj.NodeID]bool) (report *Report, err error) {
 	defer mon.Task()(&ctx)(&err)
+	report, _, err = verifier.VerifyWithSummary(ctx, stripe, skip)
+	return report, err
+}
+
+// VerifyWithSummary is like Verify, but it also returns the summary of the
+// outcomes reported to monkit. The summary is nil when the verification ended
+// before the shares were audited.
+func (verifier *Verifier) VerifyWithSummary(ctx context.Context, stripe *Stripe, skip map[storj.NodeID]bool) (report *Report, summary *VerifySummary, err error) {
+	defer mon.Task()(&ctx)(&err)
 
 	pointer := stripe.Segment
 	shareSize := pointer.GetRemote().GetRedundancy().GetErasureShareSize()
@@ -89,7 +195,7 @@ func (verifier *Verifier) Verify(ctx context.Context, stripe *Stripe, skip map[s
 
 	orderLimits, privateKey, err := verifier.orders.CreateAuditOrderLimits(ctx, bucketID, pointer, skip)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// note: offlineNodes here will include disqualified nodes
@@ -102,14 +208,14 @@ func (verifier *Verifier) Verify(ctx context.Context, stripe *Stripe, skip map[s
 	if err != nil {
 		return &Report{
 			Offlines: offlineNodes,
-		}, err
+		}, nil, err
 	}
 
 	_, err = verifier.checkIfSegmentDeleted(ctx, stripe.SegmentPath, stripe.Segment)
 	if err != nil {
 		return &Report{
 			Offlines: offlineNodes,
-		}, err
+		}, nil, err
 	}
 
 	for pieceNum, share := range shares {
@@ -160,21 +266,31 @@ func (verifier *Verifier) Verify(ctx context.Context, stripe *Stripe, skip map[s
 
 	if len(sharesToAudit) < required {
 		return &Report{
-			Fails:    failedNodes,
-			Offlines: offlineNodes,
-		}, ErrNotEnoughShares.New("got %d, required %d", len(sharesToAudit), required)
+			Fails:     failedNodes,
+			Offlines:  offlineNodes,
+			Contained: getContainedNodes(containedNodes),
+		}, nil, ErrNotEnoughShares.New("got %d, required %d (offline %d, failed %d, contained %d)",
+			len(sharesToAudit), required, len(offlineNodes), len(failedNodes), len(containedNodes))
 	}
 
-	pieceNums, correctedShares, err := auditShares(ctx, required, total, sharesToAudit)
+	pieceNums, correctedShares, err := auditShares(ctx, required, total, sharesToAudit, verifier.shareTolerance)
 	if err != nil {
 		return &Report{
-			Fails:    failedNodes,
-			Offlines: offlineNodes,
-		}, err
+			Fails:     failedNodes,
+			Offlines:  offlineNodes,
+			Contained: getContainedNodes(containedNodes),
+		}, nil, err
 	}
 
+	corruptions := make(map[storj.NodeID]*Corruption, len(pieceNums))
 	for _, pieceNum := range pieceNums {
-		failedNodes = append(failedNodes, shares[pieceNum].NodeID)
+		nodeID := shares[pieceNum].NodeID
+		failedNodes = append(failedNodes, nodeID)
+
+		corruption := findCorruption(shares[pieceNum].Data, correctedShare(correctedShares, pieceNum))
+		if corruption != nil {
+			corruptions[nodeID] = corruption
+		}
 	}
 	// remove failed audit pieces from the pointer so as to only penalize once for failed audits
 	err = verifier.removeFailedPieces(ctx, stripe.SegmentPath, stripe.Segment, failedNodes)
@@ -184,50 +300,44 @@ func (verifier *Verifier) Verify(ctx context.Context, stripe *Stripe, skip map[s
 
 	successNodes := getSuccessNodes(ctx, shares, failedNodes, offlineNodes, containedNodes)
 
-	totalInPointer := len(stripe.Segment.GetRemote().GetRemotePieces())
-	numOffline := len(offlineNodes)
-	numSuccessful := len(successNodes)
-	numFailed := len(failedNodes)
-	numContained := len(containedNodes)
-	totalAudited := numSuccessful + numFailed + numOffline + numContained
-	auditedPercentage := float64(totalAudited) / float64(totalInPointer)
-	offlinePercentage := float64(0)
-	successfulPercentage := float64(0)
-	failedPercentage := float64(0)
-	containedPercentage := float64(0)
+	summary = &VerifySummary{
+		TotalInPointer: len(stripe.Segment.GetRemote().GetRemotePieces()),
+		NumSuccessful:  len(successNodes),
+		NumFailed:      len(failedNodes),
+		NumOffline:     len(offlineNodes),
+		NumContained:   len(containedNodes),
+	}
+	totalAudited := summary.NumSuccessful + summary.NumFailed + summary.NumOffline + summary.NumContained
+	summary.AuditedPercentage = float64(totalAudited) / float64(summary.TotalInPointer)
 	if totalAudited > 0 {
-		offlinePercentage = float64(numOffline) / float64(totalAudited)
-		successfulPercentage = float64(numSuccessful) / float64(totalAudited)
-		failedPercentage = float64(numFailed) / float64(totalAudited)
-		containedPercentage = float64(numContained) / float64(totalAudited)
-	}
-
-	mon.Meter("audit_success_nodes_global").Mark(numSuccessful)
-	mon.Meter("audit_fail_nodes_global").Mark(numFailed)
-	mon.Meter("audit_offline_nodes_global").Mark(numOffline)
-	mon.Meter("audit_contained_nodes_global").Mark(numContained)
-	mon.Meter("audit_total_nodes_global").Mark(totalAudited)
-	mon.Meter("audit_total_pointer_nodes_global").Mark(totalInPointer)
-
-	mon.IntVal("audit_success_nodes").Observe(int64(numSuccessful))
-	mon.IntVal("audit_fail_nodes").Observe(int64(numFailed))
-	mon.IntVal("audit_offline_nodes").Observe(int64(numOffline))
-	mon.IntVal("audit_contained_nodes").Observe(int64(numContained))
-	mon.IntVal("audit_total_nodes").Observe(int64(totalAudited))
-	mon.IntVal("audit_total_pointer_nodes").Observe(int64(totalInPointer))
-	mon.FloatVal("audited_percentage").Observe(auditedPercentage)
-	mon.FloatVal("audit_offline_percentage").Observe(offlinePercentage)
-	mon.FloatVal("audit_successful_percentage").Observe(successfulPercentage)
-	mon.FloatVal("audit_failed_percentage").Observe(failedPercentage)
-	mon.FloatVal("audit_contained_percentage").Observe(containedPercentage)
+		summary.OfflinePercentage = float64(summary.NumOffline) / float64(totalAudited)
+		summary.SuccessfulPercentage = float64(summary.NumSuccessful) / float64(totalAudited)
+		summary.FailedPercentage = float64(summary.NumFailed) / float64(totalAudited)
+		summary.ContainedPercentage = float64(summary.NumContained) / float64(totalAudited)
+	}
+
+	verifier.countOutcome("audit_success_nodes", summary.NumSuccessful)
+	verifier.countOutcome("audit_fail_nodes", summary.NumFailed)
+	verifier.countOutcome("audit_offline_nodes", summary.NumOffline)
+	verifier.countOutcome("audit_contained_nodes", summary.NumContained)
+	verifier.countOutcome("audit_total_nodes", totalAudited)
+	verifier.countOutcome("audit_total_pointer_nodes", summary.TotalInPointer)
+
+	mon.FloatVal("audited_percentage").Observe(summary.AuditedPercentage)
+	mon.FloatVal("audit_offline_percentage").Observe(summary.OfflinePercentage)
+	mon.FloatVal("audit_successful_percentage").Observe(summary.SuccessfulPercentage)
+	mon.FloatVal("audit_failed_percentage").Observe(summary.FailedPercentage)
+	mon.FloatVal("audit_contained_percentage").Observe(summary.ContainedPercentage)
 
 	pendingAudits, err := createPendingAudits(ctx, containedNodes, correctedShares, stripe)
 	if err != nil {
 		return &Report{
-			Successes: successNodes,
-			Fails:     failedNodes,
-			Offlines:  offlineNodes,
-		}, err
+			Successes:   successNodes,
+			Fails:       failedNodes,
+			Offlines:    offlineNodes,
+			Contained:   getContainedNodes(containedNodes),
+			Corruptions: corruptions,
+		}, summary, err
 	}
 
 	return &Report{
@@ -235,24 +345,164 @@ func (verifier *Verifier) Verify(ctx context.Context, stripe *Stripe, skip map[s
 		Fails:         failedNodes,
 		Offlines:      offlineNodes,
 		PendingAudits: pendingAudits,
-	}, nil
+		Contained:     getContainedNodes(containedNodes),
+		Corruptions:   corruptions,
+	}, summary, nil
+}
+
+// Availability is an estimate of how many pieces of a stripe could be audited.
+type Availability struct {
+	// Available is the number of nodes for which audit order limits could be created.
+	Available int
+	// Total is the number of nodes storing a piece of the stripe, not counting skipped nodes.
+	Total int
+	// Offlines are the nodes for which no order limit could be created (offline or disqualified).
+	Offlines storj.NodeIDList
+}
+
+// EstimateAvailability creates the audit order limits for the stripe and
+// reports for how many of its nodes they could be created. No shares are
+// downloaded, so this is a cheap estimate of the outcome of Verify.
+func (verifier *Verifier) EstimateAvailability(ctx context.Context, stripe *Stripe, skip map[storj.NodeID]bool) (_ *Availability, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucketID := createBucketID(stripe.SegmentPath)
+
+	orderLimits, _, err := verifier.orders.CreateAuditOrderLimits(ctx, bucketID, stripe.Segment, skip)
+	if err != nil {
+		return nil, err
+	}
+
+	availability := &Availability{
+		Offlines: getOfflineNodes(stripe.Segment, orderLimits, skip),
+	}
+	for _, piece := range stripe.Segment.GetRemote().GetRemotePieces() {
+		if !skip[piece.NodeId] {
+			availability.Total++
+		}
+	}
+	availability.Available = availability.Total - len(availability.Offlines)
+
+	return availability, nil
+}
+
+// VerifyNode downloads the shares of the stripe and checks only the share of nodeID
+// against the reconstructed stripe. It returns whether the share of nodeID is correct.
+// No other node is evaluated, and nothing is reported or removed from the pointer.
+func (verifier *Verifier) VerifyNode(ctx context.Context, stripe *Stripe, nodeID storj.NodeID) (passed bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pointer := stripe.Segment
+	shareSize := pointer.GetRemote().GetRedundancy().GetErasureShareSize()
+	bucketID := createBucketID(stripe.SegmentPath)
+
+	pieceNum := -1
+	for _, piece := range pointer.GetRemote().GetRemotePieces() {
+		if piece.NodeId == nodeID {
+			pieceNum = int(piece.PieceNum)
+			break
+		}
+	}
+	if pieceNum < 0 {
+		return false, Error.New("node %s does not hold a piece of segment %s", nodeID, stripe.SegmentPath)
+	}
+
+	orderLimits, privateKey, err := verifier.orders.CreateAuditOrderLimits(ctx, bucketID, pointer, nil)
+	if err != nil {
+		return false, err
+	}
+	if orderLimits[pieceNum] == nil {
+		return false, Error.New("order limit not created for node %s (offline/disqualified)", nodeID)
+	}
+
+	shares, err := verifier.DownloadShares(ctx, orderLimits, privateKey, stripe.Index, shareSize)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = verifier.checkIfSegmentDeleted(ctx, stripe.SegmentPath, stripe.Segment)
+	if err != nil {
+		return false, err
+	}
+
+	target := shares[pieceNum]
+	if target.Error != nil {
+		if errs2.IsRPC(target.Error, codes.NotFound) {
+			// missing share
+			return false, nil
+		}
+		return false, Error.Wrap(target.Error)
+	}
+
+	sharesToAudit := make(map[int]Share)
+	for num, share := range shares {
+		if share.Error == nil {
+			sharesToAudit[num] = share
+		}
+	}
+
+	required := int(pointer.Remote.Redundancy.GetMinReq())
+	total := int(pointer.Remote.Redundancy.GetTotal())
+
+	if len(sharesToAudit) < required {
+		return false, ErrNotEnoughShares.New("got %d, required %d", len(sharesToAudit), required)
+	}
+
+	pieceNums, _, err := auditShares(ctx, required, total, sharesToAudit, verifier.shareTolerance)
+	if err != nil {
+		return false, err
+	}
+
+	for _, num := range pieceNums {
+		if num == pieceNum {
+			return false, nil
+		}
+	}
+	return true, nil
 }
 
 // DownloadShares downloads shares from the nodes where remote pieces are located
 func (verifier *Verifier) DownloadShares(ctx context.Context, limits []*pb.AddressedOrderLimit, piecePrivateKey storj.PiecePrivateKey, stripeIndex int64, shareSize int32) (shares map[int]Share, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	sharesCh, errCh := verifier.DownloadSharesChan(ctx, limits, piecePrivateKey, stripeIndex, shareSize)
+
 	shares = make(map[int]Share, len(limits))
-	ch := make(chan *Share, len(limits))
+	for share := range sharesCh {
+		shares[share.PieceNum] = share
+	}
+	// a cancellation is reported through the errors of the shares as well
+	<-errCh
 
+	return shares, nil
+}
+
+// DownloadSharesChan is like DownloadShares, but it sends every share to the
+// returned channel as soon as its download completes. Nil limits have no share.
+// The shares channel is closed once all downloads completed. The error channel
+// receives the context error when ctx was canceled before all downloads
+// completed, and is closed afterwards. Both channels are buffered, so the
+// downloads complete even when the channels aren't drained.
+func (verifier *Verifier) DownloadSharesChan(ctx context.Context, limits []*pb.AddressedOrderLimit, piecePrivateKey storj.PiecePrivateKey, stripeIndex int64, shareSize int32) (<-chan Share, <-chan error) {
+	sharesCh := make(chan Share, len(limits))
+	errCh := make(chan error, 1)
+
+	var sem chan struct{}
+	if verifier.maxConcurrentDownloads > 0 {
+		sem = make(chan struct{}, verifier.maxConcurrentDownloads)
+	}
+
+	var wg sync.WaitGroup
 	for i, limit := range limits {
 		if limit == nil {
-			ch <- nil
 			continue
 		}
 
+		wg.Add(1)
 		go func(i int, limit *pb.AddressedOrderLimit) {
-			share, err := verifier.GetShare(ctx, limit, piecePrivateKey, stripeIndex, shareSize, i)
+			defer wg.Done()
+
+			share, err := verifier.getShareLimited(ctx, sem, limit, piecePrivateKey, stripeIndex, shareSize, i)
 			if err != nil {
 				share = Share{
 					Error:    err,
@@ -261,21 +511,27 @@ func (verifier *Verifier) DownloadShares(ctx context.Context, limits []*pb.Addre
 					Data:     nil,
 				}
 			}
-			ch <- &share
+			sharesCh <- share
 		}(i, limit)
 	}
 
-	for range limits {
-		share := <-ch
-		if share != nil {
-			shares[share.PieceNum] = *share
+	go func() {
+		wg.Wait()
+		if err := ctx.Err(); err != nil {
+			errCh <- err
 		}
-	}
+		close(sharesCh)
+		close(errCh)
+	}()
 
-	return shares, nil
+	return sharesCh, errCh
 }
 
-// Reverify reverifies the contained nodes in the stripe
+// Reverify reverifies the contained nodes in the stripe.
+//
+// When ctx is canceled, no more order limits are created and the report of the
+// nodes reverified so far is returned. The nodes that weren't reverified are
+// skipped, so they stay contained.
 func (verifier *Verifier) Reverify(ctx context.Context, stripe *Stripe) (report *Report, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -300,112 +556,83 @@ func (verifier *Verifier) Reverify(ctx context.Context, stripe *Stripe) (report
 	ch := make(chan result, len(pieces))
 	var containedInSegment int64
 
-	for _, piece := range pieces {
-		pending, err := verifier.containment.Get(ctx, piece.NodeId)
-		if err != nil {
-			if ErrContainedNotFound.Has(err) {
-				ch <- result{nodeID: piece.NodeId, status: skipped}
-				continue
-			}
-			ch <- result{nodeID: piece.NodeId, status: erred, err: err}
-			verifier.log.Debug("Reverify: error getting from containment db", zap.Stringer("Node ID", piece.NodeId), zap.Error(err))
-			continue
+	reverifyPiece := func(pending *PendingAudit, piece *pb.RemotePiece) {
+		// don't create any more order limits once the reverification is canceled
+		if ctx.Err() != nil {
+			ch <- result{nodeID: piece.NodeId, status: skipped}
+			verifier.log.Debug("Reverify: canceled (skipped)", zap.Stringer("Node ID", piece.NodeId))
+			return
 		}
-		containedInSegment++
 
-		go func(pending *PendingAudit, piece *pb.RemotePiece) {
-			limit, piecePrivateKey, err := verifier.orders.CreateAuditOrderLimit(ctx, createBucketID(stripe.SegmentPath), pending.NodeID, piece.PieceNum, pending.PieceID, pending.ShareSize)
-			if err != nil {
-				if overlay.ErrNodeDisqualified.Has(err) {
-					_, errDelete := verifier.containment.Delete(ctx, piece.NodeId)
-					if errDelete != nil {
-						verifier.log.Debug("Error deleting disqualified node from containment db", zap.Stringer("Node ID", piece.NodeId), zap.Error(err))
-						err = errs.Combine(err, errDelete)
-					}
-					ch <- result{nodeID: piece.NodeId, status: erred, err: err}
-					verifier.log.Debug("Reverify: order limit not created (disqualified)", zap.Stringer("Node ID", piece.NodeId))
-					return
-				}
-				if overlay.ErrNodeOffline.Has(err) {
-					ch <- result{nodeID: piece.NodeId, status: offline}
-					verifier.log.Debug("Reverify: order limit not created (offline)", zap.Stringer("Node ID", piece.NodeId))
-					return
+		// the order limit created by Verify can't be reused here: a storage node marks
+		// the serial number of every limit it receives as used and rejects it afterwards
+		limit, piecePrivateKey, err := verifier.orders.CreateAuditOrderLimit(ctx, createBucketID(stripe.SegmentPath), pending.NodeID, piece.PieceNum, pending.PieceID, pending.ShareSize)
+		if err != nil {
+			if overlay.ErrNodeDisqualified.Has(err) {
+				_, errDelete := verifier.containment.Delete(ctx, piece.NodeId)
+				if errDelete != nil {
+					verifier.log.Debug("Error deleting disqualified node from containment db", zap.Stringer("Node ID", piece.NodeId), zap.Error(err))
+					err = errs.Combine(err, errDelete)
 				}
 				ch <- result{nodeID: piece.NodeId, status: erred, err: err}
-				verifier.log.Debug("Reverify: error creating order limit", zap.Stringer("Node ID", piece.NodeId), zap.Error(err))
+				verifier.log.Debug("Reverify: order limit not created (disqualified)", zap.Stringer("Node ID", piece.NodeId))
+				return
+			}
+			if overlay.ErrNodeOffline.Has(err) {
+				ch <- result{nodeID: piece.NodeId, status: offline}
+				verifier.log.Debug("Reverify: order limit not created (offline)", zap.Stringer("Node ID", piece.NodeId))
 				return
 			}
+			ch <- result{nodeID: piece.NodeId, status: erred, err: err}
+			verifier.log.Debug("Reverify: error creating order limit", zap.Stringer("Node ID", piece.NodeId), zap.Error(err))
+			return
+		}
 
-			share, err := verifier.GetShare(ctx, limit, piecePrivateKey, pending.StripeIndex, pending.ShareSize, int(piece.PieceNum))
+		share, err := verifier.GetShare(ctx, limit, piecePrivateKey, pending.StripeIndex, pending.ShareSize, int(piece.PieceNum))
 
-			// check if the pending audit was deleted while downloading the share
-			_, getErr := verifier.containment.Get(ctx, piece.NodeId)
-			if getErr != nil {
-				if ErrContainedNotFound.Has(getErr) {
-					ch <- result{nodeID: piece.NodeId, status: skipped}
-					verifier.log.Debug("Reverify: pending audit deleted during reverification", zap.Stringer("Node ID", piece.NodeId), zap.Error(getErr))
-					return
-				}
-				ch <- result{nodeID: piece.NodeId, status: erred, err: getErr}
-				verifier.log.Debug("Reverify: error getting from containment db", zap.Stringer("Node ID", piece.NodeId), zap.Error(getErr))
+		// a download failing because of the cancellation says nothing about the node
+		if err != nil && ctx.Err() != nil {
+			ch <- result{nodeID: piece.NodeId, status: skipped}
+			verifier.log.Debug("Reverify: canceled during download (skipped)", zap.Stringer("Node ID", piece.NodeId), zap.Error(err))
+			return
+		}
+
+		// check if the pending audit was deleted while downloading the share
+		_, getErr := verifier.containment.Get(ctx, piece.NodeId)
+		if getErr != nil {
+			if ErrContainedNotFound.Has(getErr) {
+				ch <- result{nodeID: piece.NodeId, status: skipped}
+				verifier.log.Debug("Reverify: pending audit deleted during reverification", zap.Stringer("Node ID", piece.NodeId), zap.Error(getErr))
 				return
 			}
+			ch <- result{nodeID: piece.NodeId, status: erred, err: getErr}
+			verifier.log.Debug("Reverify: error getting from containment db", zap.Stringer("Node ID", piece.NodeId), zap.Error(getErr))
+			return
+		}
 
-			// analyze the error from GetShare
-			if err != nil {
-				if transport.Error.Has(err) {
-					if errs.Is(err, context.DeadlineExceeded) {
-						// dial timeout
-						ch <- result{nodeID: piece.NodeId, status: offline}
-						verifier.log.Debug("Reverify: dial timeout (offline)", zap.Stringer("Node ID", piece.NodeId), zap.Error(err))
-						return
-					}
-					if errs2.IsRPC(err, codes.Unknown) {
-						// dial failed -- offline node
-						verifier.log.Debug("Reverify: dial failed (offline)", zap.Stringer("Node ID", piece.NodeId), zap.Error(err))
-						ch <- result{nodeID: piece.NodeId, status: offline}
-						return
-					}
-					// unknown transport error
-					ch <- result{nodeID: piece.NodeId, status: contained, pendingAudit: pending}
-					verifier.log.Debug("Reverify: unknown transport error (contained)", zap.Stringer("Node ID", piece.NodeId), zap.Error(err))
-					return
-				}
-				if errs2.IsRPC(err, codes.NotFound) {
-					// Get the original segment pointer in the metainfo
-					oldPtr, err := verifier.checkIfSegmentDeleted(ctx, pending.Path, stripe.Segment)
-					if err != nil {
-						ch <- result{nodeID: piece.NodeId, status: success}
-						verifier.log.Debug("Reverify: audit source deleted before reverification", zap.Stringer("Node ID", piece.NodeId), zap.Error(err))
-						return
-					}
-					// remove failed audit pieces from the pointer so as to only penalize once for failed audits
-					err = verifier.removeFailedPieces(ctx, pending.Path, oldPtr, storj.NodeIDList{pending.NodeID})
-					if err != nil {
-						verifier.log.Warn("Reverify: failed to delete failed pieces", zap.Stringer("Node ID", piece.NodeId), zap.Error(err))
-					}
-					// missing share
-					ch <- result{nodeID: piece.NodeId, status: failed}
-					verifier.log.Debug("Reverify: piece not found (audit failed)", zap.Stringer("Node ID", piece.NodeId), zap.Error(err))
+		// analyze the error from GetShare
+		if err != nil {
+			if transport.Error.Has(err) {
+				if errs.Is(err, context.DeadlineExceeded) {
+					// dial timeout
+					ch <- result{nodeID: piece.NodeId, status: offline}
+					verifier.log.Debug("Reverify: dial timeout (offline)", zap.Stringer("Node ID", piece.NodeId), zap.Error(err))
 					return
 				}
-				if errs2.IsRPC(err, codes.DeadlineExceeded) {
-					// dial successful, but download timed out
-					ch <- result{nodeID: piece.NodeId, status: contained, pendingAudit: pending}
-					verifier.log.Debug("Reverify: download timeout (contained)", zap.Stringer("Node ID", piece.NodeId), zap.Error(err))
+				if errs2.IsRPC(err, codes.Unknown) {
+					// dial failed -- offline node
+					verifier.log.Debug("Reverify: dial failed (offline)", zap.Stringer("Node ID", piece.NodeId), zap.Error(err))
+					ch <- result{nodeID: piece.NodeId, status: offline}
 					return
 				}
-				// unknown error
+				// unknown transport error
 				ch <- result{nodeID: piece.NodeId, status: contained, pendingAudit: pending}
-				verifier.log.Debug("Reverify: unknown error (contained)", zap.Stringer("Node ID", piece.NodeId), zap.Error(err))
+				verifier.log.Debug("Reverify: unknown transport error (contained)", zap.Stringer("Node ID", piece.NodeId), zap.Error(err))
 				return
 			}
-			downloadedHash := pkcrypto.SHA256Hash(share.Data)
-			if bytes.Equal(downloadedHash, pending.ExpectedShareHash) {
-				ch <- result{nodeID: piece.NodeId, status: success}
-				verifier.log.Debug("Reverify: hashes match (audit success)", zap.Stringer("Node ID", piece.NodeId))
-			} else {
-				oldPtr, err := verifier.checkIfSegmentDeleted(ctx, pending.Path, nil)
+			if errs2.IsRPC(err, codes.NotFound) {
+				// Get the original segment pointer in the metainfo
+				oldPtr, err := verifier.checkIfSegmentDeleted(ctx, pending.Path, stripe.Segment)
 				if err != nil {
 					ch <- result{nodeID: piece.NodeId, status: success}
 					verifier.log.Debug("Reverify: audit source deleted before reverification", zap.Stringer("Node ID", piece.NodeId), zap.Error(err))
@@ -416,11 +643,82 @@ func (verifier *Verifier) Reverify(ctx context.Context, stripe *Stripe) (report
 				if err != nil {
 					verifier.log.Warn("Reverify: failed to delete failed pieces", zap.Stringer("Node ID", piece.NodeId), zap.Error(err))
 				}
-				verifier.log.Debug("Reverify: hashes mismatch (audit failed)", zap.Stringer("Node ID", piece.NodeId),
-					zap.Binary("expected hash", pending.ExpectedShareHash), zap.Binary("downloaded hash", downloadedHash))
+				// missing share
 				ch <- result{nodeID: piece.NodeId, status: failed}
+				verifier.log.Debug("Reverify: piece not found (audit failed)", zap.Stringer("Node ID", piece.NodeId), zap.Error(err))
+				return
+			}
+			if errs2.IsRPC(err, codes.DeadlineExceeded) {
+				// dial successful, but download timed out
+				ch <- result{nodeID: piece.NodeId, status: contained, pendingAudit: pending}
+				verifier.log.Debug("Reverify: download timeout (contained)", zap.Stringer("Node ID", piece.NodeId), zap.Error(err))
+				return
+			}
+			// unknown error
+			ch <- result{nodeID: piece.NodeId, status: contained, pendingAudit: pending}
+			verifier.log.Debug("Reverify: unknown error (contained)", zap.Stringer("Node ID", piece.NodeId), zap.Error(err))
+			return
+		}
+		downloadedHash := pkcrypto.SHA256Hash(share.Data)
+		if bytes.Equal(downloadedHash, pending.ExpectedShareHash) {
+			ch <- result{nodeID: piece.NodeId, status: success}
+			verifier.log.Debug("Reverify: hashes match (audit success)", zap.Stringer("Node ID", piece.NodeId))
+		} else {
+			oldPtr, err := verifier.checkIfSegmentDeleted(ctx, pending.Path, nil)
+			if err != nil {
+				ch <- result{nodeID: piece.NodeId, status: success}
+				verifier.log.Debug("Reverify: audit source deleted before reverification", zap.Stringer("Node ID", piece.NodeId), zap.Error(err))
+				return
 			}
-		}(pending, piece)
+			// remove failed audit pieces from the pointer so as to only penalize once for failed audits
+			err = verifier.removeFailedPieces(ctx, pending.Path, oldPtr, storj.NodeIDList{pending.NodeID})
+			if err != nil {
+				verifier.log.Warn("Reverify: failed to delete failed pieces", zap.Stringer("Node ID", piece.NodeId), zap.Error(err))
+			}
+			verifier.log.Debug("Reverify: hashes mismatch (audit failed)", zap.Stringer("Node ID", piece.NodeId),
+				zap.Binary("expected hash", pending.ExpectedShareHash), zap.Binary("downloaded hash", downloadedHash))
+			ch <- result{nodeID: piece.NodeId, status: failed}
+		}
+	}
+
+	type job struct {
+		pending *PendingAudit
+		piece   *pb.RemotePiece
+	}
+	jobs := make(chan job, len(pieces))
+
+	for _, piece := range pieces {
+		if ctx.Err() != nil {
+			ch <- result{nodeID: piece.NodeId, status: skipped}
+			continue
+		}
+
+		pending, err := verifier.containment.Get(ctx, piece.NodeId)
+		if err != nil {
+			if ErrContainedNotFound.Has(err) {
+				ch <- result{nodeID: piece.NodeId, status: skipped}
+				continue
+			}
+			ch <- result{nodeID: piece.NodeId, status: erred, err: err}
+			verifier.log.Debug("Reverify: error getting from containment db", zap.Stringer("Node ID", piece.NodeId), zap.Error(err))
+			continue
+		}
+		containedInSegment++
+
+		jobs <- job{pending: pending, piece: piece}
+	}
+	close(jobs)
+
+	workers := verifier.maxConcurrentReverifies
+	if workers <= 0 || workers > len(jobs) {
+		workers = len(jobs)
+	}
+	for i := 0; i < workers; i++ {
+		go func() {
+			for job := range jobs {
+				reverifyPiece(job.pending, job.piece)
+			}
+		}()
 	}
 
 	report = &Report{}
@@ -440,15 +738,10 @@ func (verifier *Verifier) Reverify(ctx context.Context, stripe *Stripe) (report
 		}
 	}
 
-	mon.Meter("reverify_successes_global").Mark(len(report.Successes))
-	mon.Meter("reverify_offlines_global").Mark(len(report.Offlines))
-	mon.Meter("reverify_fails_global").Mark(len(report.Fails))
-	mon.Meter("reverify_contained_global").Mark(len(report.PendingAudits))
-
-	mon.IntVal("reverify_successes").Observe(int64(len(report.Successes)))
-	mon.IntVal("reverify_offlines").Observe(int64(len(report.Offlines)))
-	mon.IntVal("reverify_fails").Observe(int64(len(report.Fails)))
-	mon.IntVal("reverify_contained").Observe(int64(len(report.PendingAudits)))
+	verifier.countOutcome("reverify_successes", len(report.Successes))
+	verifier.countOutcome("reverify_offlines", len(report.Offlines))
+	verifier.countOutcome("reverify_fails", len(report.Fails))
+	verifier.countOutcome("reverify_contained", len(report.PendingAudits))
 
 	mon.IntVal("reverify_contained_in_segment").Observe(containedInSegment)
 	mon.IntVal("reverify_total_in_segment").Observe(int64(len(pieces)))
@@ -456,49 +749,19 @@ func (verifier *Verifier) Reverify(ctx context.Context, stripe *Stripe) (report
 	return report, err
 }
 
-// GetShare use piece store client to download shares from nodes
+// GetShare uses the ShareFetcher of the verifier to download shares from nodes
 func (verifier *Verifier) GetShare(ctx context.Context, limit *pb.AddressedOrderLimit, piecePrivateKey storj.PiecePrivateKey, stripeIndex int64, shareSize int32, pieceNum int) (share Share, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	bandwidthMsgSize := shareSize
-
 	// determines number of seconds allotted for receiving data from a storage node
 	timedCtx := ctx
 	if verifier.minBytesPerSecond > 0 {
-		maxTransferTime := time.Duration(int64(time.Second) * int64(bandwidthMsgSize) / verifier.minBytesPerSecond.Int64())
-		if maxTransferTime < verifier.minDownloadTimeout {
-			maxTransferTime = verifier.minDownloadTimeout
-		}
 		var cancel func()
-		timedCtx, cancel = context.WithTimeout(ctx, maxTransferTime)
+		timedCtx, cancel = context.WithTimeout(ctx, verifier.downloadTimeout(shareSize))
 		defer cancel()
 	}
 
-	storageNodeID := limit.GetLimit().StorageNodeId
-	log := verifier.log.Named(storageNodeID.String())
-	target := &pb.Node{Id: storageNodeID, Address: limit.GetStorageNodeAddress()}
-
-	ps, err := piecestore.Dial(timedCtx, verifier.transport, target, log, piecestore.DefaultConfig)
-	if err != nil {
-		return Share{}, Error.Wrap(err)
-	}
-	defer func() {
-		err := ps.Close()
-		if err != nil {
-			verifier.log.Error("audit verifier failed to close conn to node: %+v", zap.Error(err))
-		}
-	}()
-
-	offset := int64(shareSize) * stripeIndex
-
-	downloader, err := ps.Download(timedCtx, limit.GetLimit(), piecePrivateKey, offset, int64(shareSize))
-	if err != nil {
-		return Share{}, err
-	}
-	defer func() { err = errs.Combine(err, downloader.Close()) }()
-
-	buf := make([]byte, shareSize)
-	_, err = io.ReadFull(downloader, buf)
+	data, err := verifier.fetcher.FetchShare(timedCtx, limit, piecePrivateKey, stripeIndex, shareSize)
 	if err != nil {
 		return Share{}, err
 	}
@@ -506,11 +769,33 @@ func (verifier *Verifier) GetShare(ctx context.Context, limit *pb.AddressedOrder
 	return Share{
 		Error:    nil,
 		PieceNum: pieceNum,
-		NodeID:   storageNodeID,
-		Data:     buf,
+		NodeID:   limit.GetLimit().StorageNodeId,
+		Data:     data,
 	}, nil
 }
 
+// getShareLimited calls GetShare once a slot of sem is free. A nil sem doesn't limit the calls.
+func (verifier *Verifier) getShareLimited(ctx context.Context, sem chan struct{}, limit *pb.AddressedOrderLimit, piecePrivateKey storj.PiecePrivateKey, stripeIndex int64, shareSize int32, pieceNum int) (Share, error) {
+	if sem != nil {
+		select {
+		case sem <- struct{}{}:
+			defer func() { <-sem }()
+		case <-ctx.Done():
+			return Share{}, ctx.Err()
+		}
+	}
+	return verifier.GetShare(ctx, limit, piecePrivateKey, stripeIndex, shareSize, pieceNum)
+}
+
+// downloadTimeout returns the time allotted for receiving a share of shareSize from a storage node
+func (verifier *Verifier) downloadTimeout(shareSize int32) time.Duration {
+	maxTransferTime := time.Duration(int64(time.Second) * int64(shareSize) / verifier.minBytesPerSecond.Int64())
+	if maxTransferTime < verifier.minDownloadTimeout {
+		maxTransferTime = verifier.minDownloadTimeout
+	}
+	return maxTransferTime
+}
+
 // removeFailedPieces removes lost pieces from a pointer
 func (verifier *Verifier) removeFailedPieces(ctx context.Context, path string, pointer *pb.Pointer, failedNodes storj.NodeIDList) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -554,8 +839,9 @@ func (verifier *Verifier) checkIfSegmentDeleted(ctx context.Context, segmentPath
 
 // auditShares takes the downloaded shares and uses infectious's Correct function to check that they
 // haven't been altered. auditShares returns a slice containing the piece numbers of altered shares,
-// and a slice of the corrected shares.
-func auditShares(ctx context.Context, required, total int, originals map[int]Share) (pieceNums []int, corrected []infectious.Share, err error) {
+// and a slice of the corrected shares. A share only counts as altered when more than tolerance of
+// its bytes differ from the corrected share.
+func auditShares(ctx context.Context, required, total int, originals map[int]Share, tolerance int) (pieceNums []int, corrected []infectious.Share, err error) {
 	defer mon.Task()(&ctx)(&err)
 	f, err := infectious.NewFEC(required, total)
 	if err != nil {
@@ -573,13 +859,60 @@ func auditShares(ctx context.Context, required, total int, originals map[int]Sha
 	}
 
 	for _, share := range copies {
-		if !bytes.Equal(originals[share.Number].Data, share.Data) {
+		if differingBytes(originals[share.Number].Data, share.Data) > tolerance {
 			pieceNums = append(pieceNums, share.Number)
 		}
 	}
 	return pieceNums, copies, nil
 }
 
+// differingBytes returns the number of bytes that differ between a and b.
+// Missing bytes of the shorter slice count as differing.
+func differingBytes(a, b []byte) int {
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	count := len(b) - len(a)
+	for i := range a {
+		if a[i] != b[i] {
+			count++
+		}
+	}
+	return count
+}
+
+// findCorruption compares a downloaded share with its corrected share and
+// returns the byte range covering all altered bytes, or nil when it wasn't altered.
+func findCorruption(original, corrected []byte) *Corruption {
+	first, last := -1, -1
+	for i := range corrected {
+		if i < len(original) && original[i] == corrected[i] {
+			continue
+		}
+		if first < 0 {
+			first = i
+		}
+		last = i
+	}
+	if first < 0 {
+		return nil
+	}
+	return &Corruption{
+		Offset: int64(first),
+		Length: int64(last - first + 1),
+	}
+}
+
+// correctedShare returns the data of the corrected share with pieceNum.
+func correctedShare(corrected []infectious.Share, pieceNum int) []byte {
+	for _, share := range corrected {
+		if share.Number == pieceNum {
+			return share.Data
+		}
+	}
+	return nil
+}
+
 // makeCopies takes in a map of audit Shares and deep copies their data to a slice of infectious Shares
 func makeCopies(ctx context.Context, originals map[int]Share) (copies []infectious.Share, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -612,6 +945,21 @@ func getOfflineNodes(pointer *pb.Pointer, limits []*pb.AddressedOrderLimit, skip
 	return offlines
 }
 
+// getContainedNodes returns the contained nodes ordered by piece number
+func getContainedNodes(containedNodes map[int]storj.NodeID) storj.NodeIDList {
+	pieceNums := make([]int, 0, len(containedNodes))
+	for pieceNum := range containedNodes {
+		pieceNums = append(pieceNums, pieceNum)
+	}
+	sort.Ints(pieceNums)
+
+	var contained storj.NodeIDList
+	for _, pieceNum := range pieceNums {
+		contained = append(contained, containedNodes[pieceNum])
+	}
+	return contained
+}
+
 // getSuccessNodes uses the failed nodes, offline nodes and contained nodes arrays to determine which nodes passed the audit
 func getSuccessNodes(ctx context.Context, shares map[int]Share, failedNodes, offlineNodes storj.NodeIDList, containedNodes map[int]storj.NodeID) (successNodes storj.NodeIDList) {
 	defer mon.Task()(&ctx)(nil)
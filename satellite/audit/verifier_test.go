@@ -5,6 +5,8 @@ package audit_test
 
 import (
 	"context"
+	"io/ioutil"
+	"sync"
 	"testing"
 	"time"
 
@@ -12,6 +14,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 
 	"storj.io/storj/internal/errs2"
@@ -20,6 +23,7 @@ import (
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/testplanet"
 	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/peertls/tlsopts"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/pkg/transport"
@@ -223,8 +227,10 @@ func TestDownloadSharesDialTimeout(t *testing.T) {
 			planet.Satellites[0].DB.Containment(),
 			planet.Satellites[0].Orders.Service,
 			planet.Satellites[0].Identity,
-			minBytesPerSecond,
-			5*time.Second)
+			audit.VerifierConfig{
+				MinBytesPerSecond:  minBytesPerSecond,
+				MinDownloadTimeout: 5 * time.Second,
+			})
 
 		shareSize := stripe.Segment.GetRemote().GetRedundancy().GetErasureShareSize()
 		limits, privateKey, err := planet.Satellites[0].Orders.Service.CreateAuditOrderLimits(ctx, bucketID, stripe.Segment, nil)
@@ -288,8 +294,10 @@ func TestDownloadSharesDownloadTimeout(t *testing.T) {
 			planet.Satellites[0].DB.Containment(),
 			planet.Satellites[0].Orders.Service,
 			planet.Satellites[0].Identity,
-			minBytesPerSecond,
-			150*time.Millisecond)
+			audit.VerifierConfig{
+				MinBytesPerSecond:  minBytesPerSecond,
+				MinDownloadTimeout: 150 * time.Millisecond,
+			})
 
 		shareSize := stripe.Segment.GetRemote().GetRedundancy().GetErasureShareSize()
 		limits, privateKey, err := planet.Satellites[0].Orders.Service.CreateAuditOrderLimits(ctx, bucketID, stripe.Segment, nil)
@@ -336,6 +344,44 @@ func TestVerifierHappyPath(t *testing.T) {
 	})
 }
 
+// TestVerifierPaddedLastStripe checks that auditing the last stripe of a
+// segment, which is partly or wholly made of padding, doesn't fail any node. The
+// padding is erasure coded together with the data, so every share of the
+// stripe is a full share that can be verified.
+func TestVerifierPaddedLastStripe(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		audits := planet.Satellites[0].Audit.Service
+		err := audits.Close()
+		require.NoError(t, err)
+
+		ul := planet.Uplinks[0]
+		testData := testrand.Bytes(8*memory.KiB + 123)
+
+		err = ul.Upload(ctx, planet.Satellites[0], "testbucket", "test/path", testData)
+		require.NoError(t, err)
+
+		stripe, _, err := audits.Cursor.NextStripe(ctx)
+		require.NoError(t, err)
+
+		segmentSize := stripe.Segment.GetSegmentSize()
+		stripeSize := int64(stripe.Segment.GetRemote().GetRedundancy().GetErasureShareSize() * stripe.Segment.GetRemote().GetRedundancy().GetMinReq())
+
+		// the segment is padded with at least 4 bytes to a multiple of the stripe
+		// size, for a stripe aligned segment that is a whole stripe of padding
+		stripe.Index = (segmentSize+4+stripeSize-1)/stripeSize - 1
+
+		report, err := audits.Verifier.Verify(ctx, stripe, nil)
+		require.NoError(t, err)
+
+		assert.Len(t, report.Successes, len(stripe.Segment.GetRemote().GetRemotePieces()))
+		assert.Len(t, report.Fails, 0)
+		assert.Len(t, report.Offlines, 0)
+		assert.Len(t, report.PendingAudits, 0)
+	})
+}
+
 func TestVerifierOfflineNode(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
@@ -370,6 +416,175 @@ func TestVerifierOfflineNode(t *testing.T) {
 	})
 }
 
+func TestVerifierSummary(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		planet.Satellites[0].Discovery.Service.Discovery.Pause()
+
+		audits := planet.Satellites[0].Audit.Service
+		err := audits.Close()
+		require.NoError(t, err)
+
+		ul := planet.Uplinks[0]
+		testData := testrand.Bytes(8 * memory.KiB)
+
+		err = ul.Upload(ctx, planet.Satellites[0], "testbucket", "test/path", testData)
+		require.NoError(t, err)
+
+		stripe, _, err := audits.Cursor.NextStripe(ctx)
+		require.NoError(t, err)
+
+		// stop the first node in the pointer
+		stoppedNodeID := stripe.Segment.GetRemote().GetRemotePieces()[0].NodeId
+		err = stopStorageNode(ctx, planet, stoppedNodeID)
+		require.NoError(t, err)
+
+		report, summary, err := audits.Verifier.VerifyWithSummary(ctx, stripe, nil)
+		require.NoError(t, err)
+		require.NotNil(t, summary)
+
+		total := len(stripe.Segment.GetRemote().GetRemotePieces())
+		assert.Equal(t, total, summary.TotalInPointer)
+		assert.Equal(t, len(report.Successes), summary.NumSuccessful)
+		assert.Equal(t, total-1, summary.NumSuccessful)
+		assert.Equal(t, 0, summary.NumFailed)
+		assert.Equal(t, 1, summary.NumOffline)
+		assert.Equal(t, 0, summary.NumContained)
+
+		assert.Equal(t, 1.0, summary.AuditedPercentage)
+		assert.Equal(t, 1/float64(total), summary.OfflinePercentage)
+		assert.Equal(t, float64(total-1)/float64(total), summary.SuccessfulPercentage)
+		assert.Equal(t, 0.0, summary.FailedPercentage)
+		assert.Equal(t, 0.0, summary.ContainedPercentage)
+	})
+}
+
+// flakyTransport fails the first dial to failNode
+type flakyTransport struct {
+	transport.Client
+	failNode storj.NodeID
+
+	mu     sync.Mutex
+	failed bool
+}
+
+func (client *flakyTransport) DialNode(ctx context.Context, node *pb.Node, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	client.mu.Lock()
+	fail := !client.failed && node.Id == client.failNode
+	if fail {
+		client.failed = true
+	}
+	client.mu.Unlock()
+
+	if fail {
+		return nil, transport.Error.New("connection reset by peer")
+	}
+	return client.Client.DialNode(ctx, node, opts...)
+}
+
+func TestVerifierDialRetry(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		audits := planet.Satellites[0].Audit.Service
+		err := audits.Close()
+		require.NoError(t, err)
+
+		ul := planet.Uplinks[0]
+		testData := testrand.Bytes(8 * memory.KiB)
+
+		err = ul.Upload(ctx, planet.Satellites[0], "testbucket", "test/path", testData)
+		require.NoError(t, err)
+
+		stripe, _, err := audits.Cursor.NextStripe(ctx)
+		require.NoError(t, err)
+
+		flakyNodeID := stripe.Segment.GetRemote().GetRemotePieces()[0].NodeId
+		newVerifier := func(dialRetries int) *audit.Verifier {
+			return audit.NewVerifier(
+				planet.Satellites[0].Log.Named("verifier"),
+				planet.Satellites[0].Metainfo.Service,
+				&flakyTransport{Client: planet.Satellites[0].Transport, failNode: flakyNodeID},
+				planet.Satellites[0].Overlay.Service,
+				planet.Satellites[0].DB.Containment(),
+				planet.Satellites[0].Orders.Service,
+				planet.Satellites[0].Identity,
+				audit.VerifierConfig{
+					MinBytesPerSecond:  128 * memory.B,
+					MinDownloadTimeout: 5 * time.Second,
+					DialRetries:        dialRetries,
+					DialRetryBackoff:   10 * time.Millisecond,
+				})
+		}
+
+		// without retries the node is considered offline
+		report, err := newVerifier(0).Verify(ctx, stripe, nil)
+		require.NoError(t, err)
+		assert.Equal(t, storj.NodeIDList{flakyNodeID}, report.Offlines)
+
+		report, err = newVerifier(1).Verify(ctx, stripe, nil)
+		require.NoError(t, err)
+
+		assert.Len(t, report.Successes, len(stripe.Segment.GetRemote().GetRemotePieces()))
+		assert.Contains(t, report.Successes, flakyNodeID)
+		assert.Len(t, report.Offlines, 0)
+		assert.Len(t, report.Contained, 0)
+	})
+}
+
+func TestVerifierEstimateAvailability(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		planet.Satellites[0].Discovery.Service.Discovery.Pause()
+
+		audits := planet.Satellites[0].Audit.Service
+		err := audits.Close()
+		require.NoError(t, err)
+
+		ul := planet.Uplinks[0]
+		testData := testrand.Bytes(8 * memory.KiB)
+
+		err = ul.Upload(ctx, planet.Satellites[0], "testbucket", "test/path", testData)
+		require.NoError(t, err)
+
+		stripe, _, err := audits.Cursor.NextStripe(ctx)
+		require.NoError(t, err)
+
+		pieces := stripe.Segment.GetRemote().GetRemotePieces()
+
+		availability, err := audits.Verifier.EstimateAvailability(ctx, stripe, nil)
+		require.NoError(t, err)
+		assert.Equal(t, len(pieces), availability.Total)
+		assert.Equal(t, len(pieces), availability.Available)
+		assert.Len(t, availability.Offlines, 0)
+
+		// stop the first node in the pointer
+		stoppedNodeID := pieces[0].NodeId
+		err = stopStorageNode(ctx, planet, stoppedNodeID)
+		require.NoError(t, err)
+
+		availability, err = audits.Verifier.EstimateAvailability(ctx, stripe, nil)
+		require.NoError(t, err)
+		assert.Equal(t, len(pieces), availability.Total)
+		assert.Equal(t, len(pieces)-1, availability.Available)
+		assert.Equal(t, storj.NodeIDList{stoppedNodeID}, availability.Offlines)
+
+		// the estimate agrees with the offline nodes found by a full audit
+		report, err := audits.Verifier.Verify(ctx, stripe, nil)
+		require.NoError(t, err)
+		assert.Equal(t, report.Offlines, availability.Offlines)
+
+		// skipped nodes are not counted
+		skip := map[storj.NodeID]bool{pieces[1].NodeId: true}
+		availability, err = audits.Verifier.EstimateAvailability(ctx, stripe, skip)
+		require.NoError(t, err)
+		assert.Equal(t, len(pieces)-1, availability.Total)
+		assert.Equal(t, len(pieces)-2, availability.Available)
+	})
+}
+
 func TestVerifierMissingPiece(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
@@ -405,6 +620,67 @@ func TestVerifierMissingPiece(t *testing.T) {
 	})
 }
 
+func TestVerifyNodeCorruptedPiece(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		audits := planet.Satellites[0].Audit.Service
+		err := audits.Close()
+		require.NoError(t, err)
+
+		ul := planet.Uplinks[0]
+		testData := testrand.Bytes(8 * memory.KiB)
+
+		// store every piece, so that the corrupted share can be told apart from the others
+		redundancy := ul.GetConfig(planet.Satellites[0]).RS
+		redundancy.SuccessThreshold = redundancy.MaxThreshold
+		err = ul.UploadWithConfig(ctx, planet.Satellites[0], &redundancy, "testbucket", "test/path", testData)
+		require.NoError(t, err)
+
+		stripe, _, err := audits.Cursor.NextStripe(ctx)
+		require.NoError(t, err)
+
+		// corrupt the piece on the first node
+		pieces := stripe.Segment.GetRemote().GetRemotePieces()
+		corrupted := pieces[0]
+		pieceID := stripe.Segment.GetRemote().RootPieceId.Derive(corrupted.NodeId, corrupted.PieceNum)
+		store := getStorageNode(planet, corrupted.NodeId).Storage2.Store
+
+		reader, err := store.Reader(ctx, planet.Satellites[0].ID(), pieceID)
+		require.NoError(t, err)
+		data, err := ioutil.ReadAll(reader)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+
+		for i := range data {
+			data[i]++
+		}
+
+		require.NoError(t, store.Delete(ctx, planet.Satellites[0].ID(), pieceID))
+		writer, err := store.Writer(ctx, planet.Satellites[0].ID(), pieceID)
+		require.NoError(t, err)
+		_, err = writer.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, writer.Commit(ctx))
+
+		passed, err := audits.Verifier.VerifyNode(ctx, stripe, corrupted.NodeId)
+		require.NoError(t, err)
+		assert.False(t, passed)
+
+		passed, err = audits.Verifier.VerifyNode(ctx, stripe, pieces[1].NodeId)
+		require.NoError(t, err)
+		assert.True(t, passed)
+
+		// only the target node is evaluated, so the pointer keeps all pieces
+		pointer, err := planet.Satellites[0].Metainfo.Service.Get(ctx, stripe.SegmentPath)
+		require.NoError(t, err)
+		assert.Len(t, pointer.GetRemote().GetRemotePieces(), len(pieces))
+
+		_, err = audits.Verifier.VerifyNode(ctx, stripe, testrand.NodeID())
+		require.Error(t, err)
+	})
+}
+
 func TestVerifierDialTimeout(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
@@ -449,8 +725,10 @@ func TestVerifierDialTimeout(t *testing.T) {
 			planet.Satellites[0].DB.Containment(),
 			planet.Satellites[0].Orders.Service,
 			planet.Satellites[0].Identity,
-			minBytesPerSecond,
-			5*time.Second)
+			audit.VerifierConfig{
+				MinBytesPerSecond:  minBytesPerSecond,
+				MinDownloadTimeout: 5 * time.Second,
+			})
 
 		report, err := verifier.Verify(ctx, stripe, nil)
 		require.True(t, audit.ErrNotEnoughShares.Has(err), "unexpected error: %+v", err)
@@ -5,13 +5,17 @@ package audit
 
 import (
 	"context"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/vivint/infectious"
+	"go.uber.org/zap/zaptest"
 
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testidentity"
 	"storj.io/storj/internal/testrand"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/pkcrypto"
@@ -58,7 +62,7 @@ func TestFailingAudit(t *testing.T) {
 		}
 	}
 
-	pieceNums, correctedShares, err := auditShares(ctx, 8, 14, auditPkgShares)
+	pieceNums, correctedShares, err := auditShares(ctx, 8, 14, auditPkgShares, 0)
 	if err != nil {
 		panic(err)
 	}
@@ -72,6 +76,52 @@ func TestFailingAudit(t *testing.T) {
 	require.Equal(t, shares, correctedShares)
 }
 
+func TestAuditSharesTolerance(t *testing.T) {
+	const (
+		required = 8
+		total    = 14
+	)
+
+	f, err := infectious.NewFEC(required, total)
+	require.NoError(t, err)
+
+	shares := make([]infectious.Share, total)
+	output := func(s infectious.Share) {
+		shares[s.Number] = s.DeepCopy()
+	}
+
+	err = f.Encode(testrand.BytesInt(required*8), output)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	downloaded := make(map[int]Share, total)
+	for _, share := range shares {
+		downloaded[share.Number] = Share{
+			PieceNum: share.Number,
+			Data:     append([]byte(nil), share.Data...),
+		}
+	}
+
+	// a single bit flip in share 5
+	downloaded[5].Data[3] ^= 0x01
+
+	pieceNums, _, err := auditShares(ctx, required, total, downloaded, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []int{5}, pieceNums)
+
+	pieceNums, correctedShares, err := auditShares(ctx, required, total, downloaded, 1)
+	require.NoError(t, err)
+	assert.Empty(t, pieceNums)
+	assert.Equal(t, shares, correctedShares)
+
+	// a second altered byte exceeds the tolerance again
+	downloaded[5].Data[4] ^= 0x01
+
+	pieceNums, _, err = auditShares(ctx, required, total, downloaded, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []int{5}, pieceNums)
+}
+
 func TestNotEnoughShares(t *testing.T) {
 	const (
 		required = 8
@@ -99,7 +149,7 @@ func TestNotEnoughShares(t *testing.T) {
 			Data:     append([]byte(nil), shares[i].Data...),
 		}
 	}
-	_, _, err = auditShares(ctx, 20, 40, auditPkgShares)
+	_, _, err = auditShares(ctx, 20, 40, auditPkgShares, 0)
 	require.NotNil(t, err)
 	require.Contains(t, err.Error(), "infectious: must specify at least the number of required shares")
 }
@@ -155,3 +205,206 @@ func TestCreatePendingAudits(t *testing.T) {
 	assert.Equal(t, pkcrypto.SHA256Hash(shares[1].Data), pending[0].ExpectedShareHash)
 	assert.EqualValues(t, 0, pending[0].ReverifyCount)
 }
+
+func TestFindCorruption(t *testing.T) {
+	const (
+		required = 8
+		total    = 14
+	)
+
+	f, err := infectious.NewFEC(required, total)
+	require.NoError(t, err)
+
+	shares := make([]infectious.Share, total)
+	output := func(s infectious.Share) {
+		shares[s.Number] = s.DeepCopy()
+	}
+
+	err = f.Encode(testrand.BytesInt(required*8), output)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	downloaded := make(map[int]Share, total)
+	for _, share := range shares {
+		downloaded[share.Number] = Share{
+			PieceNum: share.Number,
+			Data:     append([]byte(nil), share.Data...),
+		}
+	}
+
+	// alter bytes 2 and 3 of one share and byte 6 of another share
+	downloaded[3].Data[2] ^= 0xff
+	downloaded[3].Data[3] ^= 0xff
+	downloaded[5].Data[6] ^= 0xff
+
+	pieceNums, correctedShares, err := auditShares(ctx, required, total, downloaded, 0)
+	require.NoError(t, err)
+	require.Equal(t, []int{3, 5}, pieceNums)
+
+	// every share gets the range of its own altered bytes
+	assert.Equal(t, &Corruption{Offset: 2, Length: 2}, findCorruption(downloaded[3].Data, correctedShare(correctedShares, 3)))
+	assert.Equal(t, &Corruption{Offset: 6, Length: 1}, findCorruption(downloaded[5].Data, correctedShare(correctedShares, 5)))
+	assert.Nil(t, findCorruption(downloaded[1].Data, correctedShare(correctedShares, 1)))
+}
+
+func TestDownloadTimeoutNonPositiveMinimum(t *testing.T) {
+	id := testidentity.MustPregeneratedIdentity(0, storj.LatestIDVersion())
+
+	for _, minDownloadTimeout := range []time.Duration{0, -time.Second} {
+		verifier := NewVerifier(zaptest.NewLogger(t), nil, nil, nil, nil, nil, id, VerifierConfig{MinBytesPerSecond: 128 * memory.B, MinDownloadTimeout: minDownloadTimeout})
+
+		timeout := verifier.downloadTimeout(1)
+		assert.True(t, timeout > 0)
+		assert.Equal(t, defaultMinDownloadTimeout, timeout)
+	}
+
+	// large shares still get more time than the minimum
+	verifier := NewVerifier(zaptest.NewLogger(t), nil, nil, nil, nil, nil, id, VerifierConfig{MinBytesPerSecond: 128 * memory.B})
+	assert.Equal(t, 8192*time.Second, verifier.downloadTimeout(int32(memory.MiB.Int64())))
+}
+
+// slowFetcher is a ShareFetcher which takes a while to download every share
+// and records the largest number of downloads in progress at the same time.
+type slowFetcher struct {
+	mu      sync.Mutex
+	active  int
+	maxSeen int
+}
+
+func (fetcher *slowFetcher) FetchShare(ctx context.Context, limit *pb.AddressedOrderLimit, piecePrivateKey storj.PiecePrivateKey, stripeIndex int64, shareSize int32) ([]byte, error) {
+	fetcher.mu.Lock()
+	fetcher.active++
+	if fetcher.active > fetcher.maxSeen {
+		fetcher.maxSeen = fetcher.active
+	}
+	fetcher.mu.Unlock()
+
+	time.Sleep(10 * time.Millisecond)
+
+	fetcher.mu.Lock()
+	fetcher.active--
+	fetcher.mu.Unlock()
+
+	return make([]byte, shareSize), nil
+}
+
+func TestDownloadSharesConcurrencyLimit(t *testing.T) {
+	ctx := context.Background()
+	id := testidentity.MustPregeneratedIdentity(0, storj.LatestIDVersion())
+
+	const maxConcurrentDownloads = 3
+
+	var limits []*pb.AddressedOrderLimit
+	for i := 0; i < 20; i++ {
+		if i%5 == 0 {
+			limits = append(limits, nil)
+			continue
+		}
+		limits = append(limits, &pb.AddressedOrderLimit{
+			Limit: &pb.OrderLimit{StorageNodeId: testrand.NodeID()},
+		})
+	}
+
+	fetcher := &slowFetcher{}
+	verifier := NewVerifier(zaptest.NewLogger(t), nil, nil, nil, nil, nil, id, VerifierConfig{MinBytesPerSecond: 128 * memory.B, MinDownloadTimeout: time.Second, MaxConcurrentDownloads: maxConcurrentDownloads, Fetcher: fetcher})
+
+	shares, err := verifier.DownloadShares(ctx, limits, storj.PiecePrivateKey{}, 0, 256)
+	require.NoError(t, err)
+	require.Len(t, shares, 16)
+	for _, share := range shares {
+		require.NoError(t, share.Error)
+	}
+	assert.True(t, fetcher.maxSeen <= maxConcurrentDownloads, "%d concurrent downloads", fetcher.maxSeen)
+
+	// without a limit all the shares are downloaded at the same time
+	unlimited := &slowFetcher{}
+	verifier = NewVerifier(zaptest.NewLogger(t), nil, nil, nil, nil, nil, id, VerifierConfig{MinBytesPerSecond: 128 * memory.B, MinDownloadTimeout: time.Second, Fetcher: unlimited})
+
+	shares, err = verifier.DownloadShares(ctx, limits, storj.PiecePrivateKey{}, 0, 256)
+	require.NoError(t, err)
+	require.Len(t, shares, 16)
+	assert.True(t, unlimited.maxSeen > maxConcurrentDownloads, "%d concurrent downloads", unlimited.maxSeen)
+}
+
+// blockingFetcher downloads shares immediately, except the share of blocked,
+// which waits for release or for the context to be done.
+type blockingFetcher struct {
+	blocked storj.NodeID
+	release chan struct{}
+}
+
+func (fetcher *blockingFetcher) FetchShare(ctx context.Context, limit *pb.AddressedOrderLimit, piecePrivateKey storj.PiecePrivateKey, stripeIndex int64, shareSize int32) ([]byte, error) {
+	if limit.GetLimit().StorageNodeId == fetcher.blocked {
+		select {
+		case <-fetcher.release:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	return make([]byte, shareSize), nil
+}
+
+func TestDownloadSharesChan(t *testing.T) {
+	id := testidentity.MustPregeneratedIdentity(0, storj.LatestIDVersion())
+
+	var limits []*pb.AddressedOrderLimit
+	for i := 0; i < 5; i++ {
+		limits = append(limits, &pb.AddressedOrderLimit{
+			Limit: &pb.OrderLimit{StorageNodeId: testrand.NodeID()},
+		})
+	}
+	limits = append(limits, nil)
+
+	t.Run("streams shares", func(t *testing.T) {
+		ctx := context.Background()
+
+		fetcher := &blockingFetcher{blocked: limits[0].GetLimit().StorageNodeId, release: make(chan struct{})}
+		verifier := NewVerifier(zaptest.NewLogger(t), nil, nil, nil, nil, nil, id, VerifierConfig{MinDownloadTimeout: time.Second, Fetcher: fetcher})
+
+		sharesCh, errCh := verifier.DownloadSharesChan(ctx, limits, storj.PiecePrivateKey{}, 0, 256)
+
+		// the other shares arrive while the first one is still downloading
+		received := map[int]bool{}
+		for i := 1; i < 5; i++ {
+			share := <-sharesCh
+			require.NoError(t, share.Error)
+			assert.NotEqual(t, 0, share.PieceNum)
+			received[share.PieceNum] = true
+		}
+		assert.Len(t, received, 4)
+
+		close(fetcher.release)
+		share := <-sharesCh
+		require.NoError(t, share.Error)
+		assert.Equal(t, 0, share.PieceNum)
+
+		_, ok := <-sharesCh
+		assert.False(t, ok, "shares channel isn't closed")
+		err, ok := <-errCh
+		assert.False(t, ok, "unexpected error: %v", err)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		fetcher := &blockingFetcher{blocked: limits[0].GetLimit().StorageNodeId, release: make(chan struct{})}
+		verifier := NewVerifier(zaptest.NewLogger(t), nil, nil, nil, nil, nil, id, VerifierConfig{MinDownloadTimeout: time.Second, Fetcher: fetcher})
+
+		sharesCh, errCh := verifier.DownloadSharesChan(ctx, limits, storj.PiecePrivateKey{}, 0, 256)
+		cancel()
+
+		var count int
+		for share := range sharesCh {
+			if share.PieceNum == 0 {
+				assert.Error(t, share.Error)
+			}
+			count++
+		}
+		assert.Equal(t, 5, count)
+
+		err := <-errCh
+		assert.Equal(t, context.Canceled, err)
+		_, ok := <-errCh
+		assert.False(t, ok, "error channel isn't closed")
+	})
+}
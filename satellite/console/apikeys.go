@@ -22,6 +22,8 @@ type APIKeys interface {
 	Create(ctx context.Context, head []byte, info APIKeyInfo) (*APIKeyInfo, error)
 	// Update updates APIKeyInfo in store
 	Update(ctx context.Context, key APIKeyInfo) error
+	// UpdateLastUsed sets the time APIKeyInfo with given ID was last used for authorization
+	UpdateLastUsed(ctx context.Context, id uuid.UUID, lastUsed time.Time) error
 	// Delete deletes APIKeyInfo from store
 	Delete(ctx context.Context, id uuid.UUID) error
 }
@@ -34,4 +36,5 @@ type APIKeyInfo struct {
 	Name      string    `json:"name"`
 	Secret    []byte    `json:"-"`
 	CreatedAt time.Time `json:"createdAt"`
+	LastUsed  time.Time `json:"lastUsed"`
 }
@@ -4,6 +4,8 @@
 package consoleql
 
 import (
+	"time"
+
 	"github.com/graphql-go/graphql"
 
 	"storj.io/storj/satellite/console"
@@ -17,6 +19,8 @@ const (
 	CreateAPIKeyType = "graphqlCreateAPIKey"
 	// FieldKey is field name for the actual key in createAPIKey
 	FieldKey = "key"
+	// FieldLastUsed is field name for the time api key was last used
+	FieldLastUsed = "lastUsed"
 )
 
 // graphqlAPIKeyInfo creates satellite.APIKeyInfo graphql object
@@ -39,6 +43,23 @@ func graphqlAPIKeyInfo() *graphql.Object {
 			FieldPartnerID: &graphql.Field{
 				Type: graphql.String,
 			},
+			FieldLastUsed: &graphql.Field{
+				Type: graphql.DateTime,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					var lastUsed time.Time
+					switch key := p.Source.(type) {
+					case console.APIKeyInfo:
+						lastUsed = key.LastUsed
+					case *console.APIKeyInfo:
+						lastUsed = key.LastUsed
+					}
+					// keys that were never used have no last used time
+					if lastUsed.IsZero() {
+						return nil, nil
+					}
+					return lastUsed, nil
+				},
+			},
 		},
 	})
 }
@@ -19,6 +19,8 @@ const (
 
 	// CreateUserMutation is a user creation mutation name
 	CreateUserMutation = "createUser"
+	// ResendActivationEmailMutation is a mutation name for resending account activation email
+	ResendActivationEmailMutation = "resendActivationEmail"
 	// UpdateAccountMutation is a mutation name for account updating
 	UpdateAccountMutation = "updateAccount"
 	// DeleteAccountMutation is a mutation name for account deletion
@@ -57,8 +59,8 @@ const (
 	FieldNewPassword = "newPassword"
 	// Secret is a field name for registration token for user creation during Vanguard release
 	Secret = "secret"
-	// ReferrerUserID is a field name for passing referrer's user id
-	ReferrerUserID = "referrerUserId"
+	// ReferralToken is a field name for passing referrer's referral token
+	ReferralToken = "referralToken"
 )
 
 // rootMutation creates mutation for graphql populated by AccountsClient
@@ -75,7 +77,7 @@ func rootMutation(log *zap.Logger, service *console.Service, mailService *mailse
 					Secret: &graphql.ArgumentConfig{
 						Type: graphql.NewNonNull(graphql.String),
 					},
-					ReferrerUserID: &graphql.ArgumentConfig{
+					ReferralToken: &graphql.ArgumentConfig{
 						Type: graphql.String,
 					},
 				},
@@ -83,7 +85,7 @@ func rootMutation(log *zap.Logger, service *console.Service, mailService *mailse
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
 					input, _ := p.Args[InputArg].(map[string]interface{})
 					secretInput, _ := p.Args[Secret].(string)
-					refUserID, _ := p.Args[ReferrerUserID].(string)
+					referralToken, _ := p.Args[ReferralToken].(string)
 
 					createUser := fromMapCreateUser(input)
 
@@ -96,7 +98,7 @@ func rootMutation(log *zap.Logger, service *console.Service, mailService *mailse
 						return nil, err
 					}
 
-					user, err := service.CreateUser(p.Context, createUser, secret, refUserID)
+					user, err := service.CreateUser(p.Context, createUser, secret, referralToken)
 					if err != nil {
 						log.Error("register: failed to create account",
 							zap.Error(err))
@@ -135,6 +137,50 @@ func rootMutation(log *zap.Logger, service *console.Service, mailService *mailse
 					return user, nil
 				},
 			},
+			ResendActivationEmailMutation: &graphql.Field{
+				Type: graphql.Boolean,
+				Args: graphql.FieldConfigArgument{
+					FieldEmail: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+				},
+				// generates new activation token for inactive user and sends it by email
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					email, _ := p.Args[FieldEmail].(string)
+
+					user, token, err := service.ResendActivationToken(p.Context, email)
+					if err != nil {
+						log.Error("resend activation email: failed to generate activation token",
+							zap.String("email", email),
+							zap.Error(err))
+
+						return false, err
+					}
+					// the response doesn't tell whether there is an inactive user with email
+					if user == nil {
+						return true, nil
+					}
+
+					rootObject := p.Info.RootValue.(map[string]interface{})
+					origin := rootObject["origin"].(string)
+					link := origin + rootObject[ActivationPath].(string) + token
+					userName := user.ShortName
+					if user.ShortName == "" {
+						userName = user.FullName
+					}
+
+					mailService.SendRenderedAsync(
+						p.Context,
+						[]post.Address{{Address: user.Email, Name: userName}},
+						&AccountActivationEmail{
+							Origin:         origin,
+							ActivationLink: link,
+						},
+					)
+
+					return true, nil
+				},
+			},
 			UpdateAccountMutation: &graphql.Field{
 				Type: types.user,
 				Args: graphql.FieldConfigArgument{
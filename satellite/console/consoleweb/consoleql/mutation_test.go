@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -57,6 +58,7 @@ func TestGrapqhlMutation(t *testing.T) {
 			db.Rewards(),
 			localpayments.NewService(nil),
 			console.TestPasswordCost,
+			console.PasswordRules{},
 		)
 		require.NoError(t, err)
 
@@ -133,7 +135,7 @@ func TestGrapqhlMutation(t *testing.T) {
 			require.NoError(t, err)
 
 			query := fmt.Sprintf(
-				"mutation {createUser(input:{email:\"%s\",password:\"%s\", fullName:\"%s\", shortName:\"%s\", partnerId:\"%s\"}, secret: \"%s\", referrerUserId: \"\"){id,shortName,fullName,email,partnerId,createdAt}}",
+				"mutation {createUser(input:{email:\"%s\",password:\"%s\", fullName:\"%s\", shortName:\"%s\", partnerId:\"%s\"}, secret: \"%s\", referralToken: \"\"){id,shortName,fullName,email,partnerId,createdAt}}",
 				newUser.Email,
 				newUser.Password,
 				newUser.FullName,
@@ -172,6 +174,120 @@ func TestGrapqhlMutation(t *testing.T) {
 			assert.Equal(t, newUser.PartnerID, user.PartnerID.String())
 		})
 
+		t.Run("Resend activation email mutation", func(t *testing.T) {
+			regTokenTest, err := service.CreateRegToken(ctx, 1)
+			require.NoError(t, err)
+
+			inactiveUser, err := service.CreateUser(ctx, console.CreateUser{
+				UserInfo: console.UserInfo{
+					FullName: "Inactive User",
+					Email:    "inactive@mail.test",
+				},
+				Password: "123a123",
+			}, regTokenTest.Secret, refUserID)
+			require.NoError(t, err)
+
+			query := fmt.Sprintf("mutation {resendActivationEmail(email:\"%s\")}", inactiveUser.Email)
+
+			result := graphql.Do(graphql.Params{
+				Schema:        schema,
+				Context:       ctx,
+				RequestString: query,
+				RootObject:    rootObject,
+			})
+			require.False(t, result.HasErrors())
+
+			data := result.Data.(map[string]interface{})
+			assert.Equal(t, true, data[consoleql.ResendActivationEmailMutation])
+
+			// resending again right away is rate limited
+			result = graphql.Do(graphql.Params{
+				Schema:        schema,
+				Context:       ctx,
+				RequestString: query,
+				RootObject:    rootObject,
+			})
+			require.True(t, result.HasErrors())
+
+			// unknown and already active emails get the same response, but no token
+			for _, email := range []string{"unknown@mail.test", rootUser.Email} {
+				result = graphql.Do(graphql.Params{
+					Schema:        schema,
+					Context:       ctx,
+					RequestString: fmt.Sprintf("mutation {resendActivationEmail(email:\"%s\")}", email),
+					RootObject:    rootObject,
+				})
+				require.False(t, result.HasErrors())
+
+				data = result.Data.(map[string]interface{})
+				assert.Equal(t, true, data[consoleql.ResendActivationEmailMutation])
+
+				user, token, err := service.ResendActivationToken(ctx, strings.ToUpper(email))
+				require.Error(t, err, "expected the resend to be rate limited by email")
+				assert.Nil(t, user)
+				assert.Empty(t, token)
+			}
+
+			// a new service has no resend history, so the generated token can be checked directly
+			otherService, err := console.NewService(
+				log,
+				&consoleauth.Hmac{Secret: []byte("my-suppa-secret-key")},
+				db.Console(),
+				db.Rewards(),
+				localpayments.NewService(nil),
+				console.TestPasswordCost,
+				console.PasswordRules{},
+			)
+			require.NoError(t, err)
+
+			user, activationToken, err := otherService.ResendActivationToken(ctx, inactiveUser.Email)
+			require.NoError(t, err)
+			require.Equal(t, inactiveUser.ID, user.ID)
+
+			err = otherService.ActivateAccount(ctx, activationToken)
+			require.NoError(t, err)
+
+			activated, err := service.GetUser(ctx, inactiveUser.ID)
+			require.NoError(t, err)
+			assert.Equal(t, console.Active, activated.Status)
+		})
+
+		t.Run("Create user with password rules", func(t *testing.T) {
+			strictService, err := console.NewService(
+				log,
+				&consoleauth.Hmac{Secret: []byte("my-suppa-secret-key")},
+				db.Console(),
+				db.Rewards(),
+				localpayments.NewService(nil),
+				console.TestPasswordCost,
+				console.PasswordRules{
+					MinLength:     8,
+					RequireLetter: true,
+					RequireUpper:  true,
+					RequireDigit:  true,
+				},
+			)
+			require.NoError(t, err)
+
+			regTokenTest, err := strictService.CreateRegToken(ctx, 1)
+			require.NoError(t, err)
+
+			newUser := console.CreateUser{
+				UserInfo: console.UserInfo{
+					FullName: "Strict User",
+					Email:    "strict@mail.test",
+				},
+				Password: "123a123",
+			}
+
+			_, err = strictService.CreateUser(ctx, newUser, regTokenTest.Secret, refUserID)
+			require.True(t, console.ErrWeakPassword.Has(err))
+
+			newUser.Password = "123Abc123"
+			_, err = strictService.CreateUser(ctx, newUser, regTokenTest.Secret, refUserID)
+			require.NoError(t, err)
+		})
+
 		testQuery := func(t *testing.T, query string) interface{} {
 			result := graphql.Do(graphql.Params{
 				Schema:        schema,
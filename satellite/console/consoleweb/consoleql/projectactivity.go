@@ -0,0 +1,58 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleql
+
+import (
+	"github.com/graphql-go/graphql"
+
+	"storj.io/storj/satellite/console"
+)
+
+const (
+	// ProjectActivityType is a graphql type name for an event of the project activity feed
+	ProjectActivityType = "projectActivity"
+	// FieldActorID is a field name for the id of the user who made a change
+	FieldActorID = "actorID"
+	// FieldActor is a field name for the user who made a change
+	FieldActor = "actor"
+	// FieldTarget is a field name for what was changed
+	FieldTarget = "target"
+)
+
+// graphqlProjectActivity creates projectActivity type
+func graphqlProjectActivity(service *console.Service, types *TypeCreator) *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: ProjectActivityType,
+		Fields: graphql.Fields{
+			FieldID: &graphql.Field{
+				Type: graphql.Int,
+			},
+			FieldType: &graphql.Field{
+				Type: graphql.String,
+			},
+			FieldActorID: &graphql.Field{
+				Type: graphql.String,
+			},
+			FieldActor: &graphql.Field{
+				Type: types.user,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					activity, _ := p.Source.(console.ProjectActivity)
+
+					// the actor might have deleted the account since
+					user, err := service.GetUser(p.Context, activity.ActorID)
+					if err != nil {
+						return nil, nil
+					}
+					return user, nil
+				},
+			},
+			FieldTarget: &graphql.Field{
+				Type: graphql.String,
+			},
+			FieldCreatedAt: &graphql.Field{
+				Type: graphql.DateTime,
+			},
+		},
+	})
+}
@@ -14,8 +14,15 @@ import (
 const (
 	// ProjectMemberType is a graphql type name for project member
 	ProjectMemberType = "projectMember"
+	// ProjectMembersCursorInputType is a graphql input
+	// type name for project members cursor
+	ProjectMembersCursorInputType = "projectMembersCursor"
+	// ProjectMembersPageType is a graphql type name for project members page
+	ProjectMembersPageType = "projectMembersPage"
 	// FieldJoinedAt is a field name for joined at timestamp
 	FieldJoinedAt = "joinedAt"
+	// FieldProjectMembers is a field name for project members
+	FieldProjectMembers = "projectMembers"
 )
 
 // graphqlProjectMember creates projectMember type
@@ -43,3 +50,84 @@ type projectMember struct {
 	User     *console.User
 	JoinedAt time.Time
 }
+
+// graphqlProjectMembersCursor creates project members cursor graphql input type
+func graphqlProjectMembersCursor() *graphql.InputObject {
+	return graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: ProjectMembersCursorInputType,
+		Fields: graphql.InputObjectConfigFieldMap{
+			SearchArg: &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.String),
+			},
+			LimitArg: &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.Int),
+			},
+			PageArg: &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.Int),
+			},
+			OrderArg: &graphql.InputObjectFieldConfig{
+				Type: graphql.Int,
+			},
+		},
+	})
+}
+
+// graphqlProjectMembersPage creates project members page graphql object
+func graphqlProjectMembersPage(types *TypeCreator) *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: ProjectMembersPageType,
+		Fields: graphql.Fields{
+			FieldProjectMembers: &graphql.Field{
+				Type: graphql.NewList(types.projectMember),
+			},
+			SearchArg: &graphql.Field{
+				Type: graphql.String,
+			},
+			LimitArg: &graphql.Field{
+				Type: graphql.Int,
+			},
+			OrderArg: &graphql.Field{
+				Type: graphql.Int,
+			},
+			OffsetArg: &graphql.Field{
+				Type: graphql.Int,
+			},
+			FieldPageCount: &graphql.Field{
+				Type: graphql.Int,
+			},
+			FieldCurrentPage: &graphql.Field{
+				Type: graphql.Int,
+			},
+			FieldTotalCount: &graphql.Field{
+				Type: graphql.Int,
+			},
+		},
+	})
+}
+
+// projectMembersPage is a page of project members with their users
+type projectMembersPage struct {
+	ProjectMembers []projectMember
+
+	Search string
+	Limit  uint
+	Order  int
+	Offset uint64
+
+	PageCount   uint
+	CurrentPage uint
+	TotalCount  uint64
+}
+
+// fromMapProjectMembersCursor creates console.ProjectMembersCursor from input args
+func fromMapProjectMembersCursor(args map[string]interface{}) (cursor console.ProjectMembersCursor) {
+	limit, _ := args[LimitArg].(int)
+	page, _ := args[PageArg].(int)
+	order, _ := args[OrderArg].(int)
+
+	cursor.Limit = uint(limit)
+	cursor.Page = uint(page)
+	cursor.Order = console.ProjectMemberOrder(order)
+	cursor.Search, _ = args[SearchArg].(string)
+	return
+}
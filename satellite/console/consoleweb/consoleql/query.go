@@ -23,6 +23,8 @@ const (
 	UserQuery = "user"
 	// ProjectQuery is a query name for project
 	ProjectQuery = "project"
+	// ProjectMembersQuery is a query name for a page of project members
+	ProjectMembersQuery = "projectMembers"
 	// MyProjectsQuery is a query name for projects related to account
 	MyProjectsQuery = "myProjects"
 	// ActiveRewardQuery is a query name for current active reward offer
@@ -35,6 +37,8 @@ const (
 	ForgotPasswordQuery = "forgotPassword"
 	// ResendAccountActivationEmailQuery is a query name for password recovery request
 	ResendAccountActivationEmailQuery = "resendAccountActivationEmail"
+	// RecentActivityQuery is a query name for the most recent events of the project activity feed
+	RecentActivityQuery = "recentActivity"
 )
 
 // rootQuery creates query for graphql populated by AccountsClient
@@ -80,6 +84,77 @@ func rootQuery(service *console.Service, mailService *mailservice.Service, types
 					return service.GetProject(p.Context, *id)
 				},
 			},
+			ProjectMembersQuery: &graphql.Field{
+				Type: types.projectMembers,
+				Args: graphql.FieldConfigArgument{
+					FieldProjectID: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+					CursorArg: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(types.projectMembersCursor),
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					inputID, _ := p.Args[FieldProjectID].(string)
+					cursor := fromMapProjectMembersCursor(p.Args[CursorArg].(map[string]interface{}))
+
+					projectID, err := uuid.Parse(inputID)
+					if err != nil {
+						return nil, err
+					}
+
+					page, err := service.GetProjectMembersPage(p.Context, *projectID, cursor)
+					if err != nil {
+						return nil, err
+					}
+
+					users := make([]projectMember, 0, len(page.ProjectMembers))
+					for _, member := range page.ProjectMembers {
+						user, err := service.GetUser(p.Context, member.MemberID)
+						if err != nil {
+							return nil, err
+						}
+
+						users = append(users, projectMember{
+							User:     user,
+							JoinedAt: member.CreatedAt,
+						})
+					}
+
+					return projectMembersPage{
+						ProjectMembers: users,
+						Search:         page.Search,
+						Limit:          page.Limit,
+						Order:          int(page.Order),
+						Offset:         page.Offset,
+						PageCount:      page.PageCount,
+						CurrentPage:    page.CurrentPage,
+						TotalCount:     page.TotalCount,
+					}, nil
+				},
+			},
+			RecentActivityQuery: &graphql.Field{
+				Type: graphql.NewList(types.projectActivity),
+				Args: graphql.FieldConfigArgument{
+					FieldProjectID: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+					LimitArg: &graphql.ArgumentConfig{
+						Type: graphql.Int,
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					inputID, _ := p.Args[FieldProjectID].(string)
+					limit, _ := p.Args[LimitArg].(int)
+
+					projectID, err := uuid.Parse(inputID)
+					if err != nil {
+						return nil, err
+					}
+
+					return service.GetProjectActivity(p.Context, *projectID, limit)
+				},
+			},
 			MyProjectsQuery: &graphql.Field{
 				Type: graphql.NewList(types.project),
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
 
+	"storj.io/storj/internal/currency"
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/pkg/auth"
 	"storj.io/storj/satellite"
@@ -21,6 +22,7 @@ import (
 	"storj.io/storj/satellite/console/consoleweb/consoleql"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/payments/localpayments"
+	"storj.io/storj/satellite/rewards"
 	"storj.io/storj/satellite/satellitedb/satellitedbtest"
 )
 
@@ -38,6 +40,7 @@ func TestGraphqlQuery(t *testing.T) {
 			db.Rewards(),
 			localpayments.NewService(nil),
 			console.TestPasswordCost,
+			console.PasswordRules{},
 		)
 		require.NoError(t, err)
 
@@ -288,6 +291,50 @@ func TestGraphqlQuery(t *testing.T) {
 			assert.True(t, foundU2)
 		})
 
+		t.Run("Project members query", func(t *testing.T) {
+			queryPage := func(t *testing.T, search string, page int) (emails []string, totalCount, pageCount interface{}) {
+				query := fmt.Sprintf(
+					"query {projectMembers(projectID:\"%s\", cursor:{search:\"%s\", limit:1, page:%d, order:2}){projectMembers{user{email}},totalCount,pageCount,currentPage}}",
+					createdProject.ID.String(),
+					search,
+					page,
+				)
+
+				result := testQuery(t, query)
+
+				data := result.(map[string]interface{})
+				membersPage := data[consoleql.ProjectMembersQuery].(map[string]interface{})
+				assert.EqualValues(t, page, membersPage[consoleql.FieldCurrentPage])
+
+				for _, entry := range membersPage[consoleql.FieldProjectMembers].([]interface{}) {
+					member := entry.(map[string]interface{})
+					user := member[consoleql.UserType].(map[string]interface{})
+					emails = append(emails, user[consoleql.FieldEmail].(string))
+				}
+
+				return emails, membersPage[consoleql.FieldTotalCount], membersPage[consoleql.FieldPageCount]
+			}
+
+			emails, totalCount, pageCount := queryPage(t, "muu", 1)
+			assert.Equal(t, []string{user1.Email}, emails)
+			assert.EqualValues(t, 2, totalCount)
+			assert.EqualValues(t, 2, pageCount)
+
+			emails, totalCount, pageCount = queryPage(t, "muu", 2)
+			assert.Equal(t, []string{user2.Email}, emails)
+			assert.EqualValues(t, 2, totalCount)
+			assert.EqualValues(t, 2, pageCount)
+
+			emails, totalCount, pageCount = queryPage(t, "muu2", 1)
+			assert.Equal(t, []string{user2.Email}, emails)
+			assert.EqualValues(t, 1, totalCount)
+			assert.EqualValues(t, 1, pageCount)
+
+			emails, totalCount, _ = queryPage(t, "nobody", 1)
+			assert.Empty(t, emails)
+			assert.EqualValues(t, 0, totalCount)
+		})
+
 		keyInfo1, _, err := service.CreateAPIKey(authCtx, createdProject.ID, "key1")
 		require.NoError(t, err)
 
@@ -386,6 +433,45 @@ func TestGraphqlQuery(t *testing.T) {
 			assert.True(t, foundProj2)
 		})
 
+		t.Run("Credit usage query", func(t *testing.T) {
+			offer, err := db.Rewards().Create(ctx, &rewards.NewOffer{
+				Name:                      "referral",
+				Description:               "referral offer",
+				AwardCredit:               currency.Cents(100),
+				InviteeCredit:             currency.Cents(50),
+				AwardCreditDurationDays:   60,
+				InviteeCreditDurationDays: 30,
+				RedeemableCap:             50,
+				ExpiresAt:                 time.Now().UTC().Add(time.Hour),
+				Status:                    rewards.Active,
+				Type:                      rewards.Referral,
+			})
+			require.NoError(t, err)
+
+			for _, credit := range []console.UserCredit{
+				{Type: console.Referrer, CreditsEarned: currency.Cents(100)},
+				{Type: console.Invitee, CreditsEarned: currency.Cents(50)},
+			} {
+				credit.UserID = rootUser.ID
+				credit.OfferID = offer.ID
+				credit.ExpiresAt = time.Now().UTC().Add(time.Hour * 24)
+
+				err = db.Console().UserCredits().Create(ctx, credit)
+				require.NoError(t, err)
+			}
+
+			query := "query {creditUsage{availableCredit,usedCredit,referred}}"
+
+			result := testQuery(t, query)
+
+			data := result.(map[string]interface{})
+			usage := data[consoleql.CreditUsageQuery].(map[string]interface{})
+
+			assert.Equal(t, 150, usage[consoleql.FieldAvailableCredit])
+			assert.Equal(t, 0, usage[consoleql.FieldUsedCredit])
+			assert.Equal(t, 1, usage[consoleql.FieldReferred])
+		})
+
 		t.Run("Token query", func(t *testing.T) {
 			query := fmt.Sprintf(
 				"query {token(email: \"%s\", password: \"%s\"){token,user{id,email,fullName,shortName,createdAt}}}",
@@ -482,3 +568,136 @@ func TestGraphqlQuery(t *testing.T) {
 		})
 	})
 }
+
+func TestGraphqlProjectActivity(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		log := zaptest.NewLogger(t)
+
+		service, err := console.NewService(
+			log,
+			&consoleauth.Hmac{Secret: []byte("my-suppa-secret-key")},
+			db.Console(),
+			db.Rewards(),
+			localpayments.NewService(nil),
+			console.TestPasswordCost,
+			console.PasswordRules{},
+		)
+		require.NoError(t, err)
+
+		mailService, err := mailservice.New(log, &discardSender{}, "testdata")
+		require.NoError(t, err)
+		defer ctx.Check(mailService.Close)
+
+		rootObject := make(map[string]interface{})
+		rootObject["origin"] = "http://doesntmatter.com/"
+		rootObject[consoleql.ActivationPath] = "?activationToken="
+		rootObject[consoleql.SignInPath] = "login"
+
+		schema, err := consoleql.CreateSchema(log, service, mailService)
+		require.NoError(t, err)
+
+		createActiveUser := func(email string) *console.User {
+			regToken, err := service.CreateRegToken(ctx, 1)
+			require.NoError(t, err)
+
+			user, err := service.CreateUser(ctx, console.CreateUser{
+				UserInfo: console.UserInfo{
+					FullName: "User",
+					Email:    email,
+				},
+				Password: "123a123",
+			}, regToken.Secret, "")
+			require.NoError(t, err)
+
+			activationToken, err := service.GenerateActivationToken(ctx, user.ID, email)
+			require.NoError(t, err)
+			require.NoError(t, service.ActivateAccount(ctx, activationToken))
+
+			user.Email = email
+			return user
+		}
+
+		rootUser := createActiveUser("root@mail.test")
+		member := createActiveUser("member@mail.test")
+
+		token, err := service.Token(ctx, rootUser.Email, "123a123")
+		require.NoError(t, err)
+
+		sauth, err := service.Authorize(auth.WithAPIKey(ctx, []byte(token)))
+		require.NoError(t, err)
+
+		authCtx := console.WithAuth(ctx, sauth)
+
+		testQuery := func(t *testing.T, query string) map[string]interface{} {
+			result := graphql.Do(graphql.Params{
+				Schema:        schema,
+				Context:       authCtx,
+				RequestString: query,
+				RootObject:    rootObject,
+			})
+
+			for _, err := range result.Errors {
+				assert.NoError(t, err)
+			}
+			require.False(t, result.HasErrors())
+
+			return result.Data.(map[string]interface{})
+		}
+
+		data := testQuery(t, `mutation {createProject(input:{name:"activity",description:"desc"}){id}}`)
+		projectID := data[consoleql.CreateProjectMutation].(map[string]interface{})[consoleql.FieldID].(string)
+
+		testQuery(t, fmt.Sprintf(`mutation {updateProjectDescription(id:"%s",description:"new desc"){id}}`, projectID))
+		testQuery(t, fmt.Sprintf(`mutation {addProjectMembers(projectID:"%s",email:["%s"]){id}}`, projectID, member.Email))
+		testQuery(t, fmt.Sprintf(`mutation {createAPIKey(projectID:"%s",name:"key1"){key}}`, projectID))
+
+		recentActivity := func(t *testing.T, limit int) []interface{} {
+			data := testQuery(t, fmt.Sprintf(
+				`query {recentActivity(projectID:"%s",limit:%d){id,type,target,actorID,actor{email},createdAt}}`,
+				projectID, limit,
+			))
+			return data[consoleql.RecentActivityQuery].([]interface{})
+		}
+
+		expected := []struct {
+			activityType console.ActivityType
+			target       string
+		}{
+			{console.ActivityAPIKeyCreated, "key1"},
+			{console.ActivityMemberAdded, member.Email},
+			{console.ActivityProjectUpdated, "activity"},
+			{console.ActivityProjectCreated, "activity"},
+		}
+
+		activities := recentActivity(t, 10)
+		require.Len(t, activities, len(expected))
+
+		var previous time.Time
+		for i, activity := range activities {
+			event := activity.(map[string]interface{})
+			assert.Equal(t, string(expected[i].activityType), event[consoleql.FieldType])
+			assert.Equal(t, expected[i].target, event[consoleql.FieldTarget])
+			assert.Equal(t, rootUser.ID.String(), event[consoleql.FieldActorID])
+
+			actor := event[consoleql.FieldActor].(map[string]interface{})
+			assert.Equal(t, rootUser.Email, actor[consoleql.FieldEmail])
+
+			var createdAt time.Time
+			err := createdAt.UnmarshalText([]byte(event[consoleql.FieldCreatedAt].(string)))
+			require.NoError(t, err)
+			if i > 0 {
+				assert.False(t, createdAt.After(previous))
+			}
+			previous = createdAt
+		}
+
+		// the limit keeps the most recent events
+		activities = recentActivity(t, 2)
+		require.Len(t, activities, 2)
+		assert.Equal(t, string(console.ActivityAPIKeyCreated), activities[0].(map[string]interface{})[consoleql.FieldType])
+		assert.Equal(t, string(console.ActivityMemberAdded), activities[1].(map[string]interface{})[consoleql.FieldType])
+	})
+}
@@ -27,12 +27,15 @@ type TypeCreator struct {
 	bucketUsagePage *graphql.Object
 	paymentMethod   *graphql.Object
 	projectMember   *graphql.Object
+	projectMembers  *graphql.Object
+	projectActivity *graphql.Object
 	apiKeyInfo      *graphql.Object
 	createAPIKey    *graphql.Object
 
-	userInput         *graphql.InputObject
-	projectInput      *graphql.InputObject
-	bucketUsageCursor *graphql.InputObject
+	userInput            *graphql.InputObject
+	projectInput         *graphql.InputObject
+	bucketUsageCursor    *graphql.InputObject
+	projectMembersCursor *graphql.InputObject
 }
 
 // Create create types and check for error
@@ -53,6 +56,11 @@ func (c *TypeCreator) Create(log *zap.Logger, service *console.Service, mailServ
 		return err
 	}
 
+	c.projectMembersCursor = graphqlProjectMembersCursor()
+	if err := c.projectMembersCursor.Error(); err != nil {
+		return err
+	}
+
 	// entities
 	c.user = graphqlUser()
 	if err := c.user.Error(); err != nil {
@@ -104,6 +112,16 @@ func (c *TypeCreator) Create(log *zap.Logger, service *console.Service, mailServ
 		return err
 	}
 
+	c.projectMembers = graphqlProjectMembersPage(c)
+	if err := c.projectMembers.Error(); err != nil {
+		return err
+	}
+
+	c.projectActivity = graphqlProjectActivity(service, c)
+	if err := c.projectActivity.Error(); err != nil {
+		return err
+	}
+
 	c.project = graphqlProject(service, c)
 	if err := c.project.Error(); err != nil {
 		return err
@@ -5,6 +5,8 @@ package consoleql
 
 import (
 	"github.com/graphql-go/graphql"
+
+	"storj.io/storj/satellite/console"
 )
 
 const (
@@ -24,12 +26,24 @@ func graphqlCreditUsage() *graphql.Object {
 		Fields: graphql.Fields{
 			FieldAvailableCredit: &graphql.Field{
 				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					usage, _ := p.Source.(*console.UserCreditUsage)
+					return usage.AvailableCredits.Cents(), nil
+				},
 			},
 			FieldUsedCredit: &graphql.Field{
 				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					usage, _ := p.Source.(*console.UserCreditUsage)
+					return usage.UsedCredits.Cents(), nil
+				},
 			},
 			FieldReferred: &graphql.Field{
 				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					usage, _ := p.Source.(*console.UserCreditUsage)
+					return usage.Referred, nil
+				},
 			},
 		},
 	})
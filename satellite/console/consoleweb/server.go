@@ -56,7 +56,8 @@ type Config struct {
 	AuthToken       string `help:"auth token needed for access to registration token creation endpoint" default:""`
 	AuthTokenSecret string `help:"secret used to sign auth tokens" releaseDefault:"" devDefault:"my-suppa-secret-key"`
 
-	PasswordCost int `internal:"true" help:"password hashing cost (0=automatic)" default:"0"`
+	PasswordCost  int `internal:"true" help:"password hashing cost (0=automatic)" default:"0"`
+	PasswordRules console.PasswordRules
 }
 
 // Server represents console web server
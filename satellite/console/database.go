@@ -35,6 +35,8 @@ type DB interface {
 	ProjectPayments() ProjectPayments
 	// ProjectInvoiceStamps is a getter for ProjectInvoiceStamps repository
 	ProjectInvoiceStamps() ProjectInvoiceStamps
+	// ProjectActivities is a getter for ProjectActivities repository
+	ProjectActivities() ProjectActivities
 
 	// BeginTransaction is a method for opening transaction
 	BeginTx(ctx context.Context) (DBTx, error)
@@ -0,0 +1,163 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	// maxLoginAttempts is the number of failed logins after which an account is locked out
+	maxLoginAttempts = 5
+	// loginLockoutDuration is how long an account stays locked out
+	loginLockoutDuration = 15 * time.Minute
+)
+
+// LockoutError is returned by Token when too many failed logins were made for an account
+type LockoutError struct {
+	Until time.Time
+}
+
+// Error implements error
+func (err *LockoutError) Error() string {
+	return fmt.Sprintf(lockedOutErrMsg, err.Until.UTC().Format(time.RFC3339))
+}
+
+// LoginStatus describes whether logins for an account are currently allowed
+type LoginStatus struct {
+	FailedAttempts int
+	Locked         bool
+	LockedUntil    time.Time
+	// Remaining is the time left until the lockout ends
+	Remaining time.Duration
+}
+
+// loginLockout keeps track of failed logins per email. Failures of unknown
+// emails are tracked as well, so that the responses don't reveal which emails
+// belong to users. Failures are forgotten once they expired.
+type loginLockout struct {
+	mu       sync.Mutex
+	failures map[string]*loginFailures
+	// failuresTTL is ordered by ttl, as every failure extends the ttl by loginLockoutDuration
+	failuresTTL []loginFailuresTTLItem
+}
+
+// loginFailuresTTLItem keeps association between an email and the ttl of its failures
+type loginFailuresTTLItem struct {
+	email string
+	ttl   time.Time
+}
+
+// loginFailures holds failed logins of a single email
+type loginFailures struct {
+	count       int
+	last        time.Time
+	lockedUntil time.Time
+}
+
+// ttl returns when the failures stop affecting logins
+func (failures *loginFailures) ttl() time.Time {
+	ttl := failures.last.Add(loginLockoutDuration)
+	if failures.lockedUntil.After(ttl) {
+		return failures.lockedUntil
+	}
+	return ttl
+}
+
+// expired returns whether the failures no longer affect logins at now
+func (failures *loginFailures) expired(now time.Time) bool {
+	return !now.Before(failures.ttl())
+}
+
+func newLoginLockout() *loginLockout {
+	return &loginLockout{failures: make(map[string]*loginFailures)}
+}
+
+// status returns the login status of email at now
+func (lockout *loginLockout) status(email string, now time.Time) LoginStatus {
+	lockout.mu.Lock()
+	defer lockout.mu.Unlock()
+
+	failures, ok := lockout.lookup(email, now)
+	if !ok {
+		return LoginStatus{}
+	}
+
+	status := LoginStatus{FailedAttempts: failures.count}
+	if now.Before(failures.lockedUntil) {
+		status.Locked = true
+		status.LockedUntil = failures.lockedUntil
+		status.Remaining = failures.lockedUntil.Sub(now)
+	}
+	return status
+}
+
+// fail records a failed login of email at now, locking it out after maxLoginAttempts
+func (lockout *loginLockout) fail(email string, now time.Time) {
+	lockout.mu.Lock()
+	defer lockout.mu.Unlock()
+
+	failures, ok := lockout.lookup(email, now)
+	if !ok {
+		failures = &loginFailures{}
+		lockout.failures[email] = failures
+	}
+
+	failures.count++
+	failures.last = now
+	if failures.count >= maxLoginAttempts {
+		failures.lockedUntil = now.Add(loginLockoutDuration)
+	}
+	lockout.failuresTTL = append(lockout.failuresTTL, loginFailuresTTLItem{email: email, ttl: failures.ttl()})
+
+	lockout.cleanup(now)
+}
+
+// cleanup removes the expired failures, it must be called with the lock held.
+func (lockout *loginLockout) cleanup(now time.Time) {
+	expired := 0
+	for _, item := range lockout.failuresTTL {
+		if now.Before(item.ttl) {
+			break
+		}
+		// later failures of the email extended its ttl
+		if failures, found := lockout.failures[item.email]; found && failures.ttl().Equal(item.ttl) {
+			delete(lockout.failures, item.email)
+		}
+		expired++
+	}
+	lockout.failuresTTL = lockout.failuresTTL[expired:]
+}
+
+// lookup returns the failures of email, deleting them when they expired at now.
+// The caller must hold the mutex.
+func (lockout *loginLockout) lookup(email string, now time.Time) (*loginFailures, bool) {
+	failures, ok := lockout.failures[email]
+	if !ok {
+		return nil, false
+	}
+	if failures.expired(now) {
+		delete(lockout.failures, email)
+		return nil, false
+	}
+	return failures, true
+}
+
+// succeed forgets about the failed logins of email
+func (lockout *loginLockout) succeed(email string) {
+	lockout.mu.Lock()
+	defer lockout.mu.Unlock()
+
+	delete(lockout.failures, email)
+}
+
+// GetLoginStatus returns whether logins for email are currently locked out
+func (s *Service) GetLoginStatus(ctx context.Context, email string) (status LoginStatus, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return s.lockout.status(normalizeEmail(email), time.Now()), nil
+}
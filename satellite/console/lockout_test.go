@@ -0,0 +1,95 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/consoleauth"
+	"storj.io/storj/satellite/payments/localpayments"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestLoginLockout(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		service, err := console.NewService(
+			zaptest.NewLogger(t),
+			&consoleauth.Hmac{Secret: []byte("my-suppa-secret-key")},
+			db.Console(),
+			db.Rewards(),
+			localpayments.NewService(nil),
+			console.TestPasswordCost,
+			console.PasswordRules{},
+		)
+		require.NoError(t, err)
+
+		regToken, err := service.CreateRegToken(ctx, 1)
+		require.NoError(t, err)
+
+		user, err := service.CreateUser(ctx, console.CreateUser{
+			UserInfo: console.UserInfo{
+				FullName: "Locked User",
+				Email:    "locked@mail.test",
+			},
+			Password: "123a123",
+		}, regToken.Secret, "")
+		require.NoError(t, err)
+
+		activationToken, err := service.GenerateActivationToken(ctx, user.ID, user.Email)
+		require.NoError(t, err)
+		require.NoError(t, service.ActivateAccount(ctx, activationToken))
+
+		status, err := service.GetLoginStatus(ctx, user.Email)
+		require.NoError(t, err)
+		assert.False(t, status.Locked)
+
+		// fail logins until the account gets locked out
+		for {
+			_, err = service.Token(ctx, user.Email, "wrong-password")
+			require.Error(t, err)
+
+			status, err = service.GetLoginStatus(ctx, user.Email)
+			require.NoError(t, err)
+			if status.Locked {
+				break
+			}
+			require.True(t, status.FailedAttempts < 100, "account was never locked out")
+		}
+
+		assert.True(t, status.Remaining > 0)
+		assert.True(t, status.Remaining <= 15*time.Minute)
+		assert.True(t, status.LockedUntil.After(time.Now()))
+
+		// even the correct password is rejected during the lockout
+		_, err = service.Token(ctx, user.Email, "123a123")
+		lockoutErr, ok := err.(*console.LockoutError)
+		require.True(t, ok, "expected lockout error, got %v", err)
+		assert.Equal(t, status.LockedUntil, lockoutErr.Until)
+
+		// unknown emails are locked out after the same number of failed logins
+		for i := 0; i < status.FailedAttempts; i++ {
+			_, err = service.Token(ctx, "unknown@mail.test", "wrong-password")
+			require.True(t, console.ErrUnauthorized.Has(err), "expected unauthorized error, got %v", err)
+		}
+		unknownStatus, err := service.GetLoginStatus(ctx, "unknown@mail.test")
+		require.NoError(t, err)
+		assert.True(t, unknownStatus.Locked)
+		assert.Equal(t, status.FailedAttempts, unknownStatus.FailedAttempts)
+
+		_, err = service.Token(ctx, "unknown@mail.test", "wrong-password")
+		_, ok = err.(*console.LockoutError)
+		require.True(t, ok, "expected lockout error, got %v", err)
+	})
+}
@@ -0,0 +1,94 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+)
+
+// ProjectActivities exposes methods to manage the activity log of projects
+type ProjectActivities interface {
+	// Insert records an activity event of a project
+	Insert(ctx context.Context, activity ProjectActivity) (*ProjectActivity, error)
+	// GetByProjectID returns up to limit of the most recent activity events of a project, the most recent first
+	GetByProjectID(ctx context.Context, projectID uuid.UUID, limit int) ([]ProjectActivity, error)
+}
+
+// ActivityType is the kind of change recorded in the activity log of a project
+type ActivityType string
+
+const (
+	// ActivityProjectCreated is recorded when a project is created
+	ActivityProjectCreated ActivityType = "projectCreated"
+	// ActivityProjectUpdated is recorded when the description of a project is changed
+	ActivityProjectUpdated ActivityType = "projectUpdated"
+	// ActivityMemberAdded is recorded for every member added to a project
+	ActivityMemberAdded ActivityType = "memberAdded"
+	// ActivityMemberDeleted is recorded for every member removed from a project
+	ActivityMemberDeleted ActivityType = "memberDeleted"
+	// ActivityAPIKeyCreated is recorded when an api key is created
+	ActivityAPIKeyCreated ActivityType = "apiKeyCreated"
+	// ActivityAPIKeyDeleted is recorded when an api key is deleted
+	ActivityAPIKeyDeleted ActivityType = "apiKeyDeleted"
+)
+
+// ProjectActivity is an event of the activity log of a project
+type ProjectActivity struct {
+	ID        int64
+	ProjectID uuid.UUID
+	// ActorID is the id of the user who made the change
+	ActorID uuid.UUID
+	Type    ActivityType
+	// Target names what was changed: the project name,
+	// the email of a member or the name of an api key
+	Target string
+
+	CreatedAt time.Time
+}
+
+// recordActivity adds an event to the activity log of a project. Failing to record
+// the event doesn't fail the change it describes.
+func (s *Service) recordActivity(ctx context.Context, projectID, actorID uuid.UUID, activityType ActivityType, target string) {
+	_, err := s.store.ProjectActivities().Insert(ctx, ProjectActivity{
+		ProjectID: projectID,
+		ActorID:   actorID,
+		Type:      activityType,
+		Target:    target,
+	})
+	if err != nil {
+		s.log.Warn("unable to record project activity",
+			zap.String("project", projectID.String()),
+			zap.String("type", string(activityType)),
+			zap.Error(err))
+	}
+}
+
+// GetProjectActivity returns up to limit of the most recent activity events of a project, the most recent first
+func (s *Service) GetProjectActivity(ctx context.Context, projectID uuid.UUID, limit int) (_ []ProjectActivity, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = s.isProjectMember(ctx, auth.User.ID, projectID); err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	if limit <= 0 || limit > maxLimit {
+		limit = maxLimit
+	}
+
+	activities, err := s.store.ProjectActivities().GetByProjectID(ctx, projectID, limit)
+	if err != nil {
+		return nil, errs.New(internalErrMsg)
+	}
+
+	return activities, nil
+}
@@ -16,6 +16,8 @@ type ProjectMembers interface {
 	GetByMemberID(ctx context.Context, memberID uuid.UUID) ([]ProjectMember, error)
 	// GetByProjectID is a method for querying project members from the database by projectID, offset and limit.
 	GetByProjectID(ctx context.Context, projectID uuid.UUID, pagination Pagination) ([]ProjectMember, error)
+	// GetPagedByProjectID is a method for querying a page of project members from the database by projectID and cursor.
+	GetPagedByProjectID(ctx context.Context, projectID uuid.UUID, cursor ProjectMembersCursor) (*ProjectMembersPage, error)
 	// Insert is a method for inserting project member into the database.
 	Insert(ctx context.Context, memberID, projectID uuid.UUID) (*ProjectMember, error)
 	// Delete is a method for deleting project member by memberID and projectID from the database.
@@ -40,6 +42,29 @@ type Pagination struct {
 	Order  ProjectMemberOrder
 }
 
+// ProjectMembersCursor holds info for project members
+// cursor pagination
+type ProjectMembersCursor struct {
+	Search string
+	Limit  uint
+	Page   uint
+	Order  ProjectMemberOrder
+}
+
+// ProjectMembersPage represents project members page result
+type ProjectMembersPage struct {
+	ProjectMembers []ProjectMember
+
+	Search string
+	Limit  uint
+	Order  ProjectMemberOrder
+	Offset uint64
+
+	PageCount   uint
+	CurrentPage uint
+	TotalCount  uint64
+}
+
 // ProjectMemberOrder is used for querying project members in specified order
 type ProjectMemberOrder int8
 
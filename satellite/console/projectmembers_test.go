@@ -7,6 +7,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/skyrings/skyring-common/tools/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -120,6 +121,36 @@ func TestProjectMembersRepository(t *testing.T) {
 			assert.Equal(t, 0, len(members))
 		})
 
+		t.Run("Get paged by cursor", func(t *testing.T) {
+			cursor := console.ProjectMembersCursor{Search: "son", Limit: 2, Order: 2}
+
+			seen := make(map[uuid.UUID]bool)
+			for cursor.Page = 1; cursor.Page <= 3; cursor.Page++ {
+				page, err := projectMembers.GetPagedByProjectID(ctx, createdProjects[0].ID, cursor)
+				require.NoError(t, err)
+				assert.Equal(t, uint64(5), page.TotalCount)
+				assert.Equal(t, uint(3), page.PageCount)
+				assert.Equal(t, cursor.Page, page.CurrentPage)
+
+				for _, member := range page.ProjectMembers {
+					assert.False(t, seen[member.MemberID])
+					seen[member.MemberID] = true
+				}
+			}
+			assert.Equal(t, 5, len(seen))
+
+			page, err := projectMembers.GetPagedByProjectID(ctx, createdProjects[0].ID, console.ProjectMembersCursor{Search: "email5", Limit: 2, Page: 1})
+			require.NoError(t, err)
+			require.Equal(t, 1, len(page.ProjectMembers))
+			assert.Equal(t, createdUsers[4].ID, page.ProjectMembers[0].MemberID)
+
+			_, err = projectMembers.GetPagedByProjectID(ctx, createdProjects[0].ID, console.ProjectMembersCursor{Search: "son", Limit: 2, Page: 0})
+			assert.Error(t, err)
+
+			_, err = projectMembers.GetPagedByProjectID(ctx, createdProjects[0].ID, console.ProjectMembersCursor{Search: "son", Limit: 2, Page: 4})
+			assert.Error(t, err)
+		})
+
 		t.Run("Get member by memberID success", func(t *testing.T) {
 			originalMember1 := createdUsers[0]
 			selectedMembers1, err := projectMembers.GetByMemberID(ctx, originalMember1.ID)
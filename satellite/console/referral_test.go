@@ -0,0 +1,97 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/currency"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/auth"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/consoleauth"
+	"storj.io/storj/satellite/payments/localpayments"
+	"storj.io/storj/satellite/rewards"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestReferralLink(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		service, err := console.NewService(
+			zaptest.NewLogger(t),
+			&consoleauth.Hmac{Secret: []byte("my-suppa-secret-key")},
+			db.Console(),
+			db.Rewards(),
+			localpayments.NewService(nil),
+			console.TestPasswordCost,
+			console.PasswordRules{},
+		)
+		require.NoError(t, err)
+
+		_, err = db.Rewards().Create(ctx, &rewards.NewOffer{
+			Name:                      "referral",
+			Description:               "referral offer",
+			AwardCredit:               currency.Cents(100),
+			InviteeCredit:             currency.Cents(50),
+			AwardCreditDurationDays:   60,
+			InviteeCreditDurationDays: 30,
+			RedeemableCap:             50,
+			ExpiresAt:                 time.Now().UTC().Add(time.Hour),
+			Status:                    rewards.Active,
+			Type:                      rewards.Referral,
+		})
+		require.NoError(t, err)
+
+		regToken, err := service.CreateRegToken(ctx, 1)
+		require.NoError(t, err)
+
+		referrer, err := service.CreateUser(ctx, console.CreateUser{
+			UserInfo: console.UserInfo{
+				FullName: "Referrer",
+				Email:    "referrer@mail.test",
+			},
+			Password: "123a123",
+		}, regToken.Secret, "")
+		require.NoError(t, err)
+
+		referralToken, err := service.GenerateReferralLink(ctx, referrer.ID)
+		require.NoError(t, err)
+
+		// referral tokens can't be used for authorization
+		_, err = service.Authorize(auth.WithAPIKey(ctx, []byte(referralToken)))
+		require.Error(t, err)
+
+		newUser := console.CreateUser{
+			UserInfo: console.UserInfo{
+				FullName: "Invitee",
+				Email:    "invitee@mail.test",
+			},
+			Password: "123a123",
+		}
+
+		otherRegToken, err := service.CreateRegToken(ctx, 1)
+		require.NoError(t, err)
+
+		_, err = service.CreateUser(ctx, newUser, otherRegToken.Secret, referralToken+"invalid")
+		require.Error(t, err)
+
+		invitee, err := service.CreateUser(ctx, newUser, otherRegToken.Secret, referralToken)
+		require.NoError(t, err)
+
+		credits, err := db.Console().UserCredits().GetByUserID(ctx, invitee.ID)
+		require.NoError(t, err)
+		require.Len(t, credits, 1)
+		require.NotNil(t, credits[0].ReferredBy)
+		assert.Equal(t, referrer.ID, *credits[0].ReferredBy)
+	})
+}
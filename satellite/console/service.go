@@ -8,6 +8,8 @@ import (
 	"crypto/subtle"
 	"database/sql"
 	"fmt"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/skyrings/skyring-common/tools/uuid"
@@ -30,6 +32,10 @@ const (
 	// maxLimit specifies the limit for all paged queries
 	maxLimit            = 50
 	tokenExpirationTime = 24 * time.Hour
+	// activationResendInterval is the minimal time between two activation email requests for the same email
+	activationResendInterval = time.Minute
+	// referralTokenPrefix is the start of referral token payloads
+	referralTokenPrefix = "referral:"
 
 	// DefaultPasswordCost is the hashing complexity
 	DefaultPasswordCost = bcrypt.DefaultCost
@@ -44,10 +50,14 @@ const (
 	vanguardRegTokenErrMsg               = "We are unable to create your account. This is an invite-only alpha, please join our waitlist to receive an invitation"
 	emailUsedErrMsg                      = "This email is already in use, try another"
 	activationTokenIsExpiredErrMsg       = "Your account activation link has expired, please sign up again"
+	activationResendTooSoonErrMsg        = "An activation email was sent recently, please try again in a minute"
+	referralTokenInvalidErrMsg           = "Your referral link is invalid, please ask for another one"
 	passwordRecoveryTokenIsExpiredErrMsg = "Your password recovery link has expired, please request another one"
 	credentialsErrMsg                    = "Your email or password was incorrect, please try again"
+	lockedOutErrMsg                      = "Too many failed login attempts, please try again after %s"
 	oldPassIncorrectErrMsg               = "Old password is incorrect, please try again"
 	passwordIncorrectErrMsg              = "Your password needs at least %d characters long"
+	weakPasswordErrMsg                   = "Your password is too weak, it needs %s"
 	teamMemberDoesNotExistErrMsg         = `There is no account on this Satellite for the user(s) you have entered.
 									     Please add team members with active accounts`
 
@@ -65,11 +75,18 @@ type Service struct {
 	store   DB
 	rewards rewards.DB
 
-	passwordCost int
+	passwordCost  int
+	passwordRules PasswordRules
+
+	// activationResends holds the last time an activation email was requested for each email
+	activationResendsMu sync.Mutex
+	activationResends   map[string]time.Time
+
+	lockout *loginLockout
 }
 
 // NewService returns new instance of Service
-func NewService(log *zap.Logger, signer Signer, store DB, rewards rewards.DB, pm payments.Service, passwordCost int) (*Service, error) {
+func NewService(log *zap.Logger, signer Signer, store DB, rewards rewards.DB, pm payments.Service, passwordCost int, passwordRules PasswordRules) (*Service, error) {
 	if signer == nil {
 		return nil, errs.New("signer can't be nil")
 	}
@@ -84,22 +101,29 @@ func NewService(log *zap.Logger, signer Signer, store DB, rewards rewards.DB, pm
 	}
 
 	return &Service{
-		log:          log,
-		Signer:       signer,
-		store:        store,
-		rewards:      rewards,
-		pm:           pm,
-		passwordCost: passwordCost,
+		log:           log,
+		Signer:        signer,
+		store:         store,
+		rewards:       rewards,
+		pm:            pm,
+		passwordCost:  passwordCost,
+		passwordRules: passwordRules,
+
+		activationResends: make(map[string]time.Time),
+		lockout:           newLoginLockout(),
 	}, nil
 }
 
 // CreateUser gets password hash value and creates new inactive User
-func (s *Service) CreateUser(ctx context.Context, user CreateUser, tokenSecret RegistrationSecret, refUserID string) (u *User, err error) {
+func (s *Service) CreateUser(ctx context.Context, user CreateUser, tokenSecret RegistrationSecret, referralToken string) (u *User, err error) {
 	offerType := rewards.FreeCredit
 	defer mon.Task()(&ctx)(&err)
 	if err := user.IsValid(); err != nil {
 		return nil, err
 	}
+	if err := s.passwordRules.Validate(user.Password); err != nil {
+		return nil, err
+	}
 
 	// TODO: remove after vanguard release
 	registrationToken, err := s.store.RegistrationTokens().GetBySecret(ctx, tokenSecret)
@@ -123,9 +147,17 @@ func (s *Service) CreateUser(ctx context.Context, user CreateUser, tokenSecret R
 	if err != nil {
 		return nil, errs.New(internalErrMsg)
 	}
+	var referrer *User
+	if referralToken != "" {
+		referrer, err = s.referrerFromToken(ctx, referralToken)
+		if err != nil {
+			return nil, errs.New(referralTokenInvalidErrMsg)
+		}
+	}
+
 	if user.PartnerID != "" {
 		offerType = rewards.Partner
-	} else if refUserID != "" {
+	} else if referrer != nil {
 		offerType = rewards.Referral
 	}
 
@@ -183,6 +215,9 @@ func (s *Service) CreateUser(ctx context.Context, user CreateUser, tokenSecret R
 				CreditsEarned: currency.Cents(0),
 				ExpiresAt:     time.Now().UTC().AddDate(0, 0, currentReward.InviteeCreditDurationDays),
 			}
+			if referrer != nil {
+				newCredit.ReferredBy = &referrer.ID
+			}
 
 			err = tx.UserCredits().Create(ctx, newCredit)
 			if err != nil {
@@ -384,6 +419,96 @@ func (s *Service) GenerateActivationToken(ctx context.Context, id uuid.UUID, ema
 	return s.createToken(ctx, claims)
 }
 
+// GenerateReferralLink - is a method for generating a referral token for userID.
+// Signing up with the token sets the new user's credits as referred by userID.
+func (s *Service) GenerateReferralLink(ctx context.Context, userID uuid.UUID) (token string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = s.store.Users().Get(ctx, userID)
+	if err != nil {
+		return "", errs.New(internalErrMsg)
+	}
+
+	// the payload is not a json encoded claims, so that referral tokens can't be used for authorization
+	referralToken := consoleauth.Token{Payload: []byte(referralTokenPrefix + userID.String())}
+	err = signToken(&referralToken, s.Signer)
+	if err != nil {
+		return "", errs.New(internalErrMsg)
+	}
+
+	return referralToken.String(), nil
+}
+
+// referrerFromToken returns the user who generated referralToken
+func (s *Service) referrerFromToken(ctx context.Context, referralToken string) (_ *User, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	token, err := consoleauth.FromBase64URLString(referralToken)
+	if err != nil {
+		return nil, err
+	}
+
+	signature := token.Signature
+	err = signToken(&token, s.Signer)
+	if err != nil {
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare(signature, token.Signature) != 1 {
+		return nil, errs.New("incorrect signature")
+	}
+
+	payload := string(token.Payload)
+	if !strings.HasPrefix(payload, referralTokenPrefix) {
+		return nil, errs.New("not a referral token")
+	}
+
+	referrerID, err := uuid.Parse(strings.TrimPrefix(payload, referralTokenPrefix))
+	if err != nil {
+		return nil, err
+	}
+
+	return s.store.Users().Get(ctx, *referrerID)
+}
+
+// ResendActivationToken - is a method for generating a new activation token for an inactive user.
+// It returns a nil user without an error when email doesn't belong to an inactive user, so
+// that callers don't reveal which emails are registered. It returns an error when a token
+// was already requested for email within activationResendInterval.
+func (s *Service) ResendActivationToken(ctx context.Context, email string) (u *User, token string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	email = normalizeEmail(email)
+
+	s.activationResendsMu.Lock()
+	now := time.Now()
+	if last, ok := s.activationResends[email]; ok && now.Sub(last) < activationResendInterval {
+		s.activationResendsMu.Unlock()
+		return nil, "", errs.New(activationResendTooSoonErrMsg)
+	}
+	for resent, last := range s.activationResends {
+		if now.Sub(last) >= activationResendInterval {
+			delete(s.activationResends, resent)
+		}
+	}
+	s.activationResends[email] = now
+	s.activationResendsMu.Unlock()
+
+	u, err = s.store.Users().GetInactiveByEmail(ctx, email)
+	if err == sql.ErrNoRows {
+		return nil, "", nil
+	}
+	if err != nil {
+		return nil, "", errs.New(internalErrMsg)
+	}
+
+	token, err = s.GenerateActivationToken(ctx, u.ID, u.Email)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return u, token, nil
+}
+
 // GeneratePasswordRecoveryToken - is a method for generating password recovery token
 func (s *Service) GeneratePasswordRecoveryToken(ctx context.Context, id uuid.UUID) (token string, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -470,7 +595,7 @@ func (s *Service) ResetPassword(ctx context.Context, resetPasswordToken, passwor
 		return
 	}
 
-	if err := validatePassword(password); err != nil {
+	if err := s.passwordRules.Validate(password); err != nil {
 		return err
 	}
 
@@ -511,16 +636,26 @@ func (s *Service) Token(ctx context.Context, email, password string) (token stri
 
 	email = normalizeEmail(email)
 
+	now := time.Now()
+	if status := s.lockout.status(email, now); status.Locked {
+		return "", &LockoutError{Until: status.LockedUntil}
+	}
+
 	user, err := s.store.Users().GetByEmail(ctx, email)
 	if err != nil {
-		return "", errs.New(credentialsErrMsg)
+		// unknown emails get locked out like the emails of users
+		s.lockout.fail(email, now)
+		return "", ErrUnauthorized.New(credentialsErrMsg)
 	}
 
 	err = bcrypt.CompareHashAndPassword(user.PasswordHash, []byte(password))
 	if err != nil {
+		s.lockout.fail(email, now)
 		return "", ErrUnauthorized.New(credentialsErrMsg)
 	}
 
+	s.lockout.succeed(email)
+
 	claims := consoleauth.Claims{
 		ID:         user.ID,
 		Expiration: time.Now().Add(tokenExpirationTime),
@@ -593,7 +728,7 @@ func (s *Service) ChangePassword(ctx context.Context, pass, newPass string) (err
 		return errs.New(oldPassIncorrectErrMsg)
 	}
 
-	if err := validatePassword(newPass); err != nil {
+	if err := s.passwordRules.Validate(newPass); err != nil {
 		return err
 	}
 
@@ -734,6 +869,8 @@ func (s *Service) CreateProject(ctx context.Context, projectInfo ProjectInfo) (p
 		return nil, err
 	}
 
+	s.recordActivity(ctx, p.ID, auth.User.ID, ActivityProjectCreated, p.Name)
+
 	return p, nil
 }
 
@@ -778,6 +915,8 @@ func (s *Service) UpdateProject(ctx context.Context, projectID uuid.UUID, descri
 		return nil, errs.New(internalErrMsg)
 	}
 
+	s.recordActivity(ctx, projectID, auth.User.ID, ActivityProjectUpdated, project.Name)
+
 	return project, nil
 }
 
@@ -816,21 +955,22 @@ func (s *Service) AddProjectMembers(ctx context.Context, projectID uuid.UUID, em
 		return nil, errs.New(internalErrMsg)
 	}
 
-	defer func() {
-		if err != nil {
-			err = errs.Combine(err, tx.Rollback())
-			return
-		}
+	err = withTx(tx, func(tx DBTx) error {
+		for _, user := range users {
+			_, err := tx.ProjectMembers().Insert(ctx, user.ID, projectID)
 
-		err = tx.Commit()
-	}()
+			if err != nil {
+				return errs.New(internalErrMsg)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	for _, user := range users {
-		_, err = tx.ProjectMembers().Insert(ctx, user.ID, projectID)
-
-		if err != nil {
-			return nil, errs.New(internalErrMsg)
-		}
+		s.recordActivity(ctx, projectID, auth.User.ID, ActivityMemberAdded, user.Email)
 	}
 
 	return users, nil
@@ -848,7 +988,7 @@ func (s *Service) DeleteProjectMembers(ctx context.Context, projectID uuid.UUID,
 		return ErrUnauthorized.Wrap(err)
 	}
 
-	var userIDs []uuid.UUID
+	var users []*User
 	var userErr errs.Group
 
 	// collect user querying errors
@@ -860,7 +1000,7 @@ func (s *Service) DeleteProjectMembers(ctx context.Context, projectID uuid.UUID,
 			continue
 		}
 
-		userIDs = append(userIDs, user.ID)
+		users = append(users, user)
 	}
 
 	if err = userErr.Err(); err != nil {
@@ -873,21 +1013,22 @@ func (s *Service) DeleteProjectMembers(ctx context.Context, projectID uuid.UUID,
 		return err
 	}
 
-	defer func() {
-		if err != nil {
-			err = errs.Combine(err, tx.Rollback())
-			return
-		}
-
-		err = tx.Commit()
-	}()
-
-	for _, uID := range userIDs {
-		err = tx.ProjectMembers().Delete(ctx, uID, projectID)
+	err = withTx(tx, func(tx DBTx) error {
+		for _, user := range users {
+			err := tx.ProjectMembers().Delete(ctx, user.ID, projectID)
 
-		if err != nil {
-			return errs.New(internalErrMsg)
+			if err != nil {
+				return errs.New(internalErrMsg)
+			}
 		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, user := range users {
+		s.recordActivity(ctx, projectID, auth.User.ID, ActivityMemberDeleted, user.Email)
 	}
 
 	return nil
@@ -918,6 +1059,22 @@ func (s *Service) GetProjectMembers(ctx context.Context, projectID uuid.UUID, pa
 	return
 }
 
+// GetProjectMembersPage returns a page of ProjectMembers for given Project
+func (s *Service) GetProjectMembersPage(ctx context.Context, projectID uuid.UUID, cursor ProjectMembersCursor) (_ *ProjectMembersPage, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.isProjectMember(ctx, auth.User.ID, projectID)
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	return s.store.ProjectMembers().GetPagedByProjectID(ctx, projectID, cursor)
+}
+
 // CreateAPIKey creates new api key
 func (s *Service) CreateAPIKey(ctx context.Context, projectID uuid.UUID, name string) (_ *APIKeyInfo, _ *macaroon.APIKey, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -962,6 +1119,8 @@ func (s *Service) CreateAPIKey(ctx context.Context, projectID uuid.UUID, name st
 		return nil, nil, errs.New(internalErrMsg)
 	}
 
+	s.recordActivity(ctx, projectID, auth.User.ID, ActivityAPIKeyCreated, name)
+
 	return info, key, nil
 }
 
@@ -995,6 +1154,7 @@ func (s *Service) DeleteAPIKeys(ctx context.Context, ids []uuid.UUID) (err error
 		return err
 	}
 
+	var keys []*APIKeyInfo
 	var keysErr errs.Group
 
 	for _, keyID := range ids {
@@ -1009,6 +1169,8 @@ func (s *Service) DeleteAPIKeys(ctx context.Context, ids []uuid.UUID) (err error
 			keysErr.Add(ErrUnauthorized.Wrap(err))
 			continue
 		}
+
+		keys = append(keys, key)
 	}
 
 	if err = keysErr.Err(); err != nil {
@@ -1020,20 +1182,21 @@ func (s *Service) DeleteAPIKeys(ctx context.Context, ids []uuid.UUID) (err error
 		return errs.New(internalErrMsg)
 	}
 
-	defer func() {
-		if err != nil {
-			err = errs.Combine(err, tx.Rollback())
-			return
+	err = withTx(tx, func(tx DBTx) error {
+		for _, keyToDeleteID := range ids {
+			err := tx.APIKeys().Delete(ctx, keyToDeleteID)
+			if err != nil {
+				return errs.New(internalErrMsg)
+			}
 		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
 
-		err = tx.Commit()
-	}()
-
-	for _, keyToDeleteID := range ids {
-		err = tx.APIKeys().Delete(ctx, keyToDeleteID)
-		if err != nil {
-			return errs.New(internalErrMsg)
-		}
+	for _, key := range keys {
+		s.recordActivity(ctx, key.ProjectID, auth.User.ID, ActivityAPIKeyDeleted, key.Name)
 	}
 
 	return nil
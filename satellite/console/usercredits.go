@@ -19,7 +19,9 @@ var NoCreditForUpdateErr = errs.Class("no credit found to update")
 // UserCredits holds information to interact with database
 type UserCredits interface {
 	GetCreditUsage(ctx context.Context, userID uuid.UUID, expirationEndDate time.Time) (*UserCreditUsage, error)
+	GetByUserID(ctx context.Context, userID uuid.UUID) ([]UserCredit, error)
 	Create(ctx context.Context, userCredit UserCredit) error
+	CreateBatch(ctx context.Context, userCredits []UserCredit) (skipped []UserCredit, err error)
 	UpdateEarnedCredits(ctx context.Context, userID uuid.UUID) error
 	UpdateAvailableCredits(ctx context.Context, creditsToCharge int, id uuid.UUID, billingStartDate time.Time) (remainingCharge int, err error)
 }
@@ -17,6 +17,8 @@ type Users interface {
 	Get(ctx context.Context, id uuid.UUID) (*User, error)
 	// GetByEmail is a method for querying user by email from the database.
 	GetByEmail(ctx context.Context, email string) (*User, error)
+	// GetInactiveByEmail is a method for querying the latest registered inactive user by email from the database.
+	GetInactiveByEmail(ctx context.Context, email string) (*User, error)
 	// Insert is a method for inserting user into the database.
 	Insert(ctx context.Context, user *User) (*User, error)
 	// Delete is a method for deleting user by Id from the database.
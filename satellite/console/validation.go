@@ -4,7 +4,9 @@
 package console
 
 import (
+	"fmt"
 	"strings"
+	"unicode"
 
 	"github.com/zeebo/errs"
 )
@@ -16,6 +18,62 @@ const (
 // ErrValidation validation related error class
 var ErrValidation = errs.Class("validation error")
 
+// ErrWeakPassword is returned when a password doesn't satisfy the password rules
+var ErrWeakPassword = errs.Class("weak password")
+
+// PasswordRules defines the complexity required from user passwords
+type PasswordRules struct {
+	MinLength      int  `help:"minimal number of characters in a password" default:"6"`
+	RequireLetter  bool `help:"require at least one letter in a password" default:"true"`
+	RequireUpper   bool `help:"require at least one uppercase letter in a password" default:"false"`
+	RequireDigit   bool `help:"require at least one digit in a password" default:"true"`
+	RequireSpecial bool `help:"require at least one character that is neither a letter nor a digit in a password" default:"false"`
+}
+
+// Validate checks that pass satisfies the rules
+func (rules PasswordRules) Validate(pass string) error {
+	minLength := rules.MinLength
+	if minLength < passMinLength {
+		minLength = passMinLength
+	}
+
+	var hasLetter, hasUpper, hasDigit, hasSpecial bool
+	for _, r := range pass {
+		switch {
+		case unicode.IsUpper(r):
+			hasLetter, hasUpper = true, true
+		case unicode.IsLetter(r):
+			hasLetter = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		default:
+			hasSpecial = true
+		}
+	}
+
+	var missing []string
+	if len([]rune(pass)) < minLength {
+		missing = append(missing, fmt.Sprintf("at least %d characters", minLength))
+	}
+	if rules.RequireLetter && !hasLetter {
+		missing = append(missing, "a letter")
+	}
+	if rules.RequireUpper && !hasUpper {
+		missing = append(missing, "an uppercase letter")
+	}
+	if rules.RequireDigit && !hasDigit {
+		missing = append(missing, "a digit")
+	}
+	if rules.RequireSpecial && !hasSpecial {
+		missing = append(missing, "a special character")
+	}
+
+	if len(missing) > 0 {
+		return ErrWeakPassword.New(weakPasswordErrMsg, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
 // validationError is slice of ErrValidation class errors
 type validationErrors []error
 
@@ -0,0 +1,46 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"storj.io/storj/satellite/console"
+)
+
+func TestPasswordRules(t *testing.T) {
+	rules := console.PasswordRules{
+		MinLength:      8,
+		RequireLetter:  true,
+		RequireUpper:   true,
+		RequireDigit:   true,
+		RequireSpecial: true,
+	}
+
+	for _, tt := range []struct {
+		password string
+		weak     bool
+	}{
+		{password: "123a123", weak: true},
+		{password: "Ab1!", weak: true},
+		{password: "abcdefg1!", weak: true},
+		{password: "ABCDEFGH!", weak: true},
+		{password: "Abcdefgh1", weak: true},
+		{password: "Abcdefg1!", weak: false},
+		{password: "Pässwört1?", weak: false},
+	} {
+		err := rules.Validate(tt.password)
+		if tt.weak {
+			assert.True(t, console.ErrWeakPassword.Has(err), tt.password)
+		} else {
+			assert.NoError(t, err, tt.password)
+		}
+	}
+
+	// rules without requirements still enforce the minimal length
+	assert.True(t, console.ErrWeakPassword.Has(console.PasswordRules{}.Validate("12345")))
+	assert.NoError(t, console.PasswordRules{}.Validate("123456"))
+}
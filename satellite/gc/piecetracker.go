@@ -13,6 +13,7 @@ import (
 	"storj.io/storj/pkg/bloomfilter"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/metainfo"
 )
 
 // PieceTracker implements the metainfo loop observer interface for garbage collection
@@ -21,6 +22,8 @@ type PieceTracker struct {
 	config       Config
 	creationDate time.Time
 	pieceCounts  map[storj.NodeID]int
+	// seed is shared by the bloom filters of all forks, so that they can be merged
+	seed byte
 
 	retainInfos map[storj.NodeID]*RetainInfo
 }
@@ -32,11 +35,46 @@ func NewPieceTracker(log *zap.Logger, config Config, pieceCounts map[storj.NodeI
 		config:       config,
 		creationDate: time.Now().UTC(),
 		pieceCounts:  pieceCounts,
+		seed:         bloomfilter.GenerateSeed(),
 
 		retainInfos: make(map[storj.NodeID]*RetainInfo),
 	}
 }
 
+// Fork returns a new piece tracker for a single shard of a parallel metainfo loop iteration
+func (pieceTracker *PieceTracker) Fork() metainfo.Observer {
+	return &PieceTracker{
+		log:          pieceTracker.log,
+		config:       pieceTracker.config,
+		creationDate: pieceTracker.creationDate,
+		pieceCounts:  pieceTracker.pieceCounts,
+		seed:         pieceTracker.seed,
+
+		retainInfos: make(map[storj.NodeID]*RetainInfo),
+	}
+}
+
+// Merge adds the pieces collected by a forked piece tracker to this piece tracker
+func (pieceTracker *PieceTracker) Merge(other metainfo.Observer) error {
+	fork, ok := other.(*PieceTracker)
+	if !ok {
+		return Error.New("unexpected observer type %T", other)
+	}
+
+	for nodeID, forkInfo := range fork.retainInfos {
+		info, ok := pieceTracker.retainInfos[nodeID]
+		if !ok {
+			pieceTracker.retainInfos[nodeID] = forkInfo
+			continue
+		}
+		if err := info.Filter.AddFilter(forkInfo.Filter); err != nil {
+			return Error.Wrap(err)
+		}
+		info.Count += forkInfo.Count
+	}
+	return nil
+}
+
 // RemoteSegment takes a remote segment found in metainfo and adds pieces to bloom filters
 func (pieceTracker *PieceTracker) RemoteSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) (err error) {
 	defer mon.Task()(&ctx, path)(&err)
@@ -70,7 +108,7 @@ func (pieceTracker *PieceTracker) add(nodeID storj.NodeID, pieceID storj.PieceID
 			numPieces = pieceTracker.pieceCounts[nodeID]
 		}
 		// limit size of bloom filter to ensure we are under the limit for GRPC
-		filter := bloomfilter.NewOptimalMaxSize(numPieces, pieceTracker.config.FalsePositiveRate, 2*memory.MiB)
+		filter := bloomfilter.NewOptimalMaxSizeSeed(pieceTracker.seed, numPieces, pieceTracker.config.FalsePositiveRate, 2*memory.MiB)
 		pieceTracker.retainInfos[nodeID] = &RetainInfo{
 			Filter:       filter,
 			CreationDate: pieceTracker.creationDate,
@@ -0,0 +1,76 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestPieceTrackerForkMerge(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	const (
+		numNodes    = 4
+		numSegments = 30
+		numForks    = 3
+	)
+
+	nodeIDs := make([]storj.NodeID, numNodes)
+	for i := range nodeIDs {
+		nodeIDs[i] = testrand.NodeID()
+	}
+
+	config := Config{InitialPieces: 100, FalsePositiveRate: 0.000000001}
+	tracker := NewPieceTracker(zaptest.NewLogger(t), config, nil)
+
+	forks := make([]*PieceTracker, numForks)
+	for i := range forks {
+		forks[i] = tracker.Fork().(*PieceTracker)
+	}
+
+	expected := make(map[storj.NodeID][]storj.PieceID)
+	for i := 0; i < numSegments; i++ {
+		pointer := &pb.Pointer{
+			Type: pb.Pointer_REMOTE,
+			Remote: &pb.RemoteSegment{
+				RootPieceId: testrand.PieceID(),
+			},
+		}
+		// every segment has a piece on all nodes but one
+		for k, nodeID := range nodeIDs {
+			if k == i%numNodes {
+				continue
+			}
+			pointer.Remote.RemotePieces = append(pointer.Remote.RemotePieces, &pb.RemotePiece{PieceNum: int32(k), NodeId: nodeID})
+			expected[nodeID] = append(expected[nodeID], pointer.Remote.RootPieceId.Derive(nodeID, int32(k)))
+		}
+
+		require.NoError(t, forks[i%numForks].RemoteSegment(ctx, "", pointer))
+	}
+
+	for _, fork := range forks {
+		require.NoError(t, tracker.Merge(fork))
+	}
+
+	require.Len(t, tracker.retainInfos, numNodes)
+	for nodeID, pieceIDs := range expected {
+		info := tracker.retainInfos[nodeID]
+		require.NotNil(t, info)
+		assert.Equal(t, len(pieceIDs), info.Count)
+		assert.Equal(t, tracker.creationDate, info.CreationDate)
+		for _, pieceID := range pieceIDs {
+			assert.True(t, info.Filter.Contains(pieceID))
+		}
+	}
+}
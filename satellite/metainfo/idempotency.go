@@ -0,0 +1,105 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"sync"
+	"time"
+
+	"storj.io/storj/pkg/storj"
+)
+
+// commitKey identifies a segment commit by its path and the idempotency key sent by the uplink
+type commitKey struct {
+	path storj.Path
+	key  string
+}
+
+// commitEntry is the state of a segment commit with an idempotency key
+type commitEntry struct {
+	committed bool
+	ttl       time.Time
+}
+
+// commitTTLItem keeps association between a commit key and its ttl
+type commitTTLItem struct {
+	key commitKey
+	ttl time.Time
+}
+
+// committedSegments remembers the idempotency keys of the recently committed segments,
+// so that a retried commit isn't applied twice. A key is reserved before the commit,
+// so that concurrent retries don't commit the segment either.
+type committedSegments struct {
+	mu      sync.Mutex
+	entries map[commitKey]*commitEntry
+	// entriesTTL is ordered by ttl, as every entry lives for requestTTL
+	entriesTTL []commitTTLItem
+}
+
+func newCommittedSegments() *committedSegments {
+	return &committedSegments{
+		entries: make(map[commitKey]*commitEntry),
+	}
+}
+
+// Reserve reserves the idempotency key for committing the segment at path. When
+// the key is already reserved it returns whether the segment was committed with
+// it, or whether the commit is still in progress.
+func (segments *committedSegments) Reserve(path storj.Path, idempotencyKey []byte) (reserved, committed bool) {
+	now := time.Now()
+	key := commitKey{path: path, key: string(idempotencyKey)}
+
+	segments.mu.Lock()
+	defer segments.mu.Unlock()
+
+	segments.cleanup(now)
+
+	if entry, found := segments.entries[key]; found {
+		return false, entry.committed
+	}
+
+	ttl := now.Add(requestTTL)
+	segments.entries[key] = &commitEntry{ttl: ttl}
+	segments.entriesTTL = append(segments.entriesTTL, commitTTLItem{key: key, ttl: ttl})
+	return true, false
+}
+
+// Commit records that the segment at path was committed with the reserved idempotency key.
+func (segments *committedSegments) Commit(path storj.Path, idempotencyKey []byte) {
+	segments.mu.Lock()
+	defer segments.mu.Unlock()
+
+	if entry, found := segments.entries[commitKey{path: path, key: string(idempotencyKey)}]; found {
+		entry.committed = true
+	}
+}
+
+// Release releases the reserved idempotency key after a failed commit, so that
+// the commit can be retried.
+func (segments *committedSegments) Release(path storj.Path, idempotencyKey []byte) {
+	segments.mu.Lock()
+	defer segments.mu.Unlock()
+
+	key := commitKey{path: path, key: string(idempotencyKey)}
+	if entry, found := segments.entries[key]; found && !entry.committed {
+		delete(segments.entries, key)
+	}
+}
+
+// cleanup removes the expired entries, it must be called with the lock held.
+func (segments *committedSegments) cleanup(now time.Time) {
+	expired := 0
+	for _, item := range segments.entriesTTL {
+		if !item.ttl.Before(now) {
+			break
+		}
+		// the key may have been released and reserved again since
+		if entry, found := segments.entries[item.key]; found && entry.ttl.Equal(item.ttl) {
+			delete(segments.entries, item.key)
+		}
+		expired++
+	}
+	segments.entriesTTL = segments.entriesTTL[expired:]
+}
@@ -5,10 +5,12 @@ package metainfo
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/zeebo/errs"
+	"golang.org/x/sync/errgroup"
 
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
@@ -29,6 +31,18 @@ type Observer interface {
 	InlineSegment(context.Context, storj.Path, *pb.Pointer) error
 }
 
+// ShardedObserver is an Observer that can be used with parallel iteration.
+// Each key range shard is handled by its own forked observer, and once all
+// shards are done the forks are merged back into the original observer.
+// Observers that don't implement ShardedObserver are called serially.
+type ShardedObserver interface {
+	Observer
+	// Fork returns a new observer for a single shard.
+	Fork() Observer
+	// Merge adds the results of a forked observer to this observer.
+	Merge(Observer) error
+}
+
 type observerContext struct {
 	Observer
 	ctx  context.Context
@@ -55,6 +69,7 @@ func (observer *observerContext) Wait() error {
 // LoopConfig contains configurable values for the metainfo loop.
 type LoopConfig struct {
 	CoalesceDuration time.Duration `help:"how long to wait for new observers before starting iteration" releaseDefault:"5s" devDefault:"5s"`
+	Parallelism      int           `help:"number of key range shards to iterate over in parallel" default:"1"`
 }
 
 // Loop is a metainfo loop service.
@@ -153,16 +168,131 @@ waitformore:
 		}
 	}
 
-	err = loop.metainfo.Iterate(ctx, "", "", true, false,
+	if loop.config.Parallelism <= 1 {
+		observers, err = loop.iterateRange(ctx, keyRange{}, observers)
+		return err
+	}
+
+	observers, err = loop.iterateShards(ctx, observers)
+	return err
+}
+
+// keyRange is a range of metainfo keys, from first (inclusive) to last (exclusive).
+// An empty first or last leaves the range unbounded on that side.
+type keyRange struct {
+	first string
+	last  string
+}
+
+// shardKeyRanges splits the metainfo key space into at most count ranges.
+// Metainfo keys start with a project ID, so the ranges are split on its first hex digit.
+func shardKeyRanges(count int) []keyRange {
+	const digits = "0123456789abcdef"
+	if count > len(digits) {
+		count = len(digits)
+	}
+
+	ranges := make([]keyRange, count)
+	for i := 1; i < count; i++ {
+		boundary := string(digits[i*len(digits)/count])
+		ranges[i-1].last = boundary
+		ranges[i].first = boundary
+	}
+	return ranges
+}
+
+// iterateShards goes through metainfo split into key range shards in parallel.
+// It returns the observers that finished without an error.
+func (loop *Loop) iterateShards(ctx context.Context, observers []*observerContext) (_ []*observerContext, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	ranges := shardKeyRanges(loop.config.Parallelism)
+
+	// forks[i][k] handles shard i on behalf of observers[k]
+	forks := make([][]*observerContext, len(ranges))
+	for i := range forks {
+		forks[i] = make([]*observerContext, len(observers))
+	}
+	// locked[k] is shared by all shards when observers[k] can't be forked
+	locked := make([]*lockedObserver, len(observers))
+	for k, observer := range observers {
+		locked[k] = &lockedObserver{Observer: observer.Observer}
+		var shardObserver Observer = locked[k]
+		sharded, isSharded := observer.Observer.(ShardedObserver)
+		for i := range ranges {
+			if isSharded {
+				shardObserver = sharded.Fork()
+			}
+			forks[i][k] = &observerContext{
+				Observer: shardObserver,
+				ctx:      observer.ctx,
+				done:     make(chan error, 1),
+			}
+		}
+	}
+
+	group, groupCtx := errgroup.WithContext(ctx)
+	for i, shard := range ranges {
+		i, shard := i, shard
+		group.Go(func() error {
+			_, err := loop.iterateRange(groupCtx, shard, forks[i])
+			return err
+		})
+	}
+	if err := group.Wait(); err != nil {
+		return observers, err
+	}
+
+	nextObservers := observers[:0]
+	for k, observer := range observers {
+		var shardErrs errs.Group
+		for i := range ranges {
+			select {
+			case err := <-forks[i][k].done:
+				shardErrs.Add(err)
+			default:
+			}
+		}
+
+		err := shardErrs.Err()
+		if sharded, ok := observer.Observer.(ShardedObserver); ok {
+			if err == nil {
+				for i := range ranges {
+					err = errs.Combine(err, sharded.Merge(forks[i][k].Observer))
+				}
+			}
+		} else if locked[k].err != nil {
+			// every shard that called the observer after it failed got the same error
+			err = locked[k].err
+		}
+
+		if !observer.HandleError(err) {
+			nextObservers = append(nextObservers, observer)
+		}
+	}
+
+	return nextObservers, nil
+}
+
+// iterateRange goes through the metainfo keys in the given range and sends them to the observers.
+// It returns the observers that finished without an error.
+func (loop *Loop) iterateRange(ctx context.Context, keys keyRange, observers []*observerContext) (_ []*observerContext, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = loop.metainfo.Iterate(ctx, "", keys.first, true, false,
 		func(ctx context.Context, it storage.Iterator) error {
 			var item storage.ListItem
 
-			// iterate over every segment in metainfo
+			// iterate over every segment in the range
 			for it.Next(ctx, &item) {
 				path := item.Key.String()
+				if keys.last != "" && path >= keys.last {
+					return nil
+				}
+
 				pointer := &pb.Pointer{}
 
-				err = proto.Unmarshal(item.Value, pointer)
+				err := proto.Unmarshal(item.Value, pointer)
 				if err != nil {
 					return LoopError.New("unexpected error unmarshalling pointer %s", err)
 				}
@@ -191,7 +321,44 @@ waitformore:
 			return nil
 		})
 
-	return err
+	return observers, err
+}
+
+// lockedObserver serializes calls to an observer that doesn't support parallel iteration.
+// After the observer fails it isn't called anymore, every shard gets its first error instead.
+type lockedObserver struct {
+	mu  sync.Mutex
+	err error
+	Observer
+}
+
+func (observer *lockedObserver) RemoteSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) error {
+	return observer.call(func() error { return observer.Observer.RemoteSegment(ctx, path, pointer) })
+}
+
+func (observer *lockedObserver) RemoteObject(ctx context.Context, path storj.Path, pointer *pb.Pointer) error {
+	return observer.call(func() error { return observer.Observer.RemoteObject(ctx, path, pointer) })
+}
+
+func (observer *lockedObserver) InlineSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) error {
+	return observer.call(func() error { return observer.Observer.InlineSegment(ctx, path, pointer) })
+}
+
+// call calls handler unless the observer already failed, recording the first error or panic
+func (observer *lockedObserver) call(handler func() error) (err error) {
+	observer.mu.Lock()
+	defer observer.mu.Unlock()
+
+	if observer.err != nil {
+		return observer.err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = LoopError.New("observer panicked: %v", r)
+		}
+		observer.err = err
+	}()
+	return handler()
 }
 
 // handlePointer deals with a pointer for a single observer
@@ -202,15 +369,15 @@ func handlePointer(ctx context.Context, observer *observerContext, path storj.Pa
 	remote := pointer.GetRemote()
 
 	if remote != nil {
-		if observer.HandleError(observer.RemoteSegment(ctx, path, pointer)) {
+		if observer.HandleError(callObserver(func() error { return observer.RemoteSegment(ctx, path, pointer) })) {
 			return false
 		}
 		if isLastSeg {
-			if observer.HandleError(observer.RemoteObject(ctx, path, pointer)) {
+			if observer.HandleError(callObserver(func() error { return observer.RemoteObject(ctx, path, pointer) })) {
 				return false
 			}
 		}
-	} else if observer.HandleError(observer.InlineSegment(ctx, path, pointer)) {
+	} else if observer.HandleError(callObserver(func() error { return observer.InlineSegment(ctx, path, pointer) })) {
 		return false
 	}
 
@@ -224,6 +391,20 @@ func handlePointer(ctx context.Context, observer *observerContext, path storj.Pa
 	return true
 }
 
+// callObserver calls an observer handler, so that an error or a panic from it
+// only detaches that observer instead of aborting the loop for every observer.
+func callObserver(handler func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = LoopError.New("observer panicked: %v", r)
+		}
+		if err != nil {
+			mon.Event("metainfo_loop_observer_error")
+		}
+	}()
+	return handler()
+}
+
 // Wait waits for run to be finished.
 // Safe to be called concurrently.
 func (loop *Loop) Wait() {
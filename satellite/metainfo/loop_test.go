@@ -6,6 +6,7 @@ package metainfo_test
 import (
 	"context"
 	"errors"
+	"strconv"
 	"strings"
 	"sync/atomic"
 	"testing"
@@ -190,6 +191,76 @@ func TestMetainfoLoopObserverCancel(t *testing.T) {
 	})
 }
 
+// TestMetainfoLoopObserverIsolation does the following:
+// * upload 3 remote segments
+// * hook three observers up to metainfo loop
+// * let observer 1 run normally
+// * let observer 2 return an error from its first RemoteSegment call
+// * let observer 3 panic from its first RemoteSegment call
+// * expect observer 1 to see all segments and finish without an error
+// * expect observers 2 and 3 to be detached with their errors after the first segment
+func TestMetainfoLoopObserverIsolation(t *testing.T) {
+	segmentSize := 8 * memory.KiB
+
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 4,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Metainfo.Loop.CoalesceDuration = 1 * time.Second
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		ul := planet.Uplinks[0]
+		satellite := planet.Satellites[0]
+		metaLoop := satellite.Metainfo.Loop
+
+		// upload 3 remote files with 1 segment
+		for i := 0; i < 3; i++ {
+			testData := testrand.Bytes(segmentSize)
+			path := "/some/remote/path/" + string(i)
+			err := ul.Upload(ctx, satellite, "bucket", path, testData)
+			require.NoError(t, err)
+		}
+
+		obs1 := newTestObserver(nil)
+		obs2 := newTestObserver(func(ctx context.Context) error {
+			return errors.New("test error")
+		})
+		obs3 := newTestObserver(func(ctx context.Context) error {
+			panic("test panic")
+		})
+
+		var group errgroup.Group
+		group.Go(func() error {
+			return metaLoop.Join(ctx, obs1)
+		})
+		group.Go(func() error {
+			err := metaLoop.Join(ctx, obs2)
+			if err == nil || !strings.Contains(err.Error(), "test error") {
+				return errors.New("expected test error")
+			}
+			return nil
+		})
+		group.Go(func() error {
+			err := metaLoop.Join(ctx, obs3)
+			if err == nil || !strings.Contains(err.Error(), "test panic") {
+				return errors.New("expected test panic")
+			}
+			return nil
+		})
+
+		err := group.Wait()
+		require.NoError(t, err)
+
+		assert.EqualValues(t, 3, obs1.remoteSegCount)
+		assert.EqualValues(t, 3, obs1.remoteFileCount)
+		assert.EqualValues(t, 1, obs2.remoteSegCount)
+		assert.EqualValues(t, 1, obs3.remoteSegCount)
+	})
+}
+
 // TestMetainfoLoopCancel does the following:
 // * upload 3 remote segments
 // * hook two observers up to metainfo loop
@@ -210,7 +281,7 @@ func TestMetainfoLoopCancel(t *testing.T) {
 		// upload 3 remote files with 1 segment
 		for i := 0; i < 3; i++ {
 			testData := testrand.Bytes(segmentSize)
-			path := "/some/remote/path/" + string(i)
+			path := "/some/remote/path/" + strconv.Itoa(i)
 			err := ul.Upload(ctx, satellite, "bucket", path, testData)
 			require.NoError(t, err)
 		}
@@ -277,6 +348,101 @@ func TestMetainfoLoopCancel(t *testing.T) {
 	})
 }
 
+// TestMetainfoLoopParallel does the following:
+// * put remote and inline segments into metainfo for several projects
+// * run the metainfo loop serially and with several key range shards
+// * expect that every observer has seen every segment exactly once
+// * expect that the sharded runs produce the same stats as the serial run
+func TestMetainfoLoopParallel(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+
+		// put 2 remote files and 1 inline file into each of 20 projects
+		for i := 0; i < 20; i++ {
+			projectID := testrand.UUID().String()
+			for _, path := range []string{
+				storj.JoinPaths(projectID, "l", "bucket", "remote-0"),
+				storj.JoinPaths(projectID, "l", "bucket", "remote-1"),
+			} {
+				err := satellite.Metainfo.Service.Put(ctx, path, &pb.Pointer{
+					Type: pb.Pointer_REMOTE,
+					Remote: &pb.RemoteSegment{
+						RootPieceId: testrand.PieceID(),
+						Redundancy:  &pb.RedundancyScheme{},
+					},
+				})
+				require.NoError(t, err)
+			}
+
+			path := storj.JoinPaths(projectID, "l", "bucket", "inline")
+			err := satellite.Metainfo.Service.Put(ctx, path, &pb.Pointer{
+				Type:          pb.Pointer_INLINE,
+				InlineSegment: []byte("inline"),
+			})
+			require.NoError(t, err)
+		}
+
+		runLoop := func(parallelism int, observers ...metainfo.Observer) error {
+			metaLoop := metainfo.NewLoop(metainfo.LoopConfig{
+				CoalesceDuration: 1 * time.Second,
+				Parallelism:      parallelism,
+			}, satellite.Metainfo.Service)
+
+			loopCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			var loopGroup errgroup.Group
+			loopGroup.Go(func() error {
+				return errs2.IgnoreCanceled(metaLoop.Run(loopCtx))
+			})
+
+			var group errgroup.Group
+			for _, obs := range observers {
+				obs := obs
+				group.Go(func() error {
+					return metaLoop.Join(ctx, obs)
+				})
+			}
+			err := group.Wait()
+
+			cancel()
+			require.NoError(t, loopGroup.Wait())
+			return err
+		}
+
+		serial := newTestObserver(nil)
+		require.NoError(t, runLoop(1, serial))
+
+		assert.EqualValues(t, 40, serial.remoteSegCount)
+		assert.EqualValues(t, 40, serial.remoteFileCount)
+		assert.EqualValues(t, 20, serial.inlineSegCount)
+		assert.EqualValues(t, 60, len(serial.uniquePaths))
+
+		for _, parallelism := range []int{2, 5, 16, 32} {
+			locked := newTestObserver(nil)
+			sharded := &shardedTestObserver{newTestObserver(nil)}
+			require.NoError(t, runLoop(parallelism, locked, sharded))
+
+			for _, obs := range []*testObserver{locked, sharded.testObserver} {
+				assert.Equal(t, serial.remoteSegCount, obs.remoteSegCount, parallelism)
+				assert.Equal(t, serial.remoteFileCount, obs.remoteFileCount, parallelism)
+				assert.Equal(t, serial.inlineSegCount, obs.inlineSegCount, parallelism)
+				assert.Equal(t, serial.uniquePaths, obs.uniquePaths, parallelism)
+			}
+		}
+
+		// an observer shared by the shards isn't called after it failed,
+		// and it finishes with its first error
+		failure := errors.New("observer failed")
+		failing := newTestObserver(func(context.Context) error { return failure })
+		err := runLoop(16, failing)
+		assert.Equal(t, failure, err)
+		assert.Equal(t, 1, failing.remoteSegCount)
+	})
+}
+
 type testObserver struct {
 	remoteSegCount  int
 	remoteFileCount int
@@ -325,3 +491,68 @@ func (obs *testObserver) InlineSegment(ctx context.Context, path storj.Path, poi
 	obs.uniquePaths[path] = struct{}{}
 	return nil
 }
+
+type shardedTestObserver struct {
+	*testObserver
+}
+
+func (obs *shardedTestObserver) Fork() metainfo.Observer {
+	return newTestObserver(nil)
+}
+
+func (obs *shardedTestObserver) Merge(other metainfo.Observer) error {
+	fork := other.(*testObserver)
+	obs.remoteSegCount += fork.remoteSegCount
+	obs.remoteFileCount += fork.remoteFileCount
+	obs.inlineSegCount += fork.inlineSegCount
+	for path := range fork.uniquePaths {
+		if _, ok := obs.uniquePaths[path]; ok {
+			return errors.New("path seen by multiple shards: " + path)
+		}
+		obs.uniquePaths[path] = struct{}{}
+	}
+	return nil
+}
+
+// TestSizeHistogramObserver uploads segments of different sizes, joins a
+// SizeHistogramObserver to the metainfo loop and checks the bucket counts.
+func TestSizeHistogramObserver(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 4,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Metainfo.Loop.CoalesceDuration = 1 * time.Second
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		ul := planet.Uplinks[0]
+		satellite := planet.Satellites[0]
+
+		uploads := []struct {
+			count int
+			size  memory.Size
+		}{
+			{2, 1 * memory.KiB},  // inline
+			{3, 8 * memory.KiB},  // small remote
+			{1, 32 * memory.KiB}, // large remote
+		}
+		for _, upload := range uploads {
+			for i := 0; i < upload.count; i++ {
+				path := "/path/" + upload.size.String() + "/" + strconv.Itoa(i)
+				err := ul.Upload(ctx, satellite, "bucket", path, testrand.Bytes(upload.size))
+				require.NoError(t, err)
+			}
+		}
+
+		observer := metainfo.NewSizeHistogramObserver([]memory.Size{16 * memory.KiB, 4 * memory.KiB, 64 * memory.KiB})
+		err := satellite.Metainfo.Loop.Join(ctx, observer)
+		require.NoError(t, err)
+
+		histogram := observer.Histogram()
+		assert.Equal(t, []memory.Size{4 * memory.KiB, 16 * memory.KiB, 64 * memory.KiB}, histogram.Bounds)
+		assert.Equal(t, []int64{2, 3, 1, 0}, histogram.Counts)
+		assert.True(t, histogram.Total >= 2*memory.KiB+3*8*memory.KiB+32*memory.KiB)
+	})
+}
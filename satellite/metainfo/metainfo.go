@@ -38,6 +38,9 @@ const (
 	satIDExpiration     = 24 * time.Hour
 	lastSegment         = -1
 	listLimit           = 1000
+
+	// apiKeyLastUsedInterval limits how often the last used time of an api key is written
+	apiKeyLastUsedInterval = time.Minute
 )
 
 var (
@@ -49,6 +52,7 @@ var (
 // APIKeys is api keys store methods used by endpoint
 type APIKeys interface {
 	GetByHead(ctx context.Context, head []byte) (*console.APIKeyInfo, error)
+	UpdateLastUsed(ctx context.Context, id uuid.UUID, lastUsed time.Time) error
 }
 
 // Revocations is the revocations store methods used by the endpoint
@@ -72,6 +76,7 @@ type Endpoint struct {
 	containment      Containment
 	apiKeys          APIKeys
 	createRequests   *createRequests
+	committed        *committedSegments
 	requiredRSConfig RSConfig
 	satellite        signing.Signer
 }
@@ -90,6 +95,7 @@ func NewEndpoint(log *zap.Logger, metainfo *Service, orders *orders.Service, cac
 		apiKeys:          apiKeys,
 		projectUsage:     projectUsage,
 		createRequests:   newCreateRequests(),
+		committed:        newCommittedSegments(),
 		requiredRSConfig: rsConfig,
 		satellite:        satellite,
 	}
@@ -175,6 +181,7 @@ func (endpoint *Endpoint) CreateSegmentOld(ctx context.Context, req *pb.SegmentW
 		RequestedCount: int(req.Redundancy.Total),
 		FreeBandwidth:  maxPieceSize,
 		FreeDisk:       maxPieceSize,
+		Reserve:        true,
 	}
 	nodes, err := endpoint.cache.FindStorageNodes(ctx, request)
 	if err != nil {
@@ -184,6 +191,7 @@ func (endpoint *Endpoint) CreateSegmentOld(ctx context.Context, req *pb.SegmentW
 	bucketID := createBucketID(keyInfo.ProjectID, req.Bucket)
 	rootPieceID, addressedLimits, piecePrivateKey, err := endpoint.orders.CreatePutOrderLimits(ctx, bucketID, nodes, req.Expiration, maxPieceSize)
 	if err != nil {
+		endpoint.releaseCapacity(ctx, nodes, maxPieceSize)
 		return nil, Error.Wrap(err)
 	}
 
@@ -232,19 +240,48 @@ func (endpoint *Endpoint) CommitSegmentOld(ctx context.Context, req *pb.SegmentC
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	err = endpoint.validateCommitSegment(ctx, req)
+	path, err := CreatePath(ctx, keyInfo.ProjectID, req.Segment, req.Bucket, req.Path)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, err.Error())
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	err = endpoint.filterValidPieces(ctx, req.Pointer, req.OriginalLimits)
+	if len(req.IdempotencyKey) > 0 {
+		reserved, committed := endpoint.committed.Reserve(path, req.IdempotencyKey)
+		if !reserved {
+			mon.Meter("commit_segment_duplicate").Mark(1)
+			if !committed {
+				return nil, status.Errorf(codes.Aborted, "segment commit with the same idempotency key is in progress")
+			}
+
+			// a retried commit returns the segment committed by the first attempt
+			pointer, err := endpoint.metainfo.Get(ctx, path)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, err.Error())
+			}
+
+			if len(req.OriginalLimits) > 0 {
+				endpoint.createRequests.Remove(req.OriginalLimits[0].SerialNumber)
+			}
+
+			return &pb.SegmentCommitResponseOld{Pointer: pointer}, nil
+		}
+
+		// a failed commit can be retried with the same key
+		defer func() {
+			if err != nil {
+				endpoint.committed.Release(path, req.IdempotencyKey)
+			}
+		}()
+	}
+
+	err = endpoint.validateCommitSegment(ctx, req)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 
-	path, err := CreatePath(ctx, keyInfo.ProjectID, req.Segment, req.Bucket, req.Path)
+	err = endpoint.filterValidPieces(ctx, req.Pointer, req.OriginalLimits)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 
 	exceeded, limit, err := endpoint.projectUsage.ExceedsStorageUsage(ctx, keyInfo.ProjectID)
@@ -286,6 +323,10 @@ func (endpoint *Endpoint) CommitSegmentOld(ctx context.Context, req *pb.SegmentC
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 
+	if len(req.IdempotencyKey) > 0 {
+		endpoint.committed.Commit(path, req.IdempotencyKey)
+	}
+
 	if req.Pointer.Type == pb.Pointer_INLINE {
 		// TODO or maybe use pointer.SegmentSize ??
 		err = endpoint.orders.UpdatePutInlineOrder(ctx, keyInfo.ProjectID, req.Bucket, int64(len(req.Pointer.InlineSegment)))
@@ -302,10 +343,27 @@ func (endpoint *Endpoint) CommitSegmentOld(ctx context.Context, req *pb.SegmentC
 	if len(req.OriginalLimits) > 0 {
 		endpoint.createRequests.Remove(req.OriginalLimits[0].SerialNumber)
 	}
+	endpoint.releaseLimitsCapacity(ctx, req.OriginalLimits)
 
 	return &pb.SegmentCommitResponseOld{Pointer: pointer}, nil
 }
 
+// releaseCapacity releases the capacity reserved on nodes when they were selected for an upload
+func (endpoint *Endpoint) releaseCapacity(ctx context.Context, nodes []*pb.Node, maxPieceSize int64) {
+	for _, node := range nodes {
+		endpoint.cache.ReleaseCapacity(ctx, node.Id, maxPieceSize)
+	}
+}
+
+// releaseLimitsCapacity releases the capacity reserved for the upload of a committed segment
+func (endpoint *Endpoint) releaseLimitsCapacity(ctx context.Context, limits []*pb.OrderLimit) {
+	for _, limit := range limits {
+		if limit != nil {
+			endpoint.cache.ReleaseCapacity(ctx, limit.StorageNodeId, limit.Limit)
+		}
+	}
+}
+
 // DownloadSegmentOld gets Pointer incase of INLINE data or list of OrderLimit necessary to download remote data
 func (endpoint *Endpoint) DownloadSegmentOld(ctx context.Context, req *pb.SegmentDownloadRequestOld) (resp *pb.SegmentDownloadResponseOld, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -558,6 +616,74 @@ func (endpoint *Endpoint) SetAttributionOld(ctx context.Context, req *pb.SetAttr
 	return &pb.SetAttributionResponseOld{}, err
 }
 
+// RelocateObjectOld moves the pointers of the segments of an object to a new
+// path, replacing their metadata. Remote pointers keep their pieces, so they
+// can be relocated without contacting storage nodes. Either all segments are
+// relocated or none.
+func (endpoint *Endpoint) RelocateObjectOld(ctx context.Context, req *pb.ObjectRelocateRequestOld) (resp *pb.ObjectRelocateResponseOld, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, macaroon.Action{
+		Op:            macaroon.ActionDelete,
+		Bucket:        req.Bucket,
+		EncryptedPath: req.Path,
+		Time:          time.Now(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+	}
+
+	newKeyInfo, err := endpoint.validateAuth(ctx, macaroon.Action{
+		Op:            macaroon.ActionWrite,
+		Bucket:        req.NewBucket,
+		EncryptedPath: req.NewPath,
+		Time:          time.Now(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+	}
+	if newKeyInfo.ProjectID != keyInfo.ProjectID {
+		return nil, status.Errorf(codes.InvalidArgument, "objects cannot be relocated to another project")
+	}
+
+	for _, bucket := range [][]byte{req.Bucket, req.NewBucket} {
+		err = endpoint.validateBucket(ctx, bucket)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	if len(req.Segments) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "no segments to relocate")
+	}
+
+	relocations := make([]Relocation, 0, len(req.Segments))
+	for _, segment := range req.Segments {
+		from, err := CreatePath(ctx, keyInfo.ProjectID, segment.Segment, req.Bucket, req.Path)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		to, err := CreatePath(ctx, keyInfo.ProjectID, segment.Segment, req.NewBucket, req.NewPath)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		relocations = append(relocations, Relocation{From: from, To: to, Metadata: segment.Metadata})
+	}
+
+	err = endpoint.metainfo.Relocate(ctx, relocations)
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		if storage.ErrValueChanged.Has(err) {
+			return nil, status.Errorf(codes.AlreadyExists, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &pb.ObjectRelocateResponseOld{}, nil
+}
+
 // bytesToUUID is used to convert []byte to UUID
 func bytesToUUID(data []byte) (uuid.UUID, error) {
 	var id uuid.UUID
@@ -1306,6 +1432,7 @@ func (endpoint *Endpoint) BeginSegment(ctx context.Context, req *pb.SegmentBegin
 		RequestedCount: redundancy.TotalCount(),
 		FreeBandwidth:  maxPieceSize,
 		FreeDisk:       maxPieceSize,
+		Reserve:        true,
 	}
 	nodes, err := endpoint.cache.FindStorageNodes(ctx, request)
 	if err != nil {
@@ -1315,6 +1442,7 @@ func (endpoint *Endpoint) BeginSegment(ctx context.Context, req *pb.SegmentBegin
 	bucketID := createBucketID(keyInfo.ProjectID, streamID.Bucket)
 	rootPieceID, addressedLimits, piecePrivateKey, err := endpoint.orders.CreatePutOrderLimits(ctx, bucketID, nodes, streamID.ExpirationDate, maxPieceSize)
 	if err != nil {
+		endpoint.releaseCapacity(ctx, nodes, maxPieceSize)
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 
@@ -1435,6 +1563,7 @@ func (endpoint *Endpoint) CommitSegment(ctx context.Context, req *pb.SegmentComm
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
+	endpoint.releaseLimitsCapacity(ctx, orderLimits)
 
 	return &pb.SegmentCommitResponse{}, nil
 }
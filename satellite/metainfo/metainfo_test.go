@@ -768,6 +768,33 @@ func TestGetProjectInfo(t *testing.T) {
 	})
 }
 
+func TestAPIKeyLastUsed(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+
+		key, err := macaroon.ParseAPIKey(apiKey)
+		require.NoError(t, err)
+
+		apiKeys := planet.Satellites[0].DB.Console().APIKeys()
+
+		before := time.Now()
+
+		metainfoClient, err := planet.Uplinks[0].DialMetainfo(ctx, planet.Satellites[0], apiKey)
+		require.NoError(t, err)
+		defer ctx.Check(metainfoClient.Close)
+
+		_, err = metainfoClient.GetProjectInfo(ctx)
+		require.NoError(t, err)
+
+		keyInfo, err := apiKeys.GetByHead(ctx, key.Head())
+		require.NoError(t, err)
+		require.False(t, keyInfo.LastUsed.IsZero())
+		require.False(t, keyInfo.LastUsed.Before(before.Add(-time.Second)))
+	})
+}
+
 func runCreateSegment(ctx context.Context, t *testing.T, metainfo *metainfo.Client) (*pb.Pointer, []*pb.OrderLimit) {
 	pointer := createTestPointer(t)
 
@@ -9,6 +9,7 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
 	"storj.io/storj/pkg/macaroon"
@@ -233,6 +234,94 @@ func (s *Service) setMetadata(item *pb.ListResponse_Item, data []byte, metaFlags
 	return nil
 }
 
+// Relocation describes moving the pointer at From to To and replacing its
+// metadata with Metadata. From and To may be the same path.
+type Relocation struct {
+	From     string
+	To       string
+	Metadata []byte
+}
+
+// Relocate moves pointers to new paths, replacing their metadata. Either all
+// relocations are done or none: every new pointer is written before any old
+// one is removed, and when any step fails the steps done so far are undone.
+// Moved pointers must not overwrite existing ones.
+func (s *Service) Relocate(ctx context.Context, relocations []Relocation) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	oldValues := make([]storage.Value, len(relocations))
+	newValues := make([]storage.Value, len(relocations))
+	for i, relocation := range relocations {
+		oldValues[i], err = s.DB.Get(ctx, []byte(relocation.From))
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		pointer := &pb.Pointer{}
+		err = proto.Unmarshal(oldValues[i], pointer)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		pointer.Metadata = relocation.Metadata
+		pointer.CreationDate = time.Now()
+
+		newValues[i], err = proto.Marshal(pointer)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	// undo holds the reverse of every swap done so far
+	var undo []func() error
+	defer func() {
+		if err == nil {
+			return
+		}
+		var group errs.Group
+		for i := len(undo) - 1; i >= 0; i-- {
+			group.Add(undo[i]())
+		}
+		if undoErr := group.Err(); undoErr != nil {
+			s.logger.Error("failed to undo relocation", zap.Error(undoErr))
+		}
+	}()
+
+	swap := func(path string, oldValue, newValue storage.Value) error {
+		err := s.DB.CompareAndSwap(ctx, []byte(path), oldValue, newValue)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		undo = append(undo, func() error {
+			return s.DB.CompareAndSwap(ctx, []byte(path), newValue, oldValue)
+		})
+		return nil
+	}
+
+	for i, relocation := range relocations {
+		var expected storage.Value
+		if relocation.From == relocation.To {
+			expected = oldValues[i]
+		}
+		err = swap(relocation.To, expected, newValues[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	for i, relocation := range relocations {
+		if relocation.From == relocation.To {
+			continue
+		}
+		err = swap(relocation.From, oldValues[i], nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // Delete deletes from item from db
 func (s *Service) Delete(ctx context.Context, path string) (err error) {
 	defer mon.Task()(&ctx)(&err)
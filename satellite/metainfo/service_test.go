@@ -5,16 +5,23 @@ package metainfo_test
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
 
 	"storj.io/storj/internal/memory"
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/testplanet"
 	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/storage"
+	"storj.io/storj/storage/teststore"
 )
 
 func TestIterate(t *testing.T) {
@@ -55,3 +62,78 @@ func TestIterate(t *testing.T) {
 		require.Equal(t, 1, itemCount)
 	})
 }
+
+// failingStore fails the first CompareAndSwap of failKey.
+type failingStore struct {
+	storage.KeyValueStore
+	failKey storage.Key
+}
+
+func (store *failingStore) CompareAndSwap(ctx context.Context, key storage.Key, oldValue, newValue storage.Value) error {
+	if store.failKey != nil && key.Equal(store.failKey) {
+		store.failKey = nil
+		return errors.New("injected failure")
+	}
+	return store.KeyValueStore.CompareAndSwap(ctx, key, oldValue, newValue)
+}
+
+func TestRelocateFailure(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	const numSegments = 3
+	segmentPath := func(object string, segment int) string {
+		return fmt.Sprintf("project/s%d/bucket/%s", segment, object)
+	}
+
+	for _, tt := range []struct {
+		name     string
+		dst      string
+		failPath string
+	}{
+		{name: "same location", dst: "src", failPath: segmentPath("src", 2)},
+		{name: "write destination", dst: "dst", failPath: segmentPath("dst", 2)},
+		{name: "remove source", dst: "dst", failPath: segmentPath("src", 2)},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &failingStore{KeyValueStore: teststore.New()}
+			service := metainfo.NewService(zaptest.NewLogger(t), store, nil)
+
+			var relocations []metainfo.Relocation
+			for i := 0; i < numSegments; i++ {
+				require.NoError(t, service.Put(ctx, segmentPath("src", i), &pb.Pointer{
+					Type:     pb.Pointer_INLINE,
+					Metadata: []byte("old"),
+				}))
+				relocations = append(relocations, metainfo.Relocation{
+					From:     segmentPath("src", i),
+					To:       segmentPath(tt.dst, i),
+					Metadata: []byte("new"),
+				})
+			}
+
+			store.failKey = storage.Key(tt.failPath)
+			require.Error(t, service.Relocate(ctx, relocations))
+
+			// nothing was relocated
+			for i := 0; i < numSegments; i++ {
+				pointer, err := service.Get(ctx, segmentPath("src", i))
+				require.NoError(t, err)
+				assert.Equal(t, []byte("old"), pointer.Metadata)
+
+				if tt.dst != "src" {
+					_, err = service.Get(ctx, segmentPath(tt.dst, i))
+					assert.True(t, storage.ErrKeyNotFound.Has(err))
+				}
+			}
+
+			// relocating works once the failure is gone
+			require.NoError(t, service.Relocate(ctx, relocations))
+			for i := 0; i < numSegments; i++ {
+				pointer, err := service.Get(ctx, segmentPath(tt.dst, i))
+				require.NoError(t, err)
+				assert.Equal(t, []byte("new"), pointer.Metadata)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"sort"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+// DefaultSizeHistogramBounds are the bucket bounds used when none are given to NewSizeHistogramObserver.
+var DefaultSizeHistogramBounds = []memory.Size{
+	4 * memory.KiB,
+	64 * memory.KiB,
+	memory.MiB,
+	16 * memory.MiB,
+	64 * memory.MiB,
+}
+
+// SizeHistogram is a distribution of segment sizes.
+type SizeHistogram struct {
+	// Bounds are the inclusive upper bounds of the buckets, in increasing order.
+	Bounds []memory.Size
+	// Counts holds the number of segments in each bucket. It has one more
+	// entry than Bounds, counting the segments larger than the last bound.
+	Counts []int64
+	// Total is the sum of all segment sizes.
+	Total memory.Size
+}
+
+// SizeHistogramObserver implements the metainfo loop observer interface to
+// collect the distribution of segment sizes, for capacity planning.
+type SizeHistogramObserver struct {
+	histogram SizeHistogram
+}
+
+// NewSizeHistogramObserver creates an observer bucketing segment sizes by the
+// given upper bounds. DefaultSizeHistogramBounds are used when bounds is empty.
+func NewSizeHistogramObserver(bounds []memory.Size) *SizeHistogramObserver {
+	if len(bounds) == 0 {
+		bounds = DefaultSizeHistogramBounds
+	}
+	sorted := append([]memory.Size{}, bounds...)
+	sort.Slice(sorted, func(i, k int) bool { return sorted[i] < sorted[k] })
+
+	return &SizeHistogramObserver{
+		histogram: SizeHistogram{
+			Bounds: sorted,
+			Counts: make([]int64, len(sorted)+1),
+		},
+	}
+}
+
+// RemoteSegment adds the size of a remote segment to the histogram.
+func (observer *SizeHistogramObserver) RemoteSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) (err error) {
+	observer.add(memory.Size(pointer.GetSegmentSize()))
+	return nil
+}
+
+// RemoteObject returns nil because the last segment of the object is also reported by RemoteSegment.
+func (observer *SizeHistogramObserver) RemoteObject(ctx context.Context, path storj.Path, pointer *pb.Pointer) (err error) {
+	return nil
+}
+
+// InlineSegment adds the size of an inline segment to the histogram.
+func (observer *SizeHistogramObserver) InlineSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) (err error) {
+	size := pointer.GetSegmentSize()
+	if size == 0 {
+		size = int64(len(pointer.GetInlineSegment()))
+	}
+	observer.add(memory.Size(size))
+	return nil
+}
+
+// Fork returns an empty observer with the same bounds, for a single shard.
+func (observer *SizeHistogramObserver) Fork() Observer {
+	return NewSizeHistogramObserver(observer.histogram.Bounds)
+}
+
+// Merge adds the counts of a forked observer to this observer.
+func (observer *SizeHistogramObserver) Merge(forked Observer) error {
+	other, ok := forked.(*SizeHistogramObserver)
+	if !ok {
+		return Error.New("unexpected observer type %T", forked)
+	}
+	for i, count := range other.histogram.Counts {
+		observer.histogram.Counts[i] += count
+	}
+	observer.histogram.Total += other.histogram.Total
+	return nil
+}
+
+// Histogram returns the distribution collected so far. It should be called
+// once the observer has finished its loop.
+func (observer *SizeHistogramObserver) Histogram() SizeHistogram {
+	return SizeHistogram{
+		Bounds: append([]memory.Size{}, observer.histogram.Bounds...),
+		Counts: append([]int64{}, observer.histogram.Counts...),
+		Total:  observer.histogram.Total,
+	}
+}
+
+// add counts a segment of the given size.
+func (observer *SizeHistogramObserver) add(size memory.Size) {
+	bucket := sort.Search(len(observer.histogram.Bounds), func(i int) bool {
+		return size <= observer.histogram.Bounds[i]
+	})
+	observer.histogram.Counts[bucket]++
+	observer.histogram.Total += size
+}
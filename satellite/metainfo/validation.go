@@ -147,6 +147,16 @@ func (endpoint *Endpoint) validateAuth(ctx context.Context, action macaroon.Acti
 		return nil, status.Errorf(codes.Unauthenticated, "Invalid API credential")
 	}
 
+	now := time.Now()
+	if now.Sub(keyInfo.LastUsed) >= apiKeyLastUsedInterval {
+		err = endpoint.apiKeys.UpdateLastUsed(ctx, keyInfo.ID, now)
+		if err != nil {
+			endpoint.log.Warn("unable to update api key last used time", zap.Error(err))
+		} else {
+			keyInfo.LastUsed = now
+		}
+	}
+
 	return keyInfo, nil
 }
 
@@ -603,7 +603,11 @@ func (service *Service) CreateGetRepairOrderLimits(ctx context.Context, bucketID
 }
 
 // CreatePutRepairOrderLimits creates the order limits for uploading the repaired pieces of pointer to newNodes.
-func (service *Service) CreatePutRepairOrderLimits(ctx context.Context, bucketID []byte, pointer *pb.Pointer, getOrderLimits []*pb.AddressedOrderLimit, newNodes []*pb.Node) (_ []*pb.AddressedOrderLimit, _ storj.PiecePrivateKey, err error) {
+//
+// The repaired pieces are placed at the erasure share numbers in lostPieces first, so that the
+// lost shares are regenerated, and then at share numbers which the pointer has never used.
+// Share numbers of pieces that are still in the pointer and not lost are never reused.
+func (service *Service) CreatePutRepairOrderLimits(ctx context.Context, bucketID []byte, pointer *pb.Pointer, getOrderLimits []*pb.AddressedOrderLimit, lostPieces []int32, newNodes []*pb.Node) (_ []*pb.AddressedOrderLimit, _ storj.PiecePrivateKey, err error) {
 	defer mon.Task()(&ctx)(&err)
 	orderExpiration := time.Now().Add(service.orderExpiration)
 
@@ -647,16 +651,18 @@ func (service *Service) CreatePutRepairOrderLimits(ctx context.Context, bucketID
 			totalPiecesToRepair = totalPiecesAfterRepair - numCurrentPieces
 			rootPieceID         = pointer.GetRemote().RootPieceId
 			pieceSize           = eestream.CalcPieceSize(pointer.GetSegmentSize(), redundancy)
-			pieceNum            int32
 		)
-		for _, node := range newNodes {
-			for int(pieceNum) < totalPieces && getOrderLimits[pieceNum] != nil {
-				pieceNum++
-			}
 
-			if int(pieceNum) >= totalPieces { // should not happen
-				return nil, storj.PiecePrivateKey{}, Error.New("piece num greater than total pieces: %d >= %d", pieceNum, totalPieces)
+		pieceNums := repairPieceNums(pointer, getOrderLimits, lostPieces, totalPieces)
+		if totalPiecesToRepair > 0 && len(pieceNums) == 0 { // should not happen
+			return nil, storj.PiecePrivateKey{}, Error.New("no piece numbers available for repair")
+		}
+
+		for i, node := range newNodes {
+			if i >= len(pieceNums) {
+				break
 			}
+			pieceNum := pieceNums[i]
 
 			orderLimit, err := signing.SignOrderLimit(ctx, service.satellite, &pb.OrderLimit{
 				SerialNumber:     serialNumber,
@@ -679,7 +685,6 @@ func (service *Service) CreatePutRepairOrderLimits(ctx context.Context, bucketID
 				Limit:              orderLimit,
 				StorageNodeAddress: node.Address,
 			}
-			pieceNum++
 			totalPiecesToRepair--
 
 			if totalPiecesToRepair == 0 {
@@ -704,6 +709,37 @@ func (service *Service) CreatePutRepairOrderLimits(ctx context.Context, bucketID
 	return limits, piecePrivateKey, nil
 }
 
+// repairPieceNums returns the piece numbers at which repaired pieces can be placed, in order of
+// preference: the lost pieces first and then the piece numbers that aren't used by the pointer.
+func repairPieceNums(pointer *pb.Pointer, getOrderLimits []*pb.AddressedOrderLimit, lostPieces []int32, totalPieces int) []int32 {
+	used := make(map[int32]bool)
+	for _, piece := range pointer.GetRemote().GetRemotePieces() {
+		used[piece.GetPieceNum()] = true
+	}
+	lost := make(map[int32]bool, len(lostPieces))
+	for _, pieceNum := range lostPieces {
+		lost[pieceNum] = true
+	}
+
+	// a piece that is being downloaded for the repair is healthy
+	downloading := func(pieceNum int32) bool {
+		return int(pieceNum) < len(getOrderLimits) && getOrderLimits[pieceNum] != nil
+	}
+
+	var pieceNums []int32
+	for pieceNum := int32(0); int(pieceNum) < totalPieces; pieceNum++ {
+		if used[pieceNum] && lost[pieceNum] && !downloading(pieceNum) {
+			pieceNums = append(pieceNums, pieceNum)
+		}
+	}
+	for pieceNum := int32(0); int(pieceNum) < totalPieces; pieceNum++ {
+		if !used[pieceNum] && !downloading(pieceNum) {
+			pieceNums = append(pieceNums, pieceNum)
+		}
+	}
+	return pieceNums
+}
+
 // UpdateGetInlineOrder updates amount of inline GET bandwidth for given bucket
 func (service *Service) UpdateGetInlineOrder(ctx context.Context, projectID uuid.UUID, bucketName []byte, amount int64) (err error) {
 	defer mon.Task()(&ctx)(&err)
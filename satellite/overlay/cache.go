@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"net"
+	"sync"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -35,15 +36,18 @@ var ErrBucketNotFound = errs.New("bucket not found")
 // ErrNotEnoughNodes is when selecting nodes failed with the given parameters
 var ErrNotEnoughNodes = errs.Class("not enough nodes")
 
+// ErrNotEnoughCapacity is returned when a node doesn't have enough unreserved free disk
+var ErrNotEnoughCapacity = errs.Class("not enough capacity")
+
 // OverlayError creates class of errors for stack traces
 var OverlayError = errs.Class("overlay error")
 
 // DB implements the database for overlay.Cache
 type DB interface {
 	// SelectStorageNodes looks up nodes based on criteria
-	SelectStorageNodes(ctx context.Context, count int, criteria *NodeCriteria) ([]*pb.Node, error)
+	SelectStorageNodes(ctx context.Context, count int, criteria *NodeCriteria) ([]*NodeDossier, error)
 	// SelectNewStorageNodes looks up nodes based on new node criteria
-	SelectNewStorageNodes(ctx context.Context, count int, criteria *NodeCriteria) ([]*pb.Node, error)
+	SelectNewStorageNodes(ctx context.Context, count int, criteria *NodeCriteria) ([]*NodeDossier, error)
 
 	// Get looks up the node by nodeID
 	Get(ctx context.Context, nodeID storj.NodeID) (*NodeDossier, error)
@@ -53,6 +57,8 @@ type DB interface {
 	KnownUnreliableOrOffline(context.Context, *NodeCriteria, storj.NodeIDList) (storj.NodeIDList, error)
 	// Reliable returns all nodes that are reliable
 	Reliable(context.Context, *NodeCriteria) (storj.NodeIDList, error)
+	// CountByVersion returns the number of online nodes per version string
+	CountByVersion(ctx context.Context, criteria *NodeCriteria) (map[string]int, error)
 	// Paginate will page through the database nodes
 	Paginate(ctx context.Context, offset int64, limit int) ([]*NodeDossier, bool, error)
 	// PaginateQualified will page through the qualified nodes
@@ -67,6 +73,18 @@ type DB interface {
 	UpdateStats(ctx context.Context, request *UpdateRequest) (stats *NodeStats, err error)
 	// UpdateNodeInfo updates node dossier with info requested from the node itself like node type, email, wallet, capacity, and version.
 	UpdateNodeInfo(ctx context.Context, node storj.NodeID, nodeInfo *pb.InfoResponse) (stats *NodeDossier, err error)
+	// BatchUpdateNodeInfo updates the node info of multiple storagenodes, batchSize nodes per transaction.
+	BatchUpdateNodeInfo(ctx context.Context, updates []*NodeInfoUpdate, batchSize int) (failed storj.NodeIDList, err error)
+	// RecomputeReputation recomputes the reputation of all qualified nodes with the parameters in config.
+	RecomputeReputation(ctx context.Context, config NodeSelectionConfig) (err error)
+	// WouldDisqualify returns the qualified nodes whose audit reputation is at or below auditDQ.
+	WouldDisqualify(ctx context.Context, auditDQ float64) (storj.NodeIDList, error)
+	// NodesWithFailureStreakAtLeast returns the qualified nodes that failed at least n audits in a row.
+	NodesWithFailureStreakAtLeast(ctx context.Context, n int64) (storj.NodeIDList, error)
+	// RecentlyDisqualified returns the nodes disqualified after since, ordered by the disqualification time.
+	RecentlyDisqualified(ctx context.Context, since time.Time) ([]*NodeDossier, error)
+	// PruneStaleNodes marks the nodes that haven't been contacted successfully since olderThan as offline.
+	PruneStaleNodes(ctx context.Context, olderThan time.Time) (count int64, err error)
 	// UpdateUptime updates a single storagenode's uptime stats.
 	UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool, lambda, weight, uptimeDQ float64) (stats *NodeStats, err error)
 }
@@ -78,7 +96,10 @@ type FindStorageNodesRequest struct {
 	FreeBandwidth        int64
 	FreeDisk             int64
 	ExcludedNodes        []storj.NodeID
-	MinimumVersion       string // semver or empty
+	PreferredNodes       []storj.NodeID // selected first when they meet the criteria
+	MinimumVersion       string         // semver or empty
+	// Reserve reserves FreeDisk on the selected nodes until it's released with ReleaseCapacity
+	Reserve bool
 }
 
 // NodeCriteria are the requirements for selecting nodes
@@ -89,9 +110,11 @@ type NodeCriteria struct {
 	UptimeCount    int64
 	ExcludedNodes  []storj.NodeID
 	ExcludedIPs    []string
+	PreferredNodes []storj.NodeID
 	MinimumVersion string // semver or empty
 	OnlineWindow   time.Duration
 	DistinctIP     bool
+	MinimumAge     time.Duration
 }
 
 // UpdateRequest is used to update a node status.
@@ -110,6 +133,12 @@ type UpdateRequest struct {
 	UptimeDQ     float64
 }
 
+// NodeInfoUpdate is the info requested from a single storagenode.
+type NodeInfoUpdate struct {
+	NodeID storj.NodeID
+	Info   *pb.InfoResponse
+}
+
 // NodeDossier is the complete info that the satellite tracks for a storage node
 type NodeDossier struct {
 	pb.Node
@@ -136,6 +165,17 @@ type NodeStats struct {
 	AuditReputationBeta   float64
 	UptimeReputationBeta  float64
 	Disqualified          *time.Time
+	AuditFailureStreak    int64
+}
+
+// AuditReputation returns the audit reputation score of the node, between 0 and 1.
+func (stats *NodeStats) AuditReputation() float64 {
+	return stats.AuditReputationAlpha / (stats.AuditReputationAlpha + stats.AuditReputationBeta)
+}
+
+// UptimeReputation returns the uptime reputation score of the node, between 0 and 1.
+func (stats *NodeStats) UptimeReputation() float64 {
+	return stats.UptimeReputationAlpha / (stats.UptimeReputationAlpha + stats.UptimeReputationBeta)
 }
 
 // Cache is used to store and handle node information
@@ -143,14 +183,25 @@ type Cache struct {
 	log    *zap.Logger
 	db     DB
 	config Config
+
+	mu           sync.Mutex
+	reservations map[storj.NodeID][]reservation
+}
+
+// reservation is the disk space reserved on a node by an in-flight upload
+type reservation struct {
+	bytes int64
+	// expires is when the reservation is dropped if it wasn't released
+	expires time.Time
 }
 
 // NewCache returns a new Cache
 func NewCache(log *zap.Logger, db DB, config Config) *Cache {
 	return &Cache{
-		log:    log,
-		db:     db,
-		config: config,
+		log:          log,
+		db:           db,
+		config:       config,
+		reservations: make(map[storj.NodeID][]reservation),
 	}
 }
 
@@ -201,6 +252,27 @@ func (cache *Cache) FindStorageNodes(ctx context.Context, req FindStorageNodesRe
 func (cache *Cache) FindStorageNodesWithPreferences(ctx context.Context, req FindStorageNodesRequest, preferences *NodeSelectionConfig) (nodes []*pb.Node, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	dossiers, err := cache.findStorageNodes(ctx, req, preferences)
+	for _, dossier := range dossiers {
+		nodes = append(nodes, &dossier.Node)
+	}
+	return nodes, err
+}
+
+// FindStorageNodesWithReputation searches the overlay network for nodes that meet the provided
+// requirements, like FindStorageNodes, and returns them along with their audit and uptime
+// reputation, so the caller can leave out nodes it considers marginal.
+func (cache *Cache) FindStorageNodesWithReputation(ctx context.Context, req FindStorageNodesRequest) (nodes []*NodeDossier, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return cache.findStorageNodes(ctx, req, &cache.config.Node)
+}
+
+// findStorageNodes selects the new and reputable nodes that meet the provided criteria.
+// Nodes whose free disk without the reserved capacity is less than req.FreeDisk are
+// left out, and with req.Reserve the capacity is reserved on the selected nodes.
+func (cache *Cache) findStorageNodes(ctx context.Context, req FindStorageNodesRequest, preferences *NodeSelectionConfig) (nodes []*NodeDossier, err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	// TODO: add sanity limits to requested node count
 	// TODO: add sanity limits to excluded nodes
 	reputableNodeCount := req.MinimumRequiredNodes
@@ -208,31 +280,70 @@ func (cache *Cache) FindStorageNodesWithPreferences(ctx context.Context, req Fin
 		reputableNodeCount = req.RequestedCount
 	}
 
-	excludedNodes := req.ExcludedNodes
+	excludedNodes := append([]storj.NodeID{}, req.ExcludedNodes...)
+	var criteria *NodeCriteria
+	for len(nodes) < reputableNodeCount {
+		var selected []*NodeDossier
+		selected, criteria, err = cache.selectStorageNodes(ctx, req, preferences, reputableNodeCount-len(nodes), excludedNodes)
+		if err != nil {
+			cache.releaseSelected(req, nodes)
+			return nil, err
+		}
+
+		// nodes which are too full once the reservations are accounted for are
+		// replaced by selecting again without them
+		full := 0
+		for _, node := range selected {
+			excludedNodes = append(excludedNodes, node.Id)
+			if !cache.hasCapacity(node, req.FreeDisk, req.Reserve) {
+				full++
+				continue
+			}
+			nodes = append(nodes, node)
+		}
+		if full == 0 {
+			break
+		}
+	}
+
+	if len(nodes) < reputableNodeCount {
+		cache.releaseSelected(req, nodes)
+		return nodes, ErrNotEnoughNodes.New("requested %d found %d; %+v ", reputableNodeCount, len(nodes), criteria)
+	}
+
+	return nodes, nil
+}
+
+// selectStorageNodes selects count new and reputable nodes from the database
+func (cache *Cache) selectStorageNodes(ctx context.Context, req FindStorageNodesRequest, preferences *NodeSelectionConfig, count int, excludedNodes []storj.NodeID) (nodes []*NodeDossier, _ *NodeCriteria, err error) {
+	defer mon.Task()(&ctx)(&err)
 
 	newNodeCount := 0
 	if preferences.NewNodePercentage > 0 {
-		newNodeCount = int(float64(reputableNodeCount) * preferences.NewNodePercentage)
+		newNodeCount = int(float64(count) * preferences.NewNodePercentage)
 	}
 
-	var newNodes []*pb.Node
+	var newNodes []*NodeDossier
 	if newNodeCount > 0 {
 		newNodes, err = cache.db.SelectNewStorageNodes(ctx, newNodeCount, &NodeCriteria{
 			FreeBandwidth:  req.FreeBandwidth,
 			FreeDisk:       req.FreeDisk,
 			AuditCount:     preferences.AuditCount,
 			ExcludedNodes:  excludedNodes,
+			PreferredNodes: req.PreferredNodes,
 			MinimumVersion: preferences.MinimumVersion,
 			OnlineWindow:   preferences.OnlineWindow,
 			DistinctIP:     preferences.DistinctIP,
+			MinimumAge:     preferences.MinimumAge,
 		})
 		if err != nil {
-			return nil, OverlayError.Wrap(err)
+			return nil, nil, OverlayError.Wrap(err)
 		}
 	}
 
 	var excludedIPs []string
 	// add selected new nodes and their IPs to the excluded lists for reputable node selection
+	excludedNodes = append([]storj.NodeID{}, excludedNodes...)
 	for _, newNode := range newNodes {
 		excludedNodes = append(excludedNodes, newNode.Id)
 		if preferences.DistinctIP {
@@ -247,23 +358,124 @@ func (cache *Cache) FindStorageNodesWithPreferences(ctx context.Context, req Fin
 		UptimeCount:    preferences.UptimeCount,
 		ExcludedNodes:  excludedNodes,
 		ExcludedIPs:    excludedIPs,
+		PreferredNodes: req.PreferredNodes,
 		MinimumVersion: preferences.MinimumVersion,
 		OnlineWindow:   preferences.OnlineWindow,
 		DistinctIP:     preferences.DistinctIP,
+		MinimumAge:     preferences.MinimumAge,
 	}
-	reputableNodes, err := cache.db.SelectStorageNodes(ctx, reputableNodeCount-len(newNodes), &criteria)
+	reputableNodes, err := cache.db.SelectStorageNodes(ctx, count-len(newNodes), &criteria)
 	if err != nil {
-		return nil, OverlayError.Wrap(err)
+		return nil, nil, OverlayError.Wrap(err)
 	}
 
 	nodes = append(nodes, newNodes...)
 	nodes = append(nodes, reputableNodes...)
+	return nodes, &criteria, nil
+}
 
-	if len(nodes) < reputableNodeCount {
-		return nodes, ErrNotEnoughNodes.New("requested %d found %d; %+v ", reputableNodeCount, len(nodes), criteria)
+// hasCapacity returns whether the current free disk of node without the capacity
+// reserved on it is at least freeDisk. With reserve the capacity is reserved as well.
+func (cache *Cache) hasCapacity(node *NodeDossier, freeDisk int64, reserve bool) bool {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	now := time.Now()
+	if node.Capacity.FreeDisk-cache.reserved(node.Id, now) < freeDisk {
+		return false
+	}
+	if reserve {
+		cache.reserve(node.Id, freeDisk, now)
+	}
+	return true
+}
+
+// releaseSelected releases the capacity reserved on nodes when they were selected for req
+func (cache *Cache) releaseSelected(req FindStorageNodesRequest, nodes []*NodeDossier) {
+	if !req.Reserve {
+		return
 	}
 
-	return nodes, nil
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	for _, node := range nodes {
+		cache.release(node.Id, req.FreeDisk)
+	}
+}
+
+// ReserveCapacity reserves bytes of the current free disk of a node for an upload,
+// until they are released with ReleaseCapacity or the reservation expires after
+// Config.ReservationTTL. Node selection accounts for the reservations. It fails with
+// ErrNotEnoughCapacity when the free disk which isn't reserved yet is less than bytes.
+func (cache *Cache) ReserveCapacity(ctx context.Context, nodeID storj.NodeID, bytes int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if nodeID.IsZero() {
+		return ErrEmptyNode
+	}
+
+	node, err := cache.db.Get(ctx, nodeID)
+	if err != nil {
+		return err
+	}
+
+	if !cache.hasCapacity(node, bytes, true) {
+		return ErrNotEnoughCapacity.New("node %s has %d bytes free, requested %d", nodeID, node.Capacity.FreeDisk, bytes)
+	}
+	return nil
+}
+
+// ReleaseCapacity releases bytes previously reserved on a node with ReserveCapacity
+// or when it was selected with FindStorageNodesRequest.Reserve.
+func (cache *Cache) ReleaseCapacity(ctx context.Context, nodeID storj.NodeID, bytes int64) {
+	defer mon.Task()(&ctx)(nil)
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	cache.release(nodeID, bytes)
+}
+
+// reserved returns the capacity reserved on a node at now, dropping the expired
+// reservations. It must be called with the lock held.
+func (cache *Cache) reserved(nodeID storj.NodeID, now time.Time) (bytes int64) {
+	reservations := cache.reservations[nodeID][:0]
+	for _, r := range cache.reservations[nodeID] {
+		if now.Before(r.expires) {
+			reservations = append(reservations, r)
+			bytes += r.bytes
+		}
+	}
+	if len(reservations) == 0 {
+		delete(cache.reservations, nodeID)
+	} else {
+		cache.reservations[nodeID] = reservations
+	}
+	return bytes
+}
+
+// reserve reserves bytes on a node at now, it must be called with the lock held.
+func (cache *Cache) reserve(nodeID storj.NodeID, bytes int64, now time.Time) {
+	cache.reservations[nodeID] = append(cache.reservations[nodeID], reservation{
+		bytes:   bytes,
+		expires: now.Add(cache.config.ReservationTTL),
+	})
+}
+
+// release releases a reservation of bytes on a node, it must be called with the lock held.
+func (cache *Cache) release(nodeID storj.NodeID, bytes int64) {
+	reservations := cache.reservations[nodeID]
+	for i, r := range reservations {
+		if r.bytes == bytes {
+			reservations = append(reservations[:i], reservations[i+1:]...)
+			break
+		}
+	}
+	if len(reservations) == 0 {
+		delete(cache.reservations, nodeID)
+	} else {
+		cache.reservations[nodeID] = reservations
+	}
 }
 
 // KnownOffline filters a set of nodes to offline nodes
@@ -293,6 +505,16 @@ func (cache *Cache) Reliable(ctx context.Context) (nodes storj.NodeIDList, err e
 	return cache.db.Reliable(ctx, criteria)
 }
 
+// CountByVersion returns the number of online nodes running each version,
+// keyed by the version string, for tracking the adoption of a release.
+func (cache *Cache) CountByVersion(ctx context.Context) (counts map[string]int, err error) {
+	defer mon.Task()(&ctx)(&err)
+	criteria := &NodeCriteria{
+		OnlineWindow: cache.config.Node.OnlineWindow,
+	}
+	return cache.db.CountByVersion(ctx, criteria)
+}
+
 // Put adds a node id and proto definition into the overlay cache
 func (cache *Cache) Put(ctx context.Context, nodeID storj.NodeID, value pb.Node) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -322,6 +544,7 @@ func (cache *Cache) IsVetted(ctx context.Context, nodeID storj.NodeID) (reputabl
 	criteria := &NodeCriteria{
 		AuditCount:  cache.config.Node.AuditCount,
 		UptimeCount: cache.config.Node.UptimeCount,
+		MinimumAge:  cache.config.Node.MinimumAge,
 	}
 	reputable, err = cache.db.IsVetted(ctx, nodeID, criteria)
 	if err != nil {
@@ -365,6 +588,54 @@ func (cache *Cache) UpdateNodeInfo(ctx context.Context, node storj.NodeID, nodeI
 	return cache.db.UpdateNodeInfo(ctx, node, nodeInfo)
 }
 
+// BatchUpdateNodeInfo updates the node info of multiple storagenodes in chunked transactions.
+func (cache *Cache) BatchUpdateNodeInfo(ctx context.Context, updates []*NodeInfoUpdate) (failed storj.NodeIDList, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return cache.db.BatchUpdateNodeInfo(ctx, updates, cache.config.UpdateStatsBatchSize)
+}
+
+// RecomputeReputation recomputes the audit and uptime reputation of all
+// qualified nodes with the reputation parameters in config. It is meant for
+// migrating the stored reputations after the parameters have been changed.
+// Nodes are not disqualified by the recomputation.
+func (cache *Cache) RecomputeReputation(ctx context.Context, config NodeSelectionConfig) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return cache.db.RecomputeReputation(ctx, config)
+}
+
+// WouldDisqualify returns how many and which currently qualified nodes would be
+// disqualified if the audit reputation cut-off was hypotheticalDQ. Nothing is modified.
+func (cache *Cache) WouldDisqualify(ctx context.Context, hypotheticalDQ float64) (count int, ids storj.NodeIDList, err error) {
+	defer mon.Task()(&ctx)(&err)
+	ids, err = cache.db.WouldDisqualify(ctx, hypotheticalDQ)
+	if err != nil {
+		return 0, nil, err
+	}
+	return len(ids), ids, nil
+}
+
+// NodesWithFailureStreakAtLeast returns the nodes that aren't disqualified yet
+// but failed at least n audits in a row, longest streaks first.
+func (cache *Cache) NodesWithFailureStreakAtLeast(ctx context.Context, n int64) (_ storj.NodeIDList, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return cache.db.NodesWithFailureStreakAtLeast(ctx, n)
+}
+
+// RecentlyDisqualified returns the nodes that have been disqualified after
+// since, ordered by the time they were disqualified.
+func (cache *Cache) RecentlyDisqualified(ctx context.Context, since time.Time) (_ []*NodeDossier, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return cache.db.RecentlyDisqualified(ctx, since)
+}
+
+// PruneStaleNodes marks the nodes that haven't been seen since olderThan as
+// offline, so that they aren't selected anymore until they check in again.
+// The nodes are kept in the overlay. It returns the number of nodes marked.
+func (cache *Cache) PruneStaleNodes(ctx context.Context, olderThan time.Time) (count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return cache.db.PruneStaleNodes(ctx, olderThan)
+}
+
 // UpdateUptime updates a single storagenode's uptime stats.
 func (cache *Cache) UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool) (stats *NodeStats, err error) {
 	defer mon.Task()(&ctx)(&err)
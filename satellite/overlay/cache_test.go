@@ -5,6 +5,8 @@ package overlay_test
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -12,7 +14,9 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest"
+	"golang.org/x/sync/errgroup"
 
+	"storj.io/storj/internal/memory"
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/testplanet"
 	"storj.io/storj/internal/testrand"
@@ -244,7 +248,7 @@ func TestRandomizedSelection(t *testing.T) {
 
 		// select numNodesToSelect nodes selectIterations times
 		for i := 0; i < selectIterations; i++ {
-			var nodes []*pb.Node
+			var nodes []*overlay.NodeDossier
 			var err error
 
 			if i%2 == 0 {
@@ -384,6 +388,50 @@ func TestIsVetted(t *testing.T) {
 	})
 }
 
+func TestIsVettedMinimumAge(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Overlay.Node.AuditCount = 1
+				config.Overlay.Node.UptimeCount = 1
+				config.Overlay.Node.MinimumAge = time.Hour
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellitePeer := planet.Satellites[0]
+		satellitePeer.Audit.Service.Loop.Pause()
+		satellitePeer.Repair.Checker.Loop.Pause()
+		service := satellitePeer.Overlay.Service
+		nodeID := planet.StorageNodes[0].ID()
+
+		_, err := satellitePeer.DB.OverlayCache().UpdateStats(ctx, &overlay.UpdateRequest{
+			NodeID:       nodeID,
+			IsUp:         true,
+			AuditSuccess: true,
+			AuditLambda:  1,
+			AuditWeight:  1,
+			AuditDQ:      0.5,
+			UptimeLambda: 1,
+			UptimeWeight: 1,
+			UptimeDQ:     0.5,
+		})
+		require.NoError(t, err)
+
+		// the node meets the audit and uptime counts, but is too young
+		reputable, err := service.IsVetted(ctx, nodeID)
+		require.NoError(t, err)
+		require.False(t, reputable)
+
+		reputable, err = satellitePeer.DB.OverlayCache().IsVetted(ctx, nodeID, &overlay.NodeCriteria{
+			AuditCount:  1,
+			UptimeCount: 1,
+		})
+		require.NoError(t, err)
+		require.True(t, reputable)
+	})
+}
+
 func TestNodeInfo(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
@@ -405,3 +453,316 @@ func TestNodeInfo(t *testing.T) {
 		assert.Equal(t, planet.StorageNodes[0].Local().Version.Version, node.Version.Version)
 	})
 }
+
+func TestBatchUpdateNodeInfo(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		cache := db.OverlayCache()
+
+		var updates []*overlay.NodeInfoUpdate
+		for i := 0; i < 5; i++ {
+			nodeID := testrand.NodeID()
+			err := cache.UpdateAddress(ctx, &pb.Node{Id: nodeID}, testNodeSelectionConfig(0, 0, false))
+			require.NoError(t, err)
+
+			updates = append(updates, &overlay.NodeInfoUpdate{
+				NodeID: nodeID,
+				Info: &pb.InfoResponse{
+					Type: pb.NodeType_STORAGE,
+					Capacity: &pb.NodeCapacity{
+						FreeBandwidth: int64(1000 + i),
+						FreeDisk:      int64(2000 + i),
+					},
+					Version: &pb.NodeVersion{
+						Version:    fmt.Sprintf("v0.%d.0", i),
+						CommitHash: "abc",
+						Release:    true,
+					},
+				},
+			})
+		}
+
+		failed, err := cache.BatchUpdateNodeInfo(ctx, updates, 2)
+		require.NoError(t, err)
+		require.Empty(t, failed)
+
+		for i, update := range updates {
+			node, err := cache.Get(ctx, update.NodeID)
+			require.NoError(t, err)
+
+			assert.Equal(t, pb.NodeType_STORAGE, node.Type)
+			assert.Equal(t, int64(1000+i), node.Capacity.FreeBandwidth)
+			assert.Equal(t, int64(2000+i), node.Capacity.FreeDisk)
+			assert.Equal(t, fmt.Sprintf("v0.%d.0", i), node.Version.Version)
+		}
+	})
+}
+
+func TestCountByVersion(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		cache := db.OverlayCache()
+
+		addNode := func(ver string) storj.NodeID {
+			nodeID := testrand.NodeID()
+			err := cache.UpdateAddress(ctx, &pb.Node{Id: nodeID}, testNodeSelectionConfig(0, 0, false))
+			require.NoError(t, err)
+
+			_, err = cache.UpdateNodeInfo(ctx, nodeID, &pb.InfoResponse{
+				Type:    pb.NodeType_STORAGE,
+				Version: &pb.NodeVersion{Version: ver},
+			})
+			require.NoError(t, err)
+			return nodeID
+		}
+
+		for i := 0; i < 3; i++ {
+			addNode("v0.15.0")
+		}
+		for i := 0; i < 2; i++ {
+			addNode("v0.16.1")
+		}
+
+		// offline nodes aren't counted
+		offline := addNode("v0.16.1")
+		_, err := cache.UpdateUptime(ctx, offline, false, 1, 1, 0)
+		require.NoError(t, err)
+
+		counts, err := cache.CountByVersion(ctx, &overlay.NodeCriteria{OnlineWindow: 0})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int{
+			"v0.15.0": 3,
+			"v0.16.1": 2,
+		}, counts)
+	})
+}
+
+func TestPruneStaleNodes(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		nodeSelectionConfig := testNodeSelectionConfig(0, 0, false)
+		nodeSelectionConfig.OnlineWindow = 0
+		cache := overlay.NewCache(zaptest.NewLogger(t), db.OverlayCache(), overlay.Config{Node: nodeSelectionConfig})
+
+		addNode := func() storj.NodeID {
+			nodeID := testrand.NodeID()
+			err := cache.Put(ctx, nodeID, pb.Node{Id: nodeID, Address: &pb.NodeAddress{Address: "127.0.0.1:0"}})
+			require.NoError(t, err)
+			return nodeID
+		}
+
+		stale := []storj.NodeID{addNode(), addNode()}
+
+		// nodes that are already offline aren't marked again
+		offline := addNode()
+		_, err := cache.UpdateUptime(ctx, offline, false)
+		require.NoError(t, err)
+
+		time.Sleep(10 * time.Millisecond)
+		cutoff := time.Now()
+		time.Sleep(10 * time.Millisecond)
+
+		fresh := []storj.NodeID{addNode(), addNode()}
+
+		count, err := cache.PruneStaleNodes(ctx, cutoff)
+		require.NoError(t, err)
+		assert.EqualValues(t, len(stale), count)
+
+		for _, nodeID := range stale {
+			node, err := cache.Get(ctx, nodeID)
+			require.NoError(t, err)
+			assert.False(t, cache.IsOnline(node))
+		}
+		for _, nodeID := range fresh {
+			node, err := cache.Get(ctx, nodeID)
+			require.NoError(t, err)
+			assert.True(t, cache.IsOnline(node))
+		}
+
+		// pruned nodes are still in the overlay
+		_, err = cache.Get(ctx, offline)
+		require.NoError(t, err)
+	})
+}
+
+func TestReserveCapacity(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		const pieceSize = 1000
+
+		cache := overlay.NewCache(zaptest.NewLogger(t), db.OverlayCache(), overlay.Config{
+			Node:           testNodeSelectionConfig(0, 0, false),
+			ReservationTTL: time.Hour,
+		})
+
+		setFreeDisk := func(nodeID storj.NodeID, freeDisk int64) {
+			_, err := cache.UpdateNodeInfo(ctx, nodeID, &pb.InfoResponse{
+				Type:     pb.NodeType_STORAGE,
+				Capacity: &pb.NodeCapacity{FreeDisk: freeDisk},
+			})
+			require.NoError(t, err)
+		}
+
+		addNode := func(freeDisk int64) storj.NodeID {
+			nodeID := testrand.NodeID()
+			err := cache.Put(ctx, nodeID, pb.Node{Id: nodeID, Address: &pb.NodeAddress{Address: "127.0.0.1:0"}})
+			require.NoError(t, err)
+			setFreeDisk(nodeID, freeDisk)
+			_, err = cache.UpdateUptime(ctx, nodeID, true)
+			require.NoError(t, err)
+			return nodeID
+		}
+
+		// there is only room for a single piece on the near full node
+		nearFull := addNode(pieceSize * 3 / 2)
+		roomy := addNode(100 * pieceSize)
+
+		selectNearFull := overlay.FindStorageNodesRequest{
+			RequestedCount: 1,
+			FreeDisk:       pieceSize,
+			ExcludedNodes:  []storj.NodeID{roomy},
+			Reserve:        true,
+		}
+
+		var mu sync.Mutex
+		selected := 0
+
+		// concurrent uploads selecting the node reserve its capacity only once
+		var group errgroup.Group
+		for i := 0; i < 10; i++ {
+			group.Go(func() error {
+				nodes, err := cache.FindStorageNodes(ctx, selectNearFull)
+				if overlay.ErrNotEnoughNodes.Has(err) {
+					assert.Empty(t, nodes)
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+
+				mu.Lock()
+				defer mu.Unlock()
+				selected++
+				return nil
+			})
+		}
+		require.NoError(t, group.Wait())
+		assert.Equal(t, 1, selected)
+
+		// the reserved node isn't selected anymore
+		err := cache.ReserveCapacity(ctx, nearFull, pieceSize)
+		assert.True(t, overlay.ErrNotEnoughCapacity.Has(err), err)
+
+		nodes, err := cache.FindStorageNodes(ctx, overlay.FindStorageNodesRequest{RequestedCount: 2, FreeDisk: pieceSize})
+		assert.True(t, overlay.ErrNotEnoughNodes.Has(err), err)
+		require.Len(t, nodes, 1)
+		assert.Equal(t, roomy, nodes[0].Id)
+
+		// smaller uploads still fit in the remaining space
+		nodes, err = cache.FindStorageNodes(ctx, overlay.FindStorageNodesRequest{RequestedCount: 2, FreeDisk: pieceSize / 2})
+		require.NoError(t, err)
+		assert.Len(t, nodes, 2)
+
+		// the check is based on the current free disk of the node
+		setFreeDisk(nearFull, 3*pieceSize)
+		require.NoError(t, cache.ReserveCapacity(ctx, nearFull, pieceSize))
+		setFreeDisk(nearFull, 2*pieceSize)
+		_, err = cache.FindStorageNodes(ctx, selectNearFull)
+		assert.True(t, overlay.ErrNotEnoughNodes.Has(err), err)
+
+		// releasing a reservation makes room again
+		cache.ReleaseCapacity(ctx, nearFull, pieceSize)
+		nodes, err = cache.FindStorageNodes(ctx, selectNearFull)
+		require.NoError(t, err)
+		require.Len(t, nodes, 1)
+		assert.Equal(t, nearFull, nodes[0].Id)
+
+		// reservations which are never released expire
+		expiring := overlay.NewCache(zaptest.NewLogger(t), db.OverlayCache(), overlay.Config{
+			Node:           testNodeSelectionConfig(0, 0, false),
+			ReservationTTL: time.Millisecond,
+		})
+		require.NoError(t, expiring.ReserveCapacity(ctx, nearFull, 2*pieceSize))
+		err = expiring.ReserveCapacity(ctx, nearFull, pieceSize)
+		assert.True(t, overlay.ErrNotEnoughCapacity.Has(err), err)
+
+		time.Sleep(10 * time.Millisecond)
+		require.NoError(t, expiring.ReserveCapacity(ctx, nearFull, pieceSize))
+	})
+}
+
+func TestFindStorageNodesWithReputation(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		config := testNodeSelectionConfig(0, 0, false)
+		// a failed audit leaves the node marginal rather than disqualified
+		config.AuditReputationDQ = 0.1
+
+		cache := overlay.NewCache(zaptest.NewLogger(t), db.OverlayCache(), overlay.Config{Node: config})
+
+		addNode := func(auditSuccess bool) storj.NodeID {
+			nodeID := testrand.NodeID()
+			err := cache.Put(ctx, nodeID, pb.Node{Id: nodeID, Address: &pb.NodeAddress{Address: "127.0.0.1:0"}})
+			require.NoError(t, err)
+			_, err = cache.UpdateNodeInfo(ctx, nodeID, &pb.InfoResponse{
+				Type:     pb.NodeType_STORAGE,
+				Capacity: &pb.NodeCapacity{FreeDisk: memory.GiB.Int64(), FreeBandwidth: memory.GiB.Int64()},
+			})
+			require.NoError(t, err)
+			_, err = cache.UpdateStats(ctx, &overlay.UpdateRequest{
+				NodeID:       nodeID,
+				IsUp:         true,
+				AuditSuccess: auditSuccess,
+			})
+			require.NoError(t, err)
+			return nodeID
+		}
+
+		reliable := map[storj.NodeID]bool{}
+		for i := 0; i < 3; i++ {
+			reliable[addNode(true)] = true
+		}
+		marginal := map[storj.NodeID]bool{}
+		for i := 0; i < 2; i++ {
+			marginal[addNode(false)] = true
+		}
+
+		nodes, err := cache.FindStorageNodesWithReputation(ctx, overlay.FindStorageNodesRequest{RequestedCount: 5})
+		require.NoError(t, err)
+		require.Len(t, nodes, 5)
+
+		// the returned reputation is the one stored for the node
+		for _, node := range nodes {
+			stored, err := cache.Get(ctx, node.Id)
+			require.NoError(t, err)
+			assert.Equal(t, stored.Reputation.AuditReputationAlpha, node.Reputation.AuditReputationAlpha)
+			assert.Equal(t, stored.Reputation.AuditReputationBeta, node.Reputation.AuditReputationBeta)
+			assert.Equal(t, stored.Reputation.UptimeReputationAlpha, node.Reputation.UptimeReputationAlpha)
+			assert.Equal(t, stored.Reputation.UptimeReputationBeta, node.Reputation.UptimeReputationBeta)
+		}
+
+		// the marginal nodes can be left out by their reputation
+		var filtered []storj.NodeID
+		for _, node := range nodes {
+			if node.Reputation.AuditReputation() < 0.75 {
+				assert.True(t, marginal[node.Id])
+				continue
+			}
+			filtered = append(filtered, node.Id)
+		}
+		require.Len(t, filtered, len(reliable))
+		for _, id := range filtered {
+			assert.True(t, reliable[id])
+		}
+	})
+}
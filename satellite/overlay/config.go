@@ -20,7 +20,8 @@ var (
 // Overlay cache responsibility.
 type Config struct {
 	Node                 NodeSelectionConfig
-	UpdateStatsBatchSize int `help:"number of update requests to process per transaction" default:"100"`
+	UpdateStatsBatchSize int           `help:"number of update requests to process per transaction" default:"100"`
+	ReservationTTL       time.Duration `help:"how long capacity reserved on a node for an upload is kept when the upload is neither committed nor aborted" default:"1h"`
 }
 
 // NodeSelectionConfig is a configuration struct to determine the minimum
@@ -32,6 +33,7 @@ type NodeSelectionConfig struct {
 	MinimumVersion    string        `help:"the minimum node software version for node selection queries" default:""`
 	OnlineWindow      time.Duration `help:"the amount of time without seeing a node before its considered offline" default:"1h"`
 	DistinctIP        bool          `help:"require distinct IPs when choosing nodes for upload" releaseDefault:"true" devDefault:"false"`
+	MinimumAge        time.Duration `help:"the minimum time since a node was first seen before it is no longer considered a New Node" default:"0s"`
 
 	AuditReputationRepairWeight  float64 `help:"weight to apply to audit reputation for total repair reputation calculation" default:"1.0"`
 	AuditReputationUplinkWeight  float64 `help:"weight to apply to audit reputation for total uplink reputation calculation" default:"1.0"`
@@ -196,6 +196,48 @@ func testNodeSelection(t *testing.T, ctx *testcontext.Context, planet *testplane
 	}
 }
 
+func TestNodeSelectionPreferredNodes(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 10, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		service := planet.Satellites[0].Overlay.Service
+		for _, storageNode := range planet.StorageNodes {
+			err := service.Put(ctx, storageNode.ID(), storageNode.Local().Node)
+			require.NoError(t, err)
+		}
+
+		preferred := []storj.NodeID{
+			planet.StorageNodes[7].ID(),
+			planet.StorageNodes[8].ID(),
+			planet.StorageNodes[9].ID(),
+			{1, 2, 3, 4}, // unknown nodes are never selected
+		}
+		// excluded nodes are not eligible, even when preferred
+		excluded := []storj.NodeID{planet.StorageNodes[9].ID()}
+
+		for _, preferences := range []overlay.NodeSelectionConfig{
+			testNodeSelectionConfig(0, 0, false),
+			testNodeSelectionConfig(0, 0.5, false),
+		} {
+			response, err := service.FindStorageNodesWithPreferences(ctx, overlay.FindStorageNodesRequest{
+				RequestedCount: 4,
+				ExcludedNodes:  excluded,
+				PreferredNodes: preferred,
+			}, &preferences)
+			require.NoError(t, err)
+			require.Len(t, response, 4)
+
+			selected := map[storj.NodeID]bool{}
+			for _, node := range response {
+				selected[node.Id] = true
+			}
+			assert.True(t, selected[planet.StorageNodes[7].ID()])
+			assert.True(t, selected[planet.StorageNodes[8].ID()])
+			assert.False(t, selected[planet.StorageNodes[9].ID()])
+		}
+	})
+}
+
 func TestDistinctIPs(t *testing.T) {
 	if runtime.GOOS == "darwin" {
 		t.Skip("Test does not work with macOS")
@@ -209,3 +209,250 @@ func testDatabase(ctx context.Context, t *testing.T, cache overlay.DB) {
 		require.EqualValues(t, stats.UptimeReputationBeta, expectedBeta)
 	}
 }
+
+func TestRecomputeReputation(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		cache := db.OverlayCache()
+
+		startingRep := overlay.NodeSelectionConfig{
+			AuditReputationAlpha0:  1,
+			UptimeReputationAlpha0: 2,
+		}
+
+		reliable, unreliable, disqualified := storj.NodeID{1}, storj.NodeID{2}, storj.NodeID{3}
+		for _, tt := range []struct {
+			nodeID        storj.NodeID
+			auditOutcomes []bool
+			dq            float64
+		}{
+			{reliable, []bool{true, true, true}, 0},
+			{unreliable, []bool{true, false, true, false}, 0},
+			{disqualified, []bool{false}, 1},
+		} {
+			err := cache.UpdateAddress(ctx, &pb.Node{Id: tt.nodeID}, startingRep)
+			require.NoError(t, err)
+
+			for _, success := range tt.auditOutcomes {
+				_, err = cache.UpdateStats(ctx, &overlay.UpdateRequest{
+					NodeID:       tt.nodeID,
+					AuditSuccess: success,
+					IsUp:         true,
+					AuditLambda:  0.9, AuditWeight: 1, AuditDQ: tt.dq,
+					UptimeLambda: 0.9, UptimeWeight: 1,
+				})
+				require.NoError(t, err)
+			}
+		}
+
+		disqualifiedBefore, err := cache.Get(ctx, disqualified)
+		require.NoError(t, err)
+		require.NotNil(t, disqualifiedBefore.Disqualified)
+
+		reputation := func(nodeID storj.NodeID) overlay.NodeStats {
+			node, err := cache.Get(ctx, nodeID)
+			require.NoError(t, err)
+			return node.Reputation
+		}
+
+		{ // without forgetting, the order of the outcomes does not matter
+			config := startingRep
+			config.AuditReputationLambda, config.AuditReputationWeight = 1, 1
+			config.UptimeReputationLambda, config.UptimeReputationWeight = 1, 1
+			require.NoError(t, cache.RecomputeReputation(ctx, config))
+
+			rep := reputation(reliable)
+			assert.Equal(t, 4.0, rep.AuditReputationAlpha)
+			assert.Equal(t, 0.0, rep.AuditReputationBeta)
+			assert.Equal(t, 5.0, rep.UptimeReputationAlpha)
+			assert.Equal(t, 0.0, rep.UptimeReputationBeta)
+
+			rep = reputation(unreliable)
+			assert.Equal(t, 3.0, rep.AuditReputationAlpha)
+			assert.Equal(t, 2.0, rep.AuditReputationBeta)
+			assert.Equal(t, 6.0, rep.UptimeReputationAlpha)
+			assert.Equal(t, 0.0, rep.UptimeReputationBeta)
+		}
+
+		{ // recomputing with new parameters replaces the previous values
+			config := startingRep
+			config.AuditReputationLambda, config.AuditReputationWeight = 0.5, 2
+			config.UptimeReputationLambda, config.UptimeReputationWeight = 0.5, 2
+			require.NoError(t, cache.RecomputeReputation(ctx, config))
+			require.NoError(t, cache.RecomputeReputation(ctx, config))
+
+			// 0.5^3 * 1 + 2 * (1 - 0.5^3) / (1 - 0.5)
+			rep := reputation(reliable)
+			assert.InDelta(t, 3.625, rep.AuditReputationAlpha, 1e-9)
+			assert.InDelta(t, 0.0, rep.AuditReputationBeta, 1e-9)
+
+			// half of 2 * (1 - 0.5^4) / (1 - 0.5) each, plus 0.5^4 * 1 for alpha
+			rep = reputation(unreliable)
+			assert.InDelta(t, 0.0625+1.875, rep.AuditReputationAlpha, 1e-9)
+			assert.InDelta(t, 1.875, rep.AuditReputationBeta, 1e-9)
+		}
+
+		// disqualified nodes are left untouched
+		assert.Equal(t, disqualifiedBefore.Reputation, reputation(disqualified))
+	})
+}
+
+func TestWouldDisqualify(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		cache := db.OverlayCache()
+
+		for _, tt := range []struct {
+			nodeID     storj.NodeID
+			auditAlpha float64
+			auditBeta  float64
+		}{
+			{storj.NodeID{1}, 9, 1}, // reputation 0.9
+			{storj.NodeID{2}, 7, 3}, // reputation 0.7
+			{storj.NodeID{3}, 5, 5}, // reputation 0.5
+			{storj.NodeID{4}, 2, 8}, // reputation 0.2
+		} {
+			err := cache.UpdateAddress(ctx, &pb.Node{Id: tt.nodeID}, overlay.NodeSelectionConfig{
+				AuditReputationAlpha0:  tt.auditAlpha,
+				AuditReputationBeta0:   tt.auditBeta,
+				UptimeReputationAlpha0: 1,
+			})
+			require.NoError(t, err)
+		}
+
+		// already disqualified nodes are not reported again
+		err := cache.UpdateAddress(ctx, &pb.Node{Id: storj.NodeID{5}}, overlay.NodeSelectionConfig{
+			AuditReputationAlpha0:  1,
+			AuditReputationBeta0:   9,
+			UptimeReputationAlpha0: 1,
+		})
+		require.NoError(t, err)
+		_, err = cache.UpdateStats(ctx, &overlay.UpdateRequest{
+			NodeID:      storj.NodeID{5},
+			IsUp:        true,
+			AuditLambda: 1, AuditWeight: 1, AuditDQ: 0.5,
+			UptimeLambda: 1, UptimeWeight: 1,
+		})
+		require.NoError(t, err)
+
+		ids, err := cache.WouldDisqualify(ctx, 0.6)
+		require.NoError(t, err)
+		assert.Equal(t, storj.NodeIDList{{3}, {4}}, ids)
+
+		ids, err = cache.WouldDisqualify(ctx, 0.1)
+		require.NoError(t, err)
+		assert.Empty(t, ids)
+
+		// the dry run does not disqualify anything
+		for _, nodeID := range []storj.NodeID{{1}, {2}, {3}, {4}} {
+			node, err := cache.Get(ctx, nodeID)
+			require.NoError(t, err)
+			assert.Nil(t, node.Disqualified)
+		}
+	})
+}
+
+func TestRecentlyDisqualified(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		cache := db.OverlayCache()
+
+		disqualify := func(nodeID storj.NodeID) {
+			_, err := cache.UpdateStats(ctx, &overlay.UpdateRequest{
+				NodeID:      nodeID,
+				IsUp:        true,
+				AuditLambda: 1, AuditWeight: 1, AuditDQ: 0.5,
+				UptimeLambda: 1, UptimeWeight: 1,
+			})
+			require.NoError(t, err)
+		}
+
+		for _, nodeID := range []storj.NodeID{{1}, {2}, {3}, {4}} {
+			err := cache.UpdateAddress(ctx, &pb.Node{Id: nodeID}, overlay.NodeSelectionConfig{
+				AuditReputationAlpha0:  1,
+				AuditReputationBeta0:   9,
+				UptimeReputationAlpha0: 1,
+			})
+			require.NoError(t, err)
+		}
+
+		disqualify(storj.NodeID{1})
+		time.Sleep(10 * time.Millisecond)
+		since := time.Now()
+		time.Sleep(10 * time.Millisecond)
+		disqualify(storj.NodeID{3})
+		time.Sleep(10 * time.Millisecond)
+		disqualify(storj.NodeID{2})
+
+		nodes, err := cache.RecentlyDisqualified(ctx, since)
+		require.NoError(t, err)
+		require.Len(t, nodes, 2)
+		assert.Equal(t, storj.NodeID{3}, nodes[0].Id)
+		assert.Equal(t, storj.NodeID{2}, nodes[1].Id)
+		for _, node := range nodes {
+			require.NotNil(t, node.Disqualified)
+			assert.True(t, node.Disqualified.After(since))
+		}
+
+		nodes, err = cache.RecentlyDisqualified(ctx, time.Now())
+		require.NoError(t, err)
+		assert.Empty(t, nodes)
+	})
+}
+
+func TestAuditFailureStreak(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		cache := db.OverlayCache()
+
+		audit := func(nodeID storj.NodeID, success bool) *overlay.NodeStats {
+			stats, err := cache.UpdateStats(ctx, &overlay.UpdateRequest{
+				NodeID:       nodeID,
+				AuditSuccess: success,
+				IsUp:         true,
+				AuditLambda:  1, AuditWeight: 1,
+				UptimeLambda: 1, UptimeWeight: 1,
+			})
+			require.NoError(t, err)
+			return stats
+		}
+
+		alternating, consecutive, recovered := storj.NodeID{1}, storj.NodeID{2}, storj.NodeID{3}
+		for _, nodeID := range []storj.NodeID{alternating, consecutive, recovered} {
+			err := cache.UpdateAddress(ctx, &pb.Node{Id: nodeID}, overlay.NodeSelectionConfig{
+				AuditReputationAlpha0:  100,
+				UptimeReputationAlpha0: 1,
+			})
+			require.NoError(t, err)
+		}
+
+		for i, success := range []bool{false, true, false, true, false} {
+			stats := audit(alternating, success)
+			assert.EqualValues(t, 1-i%2, stats.AuditFailureStreak)
+		}
+		for i := 1; i <= 3; i++ {
+			stats := audit(consecutive, false)
+			assert.EqualValues(t, i, stats.AuditFailureStreak)
+		}
+		for i := 0; i < 3; i++ {
+			audit(recovered, false)
+		}
+		assert.EqualValues(t, 0, audit(recovered, true).AuditFailureStreak)
+
+		nodes, err := cache.NodesWithFailureStreakAtLeast(ctx, 2)
+		require.NoError(t, err)
+		assert.Equal(t, storj.NodeIDList{consecutive}, nodes)
+
+		nodes, err = cache.NodesWithFailureStreakAtLeast(ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, storj.NodeIDList{consecutive, alternating}, nodes)
+	})
+}
@@ -54,6 +54,7 @@ import (
 	"storj.io/storj/satellite/payments/localpayments"
 	"storj.io/storj/satellite/payments/stripepayments"
 	"storj.io/storj/satellite/repair/checker"
+	"storj.io/storj/satellite/repair/corrupt"
 	"storj.io/storj/satellite/repair/irreparable"
 	"storj.io/storj/satellite/repair/queue"
 	"storj.io/storj/satellite/repair/repairer"
@@ -91,6 +92,8 @@ type DB interface {
 	RepairQueue() queue.RepairQueue
 	// Irreparable returns database for failed repairs
 	Irreparable() irreparable.DB
+	// CorruptPointers returns database for pointers the checker found to be corrupt
+	CorruptPointers() corrupt.DB
 	// Console returns database for satellite console
 	Console() console.DB
 	//  returns database for marketing admin GUI
@@ -188,7 +191,8 @@ type Peer struct {
 		Inspector *irreparable.Inspector
 	}
 	Audit struct {
-		Service *audit.Service
+		Service  *audit.Service
+		Capacity *audit.CapacityChecker
 	}
 
 	GarbageCollection struct {
@@ -439,6 +443,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 			peer.Log.Named("checker"),
 			peer.DB.RepairQueue(),
 			peer.DB.Irreparable(),
+			peer.DB.CorruptPointers(),
 			peer.Metainfo.Service,
 			peer.Metainfo.Loop,
 			peer.Overlay.Service,
@@ -447,6 +452,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 		peer.Repair.Repairer = repairer.NewService(
 			peer.Log.Named("repairer"),
 			peer.DB.RepairQueue(),
+			peer.DB.Irreparable(),
 			&config.Repairer,
 			config.Repairer.Interval,
 			config.Repairer.MaxRepair,
@@ -476,6 +482,8 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+
+		peer.Audit.Capacity = audit.NewCapacityChecker(peer.Log.Named("audit:capacity"), peer.Overlay.Service, config)
 	}
 
 	{ // setup garbage collection
@@ -603,6 +611,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 			peer.DB.Rewards(),
 			pmService,
 			consoleConfig.PasswordCost,
+			consoleConfig.PasswordRules,
 		)
 
 		if err != nil {
@@ -688,6 +697,9 @@ func (peer *Peer) Run(ctx context.Context) (err error) {
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Audit.Service.Run(ctx))
 	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.Audit.Capacity.Run(ctx))
+	})
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.GarbageCollection.Service.Run(ctx))
 	})
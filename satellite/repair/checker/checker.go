@@ -5,6 +5,9 @@ package checker
 
 import (
 	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -18,6 +21,7 @@ import (
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/repair/corrupt"
 	"storj.io/storj/satellite/repair/irreparable"
 	"storj.io/storj/satellite/repair/queue"
 )
@@ -28,6 +32,18 @@ var (
 	mon   = monkit.Package()
 )
 
+// needingRepairByScheme reports the segments needing repair found by the last
+// checker run, with one value per redundancy scheme.
+var needingRepairByScheme schemeCounts
+
+func init() {
+	mon.Chain("remote_segments_needing_repair_by_scheme", &needingRepairByScheme)
+}
+
+// corruptReasonBadRedundancy is recorded when a pointer needs repair according to its redundancy
+// scheme, even though none of its pieces are missing
+const corruptReasonBadRedundancy = "missing pieces is zero in repair range"
+
 // Config contains configurable values for checker
 type Config struct {
 	Interval            time.Duration `help:"how frequently checker should check for bad segments" releaseDefault:"30s" devDefault:"0h0m10s"`
@@ -42,7 +58,44 @@ type durabilityStats struct {
 	remoteSegmentsChecked       int64
 	remoteSegmentsNeedingRepair int64
 	remoteSegmentsLost          int64
+	remoteSegmentsCorrupt       int64
 	remoteSegmentInfo           []string
+
+	// remoteSegmentsNeedingRepairByScheme breaks remoteSegmentsNeedingRepair down by redundancy scheme
+	remoteSegmentsNeedingRepairByScheme map[redundancyScheme]int64
+}
+
+// redundancyScheme identifies the redundancy scheme of a segment
+type redundancyScheme struct {
+	minReq int32
+	total  int32
+}
+
+// schemeCounts is a monkit.StatSource of segment counts per redundancy scheme
+type schemeCounts struct {
+	mu     sync.Mutex
+	counts map[redundancyScheme]int64
+}
+
+// set replaces all counts, so that schemes not seen anymore aren't reported
+func (counts *schemeCounts) set(latest map[redundancyScheme]int64) {
+	counts.mu.Lock()
+	defer counts.mu.Unlock()
+
+	counts.counts = make(map[redundancyScheme]int64, len(latest))
+	for scheme, count := range latest {
+		counts.counts[scheme] = count
+	}
+}
+
+// Stats implements monkit.StatSource
+func (counts *schemeCounts) Stats(cb func(name string, val float64)) {
+	counts.mu.Lock()
+	defer counts.mu.Unlock()
+
+	for scheme, count := range counts.counts {
+		cb(fmt.Sprintf("rs_%d_%d", scheme.minReq, scheme.total), float64(count))
+	}
 }
 
 // Checker contains the information needed to do checks for missing pieces
@@ -50,22 +103,28 @@ type Checker struct {
 	logger          *zap.Logger
 	repairQueue     queue.RepairQueue
 	irrdb           irreparable.DB
+	corruptdb       corrupt.DB
 	metainfo        *metainfo.Service
 	metaLoop        *metainfo.Loop
+	overlay         *overlay.Cache
 	nodestate       *ReliabilityCache
 	Loop            sync2.Cycle
 	IrreparableLoop sync2.Cycle
+
+	paused int32
 }
 
 // NewChecker creates a new instance of checker
-func NewChecker(logger *zap.Logger, repairQueue queue.RepairQueue, irrdb irreparable.DB, metainfo *metainfo.Service, metaLoop *metainfo.Loop, overlay *overlay.Cache, config Config) *Checker {
+func NewChecker(logger *zap.Logger, repairQueue queue.RepairQueue, irrdb irreparable.DB, corruptdb corrupt.DB, metainfo *metainfo.Service, metaLoop *metainfo.Loop, overlay *overlay.Cache, config Config) *Checker {
 	return &Checker{
 		logger: logger,
 
 		repairQueue: repairQueue,
 		irrdb:       irrdb,
+		corruptdb:   corruptdb,
 		metainfo:    metainfo,
 		metaLoop:    metaLoop,
+		overlay:     overlay,
 		nodestate:   NewReliabilityCache(overlay, config.ReliabilityCacheStaleness),
 
 		Loop:            *sync2.NewCycle(config.Interval),
@@ -77,6 +136,12 @@ func NewChecker(logger *zap.Logger, repairQueue queue.RepairQueue, irrdb irrepar
 func (checker *Checker) Run(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	// populate the cache up front, so the first iteration doesn't cause a burst of overlay queries
+	err = checker.WarmReliabilityCache(ctx)
+	if err != nil {
+		checker.logger.Error("failed to warm reliability cache", zap.Error(err))
+	}
+
 	group, ctx := errgroup.WithContext(ctx)
 
 	group.Go(func() error {
@@ -95,12 +160,67 @@ func (checker *Checker) RefreshReliabilityCache(ctx context.Context) error {
 	return checker.nodestate.Refresh(ctx)
 }
 
+// WarmReliabilityCache populates node online status cache, unless it has already been populated.
+func (checker *Checker) WarmReliabilityCache(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !checker.nodestate.LastUpdate().IsZero() {
+		return nil
+	}
+	return checker.nodestate.Refresh(ctx)
+}
+
+// VerifySegmentDurability returns the number of healthy pieces of the segment at path.
+// Unlike the checker loop, it doesn't use the reliability cache but looks up the current
+// node status in the overlay, so the result isn't affected by a stale cache.
+func (checker *Checker) VerifySegmentDurability(ctx context.Context, path storj.Path) (numHealthy int32, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pointer, err := checker.metainfo.Get(ctx, path)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	pieces := pointer.GetRemote().GetRemotePieces()
+	if len(pieces) == 0 {
+		return 0, nil
+	}
+
+	missingPieces, err := checker.overlay.GetMissingPieces(ctx, pieces)
+	if err != nil {
+		return 0, errs.Combine(Error.New("error getting missing pieces"), err)
+	}
+
+	return int32(len(pieces) - len(missingPieces)), nil
+}
+
 // Close halts the Checker loop
 func (checker *Checker) Close() error {
 	checker.Loop.Close()
 	return nil
 }
 
+// Pause stops the checker from looking for injured and irreparable segments
+// until Resume is called. It waits for a check that is in progress to finish.
+//
+// When the checker isn't running yet, Pause waits for it to start.
+func (checker *Checker) Pause() {
+	checker.Loop.Pause()
+	checker.IrreparableLoop.Pause()
+	atomic.StoreInt32(&checker.paused, 1)
+}
+
+// Resume restarts the checker loops after Pause.
+func (checker *Checker) Resume() {
+	checker.Loop.Restart()
+	checker.IrreparableLoop.Restart()
+	atomic.StoreInt32(&checker.paused, 0)
+}
+
+// Paused returns whether the checker has been paused with Pause.
+func (checker *Checker) Paused() bool {
+	return atomic.LoadInt32(&checker.paused) == 1
+}
+
 // IdentifyInjuredSegments checks for missing pieces off of the metainfo and overlay cache
 func (checker *Checker) IdentifyInjuredSegments(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -108,6 +228,7 @@ func (checker *Checker) IdentifyInjuredSegments(ctx context.Context) (err error)
 	observer := &checkerObserver{
 		repairQueue: checker.repairQueue,
 		irrdb:       checker.irrdb,
+		corruptdb:   checker.corruptdb,
 		nodestate:   checker.nodestate,
 		monStats:    durabilityStats{},
 		log:         checker.logger,
@@ -125,6 +246,8 @@ func (checker *Checker) IdentifyInjuredSegments(ctx context.Context) (err error)
 	mon.IntVal("remote_segments_needing_repair").Observe(observer.monStats.remoteSegmentsNeedingRepair)
 	mon.IntVal("remote_segments_lost").Observe(observer.monStats.remoteSegmentsLost)
 	mon.IntVal("remote_files_lost").Observe(int64(len(observer.monStats.remoteSegmentInfo)))
+	mon.IntVal("remote_segments_corrupt").Observe(observer.monStats.remoteSegmentsCorrupt)
+	needingRepairByScheme.set(observer.monStats.remoteSegmentsNeedingRepairByScheme)
 
 	return nil
 }
@@ -171,6 +294,12 @@ func (checker *Checker) updateIrreparableSegmentStatus(ctx context.Context, poin
 				zap.Int32("repair", redundancy.RepairThreshold),
 				zap.Int32("success", redundancy.SuccessThreshold),
 				zap.Int32("total", redundancy.Total))
+
+			// record the pointer so that an operator can review and fix it
+			err = checker.corruptdb.Insert(ctx, []byte(path), pointer, corruptReasonBadRedundancy)
+			if err != nil {
+				checker.logger.Error("error adding corrupt pointer to db", zap.Error(err))
+			}
 			return nil
 		}
 		err = checker.repairQueue.Insert(ctx, &pb.InjuredSegment{
@@ -213,11 +342,49 @@ func (checker *Checker) updateIrreparableSegmentStatus(ctx context.Context, poin
 type checkerObserver struct {
 	repairQueue queue.RepairQueue
 	irrdb       irreparable.DB
+	corruptdb   corrupt.DB
 	nodestate   *ReliabilityCache
 	monStats    durabilityStats
 	log         *zap.Logger
 }
 
+// Fork returns a new observer for a single shard of a parallel metainfo loop iteration
+func (obs *checkerObserver) Fork() metainfo.Observer {
+	return &checkerObserver{
+		repairQueue: obs.repairQueue,
+		irrdb:       obs.irrdb,
+		corruptdb:   obs.corruptdb,
+		nodestate:   obs.nodestate,
+		log:         obs.log,
+	}
+}
+
+// Merge adds the stats collected by a forked observer to this observer
+func (obs *checkerObserver) Merge(other metainfo.Observer) error {
+	fork, ok := other.(*checkerObserver)
+	if !ok {
+		return Error.New("unexpected observer type %T", other)
+	}
+
+	obs.monStats.remoteFilesChecked += fork.monStats.remoteFilesChecked
+	obs.monStats.remoteSegmentsChecked += fork.monStats.remoteSegmentsChecked
+	obs.monStats.remoteSegmentsNeedingRepair += fork.monStats.remoteSegmentsNeedingRepair
+	obs.monStats.remoteSegmentsLost += fork.monStats.remoteSegmentsLost
+	obs.monStats.remoteSegmentsCorrupt += fork.monStats.remoteSegmentsCorrupt
+	for _, lostSegInfo := range fork.monStats.remoteSegmentInfo {
+		if !contains(obs.monStats.remoteSegmentInfo, lostSegInfo) {
+			obs.monStats.remoteSegmentInfo = append(obs.monStats.remoteSegmentInfo, lostSegInfo)
+		}
+	}
+	for scheme, count := range fork.monStats.remoteSegmentsNeedingRepairByScheme {
+		if obs.monStats.remoteSegmentsNeedingRepairByScheme == nil {
+			obs.monStats.remoteSegmentsNeedingRepairByScheme = make(map[redundancyScheme]int64)
+		}
+		obs.monStats.remoteSegmentsNeedingRepairByScheme[scheme] += count
+	}
+	return nil
+}
+
 func (obs *checkerObserver) RemoteSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -251,9 +418,31 @@ func (obs *checkerObserver) RemoteSegment(ctx context.Context, path storj.Path,
 				zap.Int32("repair", redundancy.RepairThreshold),
 				zap.Int32("success", redundancy.SuccessThreshold),
 				zap.Int32("total", redundancy.Total))
+			obs.monStats.remoteSegmentsCorrupt++
+
+			// record the pointer so that an operator can review and fix it
+			err = obs.corruptdb.Insert(ctx, []byte(path), pointer, corruptReasonBadRedundancy)
+			if err != nil {
+				obs.log.Error("error adding corrupt pointer to db", zap.Error(err))
+			}
 			return nil
 		}
 		obs.monStats.remoteSegmentsNeedingRepair++
+		if obs.monStats.remoteSegmentsNeedingRepairByScheme == nil {
+			obs.monStats.remoteSegmentsNeedingRepairByScheme = make(map[redundancyScheme]int64)
+		}
+		obs.monStats.remoteSegmentsNeedingRepairByScheme[redundancyScheme{redundancy.MinReq, redundancy.Total}]++
+
+		// the repairer quarantines the segments it failed to repair too many times
+		quarantined, err := obs.irrdb.IsQuarantined(ctx, []byte(path))
+		if err != nil {
+			obs.log.Error("error checking irreparable db", zap.Error(err))
+			return nil
+		}
+		if quarantined {
+			return nil
+		}
+
 		err = obs.repairQueue.Insert(ctx, &pb.InjuredSegment{
 			Path:         []byte(path),
 			LostPieces:   missingPieces,
@@ -320,15 +509,54 @@ func (obs *checkerObserver) InlineSegment(ctx context.Context, path storj.Path,
 	return nil
 }
 
-// IrreparableProcess iterates over all items in the irreparabledb. If an item can
-// now be repaired then it is added to a worker queue.
+// IrreparableProcess iterates over all items in the irreparabledb, except the quarantined
+// ones. If an item can now be repaired then it is added to a worker queue.
 func (checker *Checker) IrreparableProcess(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 	const limit = 1000
 	lastSeenSegmentPath := []byte{}
 
 	for {
-		segments, err := checker.irrdb.GetLimited(ctx, limit, lastSeenSegmentPath)
+		segments, err := checker.irrdb.GetLimitedUnquarantined(ctx, limit, lastSeenSegmentPath)
+		if err != nil {
+			return errs.Combine(Error.New("error reading segment from the queue"), err)
+		}
+
+		// zero segments returned with nil err
+		if len(segments) == 0 {
+			break
+		}
+
+		lastSeenSegmentPath = segments[len(segments)-1].Path
+
+		for _, segment := range segments {
+			err = checker.updateIrreparableSegmentStatus(ctx, segment.GetSegmentDetail(), string(segment.GetPath()))
+			if err != nil {
+				checker.logger.Error("irrepair segment checker failed: ", zap.Error(err))
+			}
+		}
+	}
+
+	return nil
+}
+
+// ForceReprocessIrreparable immediately re-evaluates the irreparable segments that have
+// a piece on nodeID, e.g. when a node returns after a downtime. Segments that became
+// repairable are added to the repair queue and removed from the irreparable db.
+func (checker *Checker) ForceReprocessIrreparable(ctx context.Context, nodeID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the node status has most likely changed since the cache was last refreshed
+	err = checker.nodestate.Refresh(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	const limit = 1000
+	lastSeenSegmentPath := []byte{}
+
+	for {
+		segments, err := checker.irrdb.GetLimitedUnquarantined(ctx, limit, lastSeenSegmentPath)
 		if err != nil {
 			return errs.Combine(Error.New("error reading segment from the queue"), err)
 		}
@@ -341,6 +569,9 @@ func (checker *Checker) IrreparableProcess(ctx context.Context) (err error) {
 		lastSeenSegmentPath = segments[len(segments)-1].Path
 
 		for _, segment := range segments {
+			if !hasPieceOnNode(segment.GetSegmentDetail(), nodeID) {
+				continue
+			}
 			err = checker.updateIrreparableSegmentStatus(ctx, segment.GetSegmentDetail(), string(segment.GetPath()))
 			if err != nil {
 				checker.logger.Error("irrepair segment checker failed: ", zap.Error(err))
@@ -350,3 +581,13 @@ func (checker *Checker) IrreparableProcess(ctx context.Context) (err error) {
 
 	return nil
 }
+
+// hasPieceOnNode returns whether pointer has a remote piece stored on nodeID
+func hasPieceOnNode(pointer *pb.Pointer, nodeID storj.NodeID) bool {
+	for _, piece := range pointer.GetRemote().GetRemotePieces() {
+		if piece.NodeId == nodeID {
+			return true
+		}
+	}
+	return false
+}
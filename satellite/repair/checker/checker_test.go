@@ -10,12 +10,15 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/internal/testrand"
 	"storj.io/storj/internal/teststorj"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite"
 	"storj.io/storj/storage"
 )
 
@@ -162,6 +165,196 @@ func TestIdentifyIrreparableSegments(t *testing.T) {
 	})
 }
 
+func TestForceReprocessIrreparable(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 3, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		checker := planet.Satellites[0].Repair.Checker
+		checker.Loop.Stop()
+		checker.IrreparableLoop.Stop()
+
+		irreparable := planet.Satellites[0].DB.Irreparable()
+		repairQueue := planet.Satellites[0].DB.RepairQueue()
+
+		// a node that is not known to the satellite, until it comes back online
+		returningNode := testrand.NodeID()
+
+		makeIrreparable := func(path string, nodes storj.NodeIDList, quarantine bool) {
+			pieces := make([]*pb.RemotePiece, 0, 10)
+			for _, nodeID := range nodes {
+				pieces = append(pieces, &pb.RemotePiece{PieceNum: int32(len(pieces)), NodeId: nodeID})
+			}
+			// simulate offline nodes
+			for len(pieces) < 10 {
+				pieces = append(pieces, &pb.RemotePiece{PieceNum: int32(len(pieces)), NodeId: testrand.NodeID()})
+			}
+			segment := &pb.IrreparableSegment{
+				Path: []byte(path),
+				SegmentDetail: &pb.Pointer{
+					CreationDate: time.Now(),
+					Remote: &pb.RemoteSegment{
+						Redundancy: &pb.RedundancyScheme{
+							MinReq:           int32(3),
+							RepairThreshold:  int32(8),
+							SuccessThreshold: int32(9),
+							Total:            int32(10),
+						},
+						RootPieceId:  teststorj.PieceIDFromString(path),
+						RemotePieces: pieces,
+					},
+				},
+				LostPieces:         int32(10 - len(nodes)),
+				LastRepairAttempt:  time.Now().Unix(),
+				RepairAttemptCount: 1,
+			}
+			if quarantine {
+				require.NoError(t, irreparable.Quarantine(ctx, segment))
+			} else {
+				require.NoError(t, irreparable.IncrementRepairAttempts(ctx, segment))
+			}
+		}
+
+		var online storj.NodeIDList
+		for _, node := range planet.StorageNodes {
+			online = append(online, node.ID())
+		}
+
+		makeIrreparable("on-returning-node", append(online, returningNode), false)
+		makeIrreparable("not-on-returning-node", online, false)
+		makeIrreparable("quarantined-on-returning-node", append(online, returningNode), true)
+
+		// bring the node online
+		err := planet.Satellites[0].Overlay.Service.Put(ctx, returningNode, pb.Node{
+			Id:      returningNode,
+			Address: &pb.NodeAddress{Address: "127.0.0.1:55555"},
+		})
+		require.NoError(t, err)
+		_, err = planet.Satellites[0].Overlay.Service.UpdateUptime(ctx, returningNode, true)
+		require.NoError(t, err)
+
+		err = checker.ForceReprocessIrreparable(ctx, returningNode)
+		require.NoError(t, err)
+
+		// the segment on the returning node is repairable now
+		_, err = irreparable.Get(ctx, []byte("on-returning-node"))
+		require.Error(t, err)
+
+		injuredSegment, err := repairQueue.Select(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []byte("on-returning-node"), injuredSegment.Path)
+
+		_, err = repairQueue.Select(ctx)
+		require.True(t, storage.ErrEmptyQueue.Has(err))
+
+		// the other segment was not touched
+		segment, err := irreparable.Get(ctx, []byte("not-on-returning-node"))
+		require.NoError(t, err)
+		require.EqualValues(t, 1, segment.RepairAttemptCount)
+
+		// the quarantined segment stays in the irreparable db
+		quarantined, err := irreparable.IsQuarantined(ctx, []byte("quarantined-on-returning-node"))
+		require.NoError(t, err)
+		require.True(t, quarantined)
+	})
+}
+
+func TestVerifySegmentDurability(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		checker := planet.Satellites[0].Repair.Checker
+		checker.Loop.Stop()
+		checker.IrreparableLoop.Stop()
+
+		pieces := make([]*pb.RemotePiece, 0, len(planet.StorageNodes))
+		for i, node := range planet.StorageNodes {
+			pieces = append(pieces, &pb.RemotePiece{PieceNum: int32(i), NodeId: node.ID()})
+		}
+		pointer := &pb.Pointer{
+			CreationDate: time.Now(),
+			Remote: &pb.RemoteSegment{
+				Redundancy: &pb.RedundancyScheme{
+					MinReq:           int32(1),
+					RepairThreshold:  int32(3),
+					SuccessThreshold: int32(4),
+					Total:            int32(4),
+				},
+				RootPieceId:  teststorj.PieceIDFromString("durability"),
+				RemotePieces: pieces,
+			},
+		}
+		err := planet.Satellites[0].Metainfo.Service.Put(ctx, "durability", pointer)
+		require.NoError(t, err)
+
+		// cache all nodes as online
+		err = checker.RefreshReliabilityCache(ctx)
+		require.NoError(t, err)
+
+		// take a node offline without refreshing the cache
+		offlineNode := planet.StorageNodes[0]
+		err = planet.StopPeer(offlineNode)
+		require.NoError(t, err)
+		_, err = planet.Satellites[0].Overlay.Service.UpdateUptime(ctx, offlineNode.ID(), false)
+		require.NoError(t, err)
+
+		// the checker loop uses the stale cache and doesn't notice the segment is injured
+		err = checker.IdentifyInjuredSegments(ctx)
+		require.NoError(t, err)
+		count, err := planet.Satellites[0].DB.RepairQueue().Count(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 0, count)
+
+		numHealthy, err := checker.VerifySegmentDurability(ctx, "durability")
+		require.NoError(t, err)
+		require.EqualValues(t, 3, numHealthy)
+	})
+}
+
+func TestPauseResume(t *testing.T) {
+	const interval = 50 * time.Millisecond
+
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Checker.Interval = interval
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		checker := planet.Satellites[0].Repair.Checker
+		repairer := planet.Satellites[0].Repair.Repairer
+		repairQueue := planet.Satellites[0].DB.RepairQueue()
+
+		// keep the repairer from taking the injured segment out of the queue
+		repairer.Pause()
+		require.True(t, repairer.Paused())
+
+		checker.Pause()
+		require.True(t, checker.Paused())
+
+		makePointer(t, planet, "b", true)
+
+		// the injured segment isn't found while the checker is paused
+		time.Sleep(10 * interval)
+		count, err := repairQueue.Count(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 0, count)
+
+		checker.Resume()
+		require.False(t, checker.Paused())
+
+		for start := time.Now(); count == 0 && time.Since(start) < 10*time.Second; {
+			time.Sleep(interval)
+			count, err = repairQueue.Count(ctx)
+			require.NoError(t, err)
+		}
+		require.Equal(t, 1, count)
+
+		repairer.Resume()
+		require.False(t, repairer.Paused())
+	})
+}
+
 func makePointer(t *testing.T, planet *testplanet.Planet, pieceID string, createLost bool) {
 	ctx := context.TODO()
 	numOfStorageNodes := len(planet.StorageNodes)
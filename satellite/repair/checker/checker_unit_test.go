@@ -0,0 +1,165 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package checker
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/repair/corrupt"
+	"storj.io/storj/satellite/repair/irreparable"
+	"storj.io/storj/satellite/repair/queue"
+)
+
+func TestNeedingRepairByScheme(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	reliable := make(storj.NodeIDList, 10)
+	for i := range reliable {
+		reliable[i] = testrand.NodeID()
+	}
+
+	ocache := overlay.NewCache(zap.NewNop(), reliableOverlayDB{reliable: reliable}, overlay.Config{})
+	observer := &checkerObserver{
+		repairQueue: fakeRepairQueue{},
+		irrdb:       fakeIrreparableDB{},
+		nodestate:   NewReliabilityCache(ocache, time.Hour),
+		log:         zap.NewNop(),
+	}
+
+	// makePointer creates a pointer with the given scheme and number of healthy pieces
+	makePointer := func(minReq, repair, success, total int32, healthy int) *pb.Pointer {
+		var pieces []*pb.RemotePiece
+		for i := 0; i < int(total); i++ {
+			nodeID := testrand.NodeID()
+			if i < healthy {
+				nodeID = reliable[i]
+			}
+			pieces = append(pieces, &pb.RemotePiece{PieceNum: int32(i), NodeId: nodeID})
+		}
+		return &pb.Pointer{
+			Remote: &pb.RemoteSegment{
+				Redundancy: &pb.RedundancyScheme{
+					MinReq:           minReq,
+					RepairThreshold:  repair,
+					SuccessThreshold: success,
+					Total:            total,
+				},
+				RemotePieces: pieces,
+			},
+		}
+	}
+
+	pointers := []*pb.Pointer{
+		// 2/4 scheme: two need repair, one is healthy
+		makePointer(2, 3, 4, 4, 3),
+		makePointer(2, 3, 4, 4, 3),
+		makePointer(2, 3, 4, 4, 4),
+		// 3/6 scheme: one needs repair, one is lost
+		makePointer(3, 5, 6, 6, 4),
+		makePointer(3, 5, 6, 6, 2),
+	}
+	for i, pointer := range pointers {
+		err := observer.RemoteSegment(ctx, fmt.Sprintf("segment-%d", i), pointer)
+		require.NoError(t, err)
+	}
+
+	require.EqualValues(t, 3, observer.monStats.remoteSegmentsNeedingRepair)
+	require.EqualValues(t, 1, observer.monStats.remoteSegmentsLost)
+	require.Equal(t, map[redundancyScheme]int64{
+		{minReq: 2, total: 4}: 2,
+		{minReq: 3, total: 6}: 1,
+	}, observer.monStats.remoteSegmentsNeedingRepairByScheme)
+}
+
+func TestCorruptPointerRecorded(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	reliable := make(storj.NodeIDList, 3)
+	for i := range reliable {
+		reliable[i] = testrand.NodeID()
+	}
+
+	ocache := overlay.NewCache(zap.NewNop(), reliableOverlayDB{reliable: reliable}, overlay.Config{})
+	corruptdb := &fakeCorruptPointers{}
+	observer := &checkerObserver{
+		repairQueue: fakeRepairQueue{},
+		irrdb:       fakeIrreparableDB{},
+		corruptdb:   corruptdb,
+		nodestate:   NewReliabilityCache(ocache, time.Hour),
+		log:         zap.NewNop(),
+	}
+
+	// all pieces are healthy, but there are fewer of them than the repair threshold
+	var pieces []*pb.RemotePiece
+	for i, nodeID := range reliable {
+		pieces = append(pieces, &pb.RemotePiece{PieceNum: int32(i), NodeId: nodeID})
+	}
+	pointer := &pb.Pointer{
+		Remote: &pb.RemoteSegment{
+			Redundancy: &pb.RedundancyScheme{
+				MinReq:           2,
+				RepairThreshold:  4,
+				SuccessThreshold: 5,
+				Total:            5,
+			},
+			RemotePieces: pieces,
+		},
+	}
+
+	err := observer.RemoteSegment(ctx, "corrupt-segment", pointer)
+	require.NoError(t, err)
+
+	require.EqualValues(t, 0, observer.monStats.remoteSegmentsNeedingRepair)
+	require.EqualValues(t, 1, observer.monStats.remoteSegmentsCorrupt)
+	require.Len(t, corruptdb.inserted, 1)
+	require.Equal(t, []byte("corrupt-segment"), corruptdb.inserted[0].Path)
+	require.Equal(t, pointer, corruptdb.inserted[0].Pointer)
+	require.Equal(t, corruptReasonBadRedundancy, corruptdb.inserted[0].Reason)
+}
+
+type reliableOverlayDB struct {
+	overlay.DB
+	reliable storj.NodeIDList
+}
+
+func (db reliableOverlayDB) Reliable(context.Context, *overlay.NodeCriteria) (storj.NodeIDList, error) {
+	return db.reliable, nil
+}
+
+type fakeRepairQueue struct{ queue.RepairQueue }
+
+func (fakeRepairQueue) Insert(context.Context, *pb.InjuredSegment) error { return nil }
+
+type fakeIrreparableDB struct{ irreparable.DB }
+
+func (fakeIrreparableDB) Delete(context.Context, []byte) error { return nil }
+
+func (fakeIrreparableDB) IsQuarantined(context.Context, []byte) (bool, error) { return false, nil }
+
+func (fakeIrreparableDB) IncrementRepairAttempts(context.Context, *pb.IrreparableSegment) error {
+	return nil
+}
+
+type fakeCorruptPointers struct {
+	corrupt.DB
+	inserted []*corrupt.Pointer
+}
+
+func (db *fakeCorruptPointers) Insert(ctx context.Context, path []byte, pointer *pb.Pointer, reason string) error {
+	db.inserted = append(db.inserted, &corrupt.Pointer{Path: path, Pointer: pointer, Reason: reason})
+	return nil
+}
@@ -5,9 +5,11 @@ package checker
 
 import (
 	"context"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 
 	"storj.io/storj/internal/testcontext"
@@ -50,3 +52,47 @@ func (fakeOverlayDB) Reliable(context.Context, *overlay.NodeCriteria) (storj.Nod
 		testrand.NodeID(),
 	}, nil
 }
+
+func TestWarmReliabilityCache(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	overlayDB := &countingOverlayDB{}
+	ocache := overlay.NewCache(zap.NewNop(), overlayDB, overlay.Config{})
+	checker := NewChecker(zap.NewNop(), nil, nil, nil, nil, nil, ocache, Config{
+		Interval:                  time.Hour,
+		IrreparableInterval:       time.Hour,
+		ReliabilityCacheStaleness: time.Hour,
+	})
+
+	require.True(t, checker.nodestate.LastUpdate().IsZero())
+
+	createdBefore := time.Now()
+
+	err := checker.WarmReliabilityCache(ctx)
+	require.NoError(t, err)
+	require.False(t, checker.nodestate.LastUpdate().IsZero())
+	require.EqualValues(t, 1, atomic.LoadInt64(&overlayDB.calls))
+
+	// warming an already populated cache does nothing
+	err = checker.WarmReliabilityCache(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, atomic.LoadInt64(&overlayDB.calls))
+
+	// processing segments uses the warm cache without querying overlay
+	for i := 0; i < 10; i++ {
+		_, err := checker.nodestate.MissingPieces(ctx, createdBefore, []*pb.RemotePiece{{NodeId: testrand.NodeID()}})
+		require.NoError(t, err)
+	}
+	require.EqualValues(t, 1, atomic.LoadInt64(&overlayDB.calls))
+}
+
+type countingOverlayDB struct {
+	overlay.DB
+	calls int64
+}
+
+func (db *countingOverlayDB) Reliable(context.Context, *overlay.NodeCriteria) (storj.NodeIDList, error) {
+	atomic.AddInt64(&db.calls, 1)
+	return storj.NodeIDList{testrand.NodeID()}, nil
+}
@@ -0,0 +1,36 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package corrupt
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/pb"
+)
+
+// ErrPointerNotFound is returned when a corrupt pointer is not in the database
+var ErrPointerNotFound = errs.Class("corrupt pointer not found")
+
+// Pointer is a pointer the checker found to be inconsistent with its redundancy scheme
+type Pointer struct {
+	Path     []byte
+	Pointer  *pb.Pointer
+	Reason   string
+	Detected time.Time
+}
+
+// DB stores corrupt pointers found by the checker, so that operators can review and fix them.
+type DB interface {
+	// Insert records a corrupt pointer, or updates the reason and detection time if it is already recorded.
+	Insert(ctx context.Context, path []byte, pointer *pb.Pointer, reason string) error
+	// Get returns the corrupt pointer recorded for path.
+	Get(ctx context.Context, path []byte) (*Pointer, error)
+	// GetLimited returns a list of corrupt pointers starting after lastSeenPath.
+	GetLimited(ctx context.Context, limit int, lastSeenPath []byte) ([]*Pointer, error)
+	// Delete removes the corrupt pointer recorded for path.
+	Delete(ctx context.Context, path []byte) error
+}
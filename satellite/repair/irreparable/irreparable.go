@@ -13,10 +13,16 @@ import (
 type DB interface {
 	// IncrementRepairAttempts increments the repair attempts.
 	IncrementRepairAttempts(ctx context.Context, segmentInfo *pb.IrreparableSegment) error
+	// Quarantine adds a segment whose repair failed too many times, so that the checker doesn't queue it for repair again.
+	Quarantine(ctx context.Context, segmentInfo *pb.IrreparableSegment) error
+	// IsQuarantined returns whether the segment was quarantined.
+	IsQuarantined(ctx context.Context, segmentPath []byte) (bool, error)
 	// Get returns irreparable segment info based on segmentPath.
 	Get(ctx context.Context, segmentPath []byte) (*pb.IrreparableSegment, error)
 	// GetLimited returns a list of irreparable segment info starting after the last segment info we retrieved
 	GetLimited(ctx context.Context, limit int, lastSeenSegmentPath []byte) ([]*pb.IrreparableSegment, error)
+	// GetLimitedUnquarantined returns a list of irreparable segment info like GetLimited, skipping the quarantined segments.
+	GetLimitedUnquarantined(ctx context.Context, limit int, lastSeenSegmentPath []byte) ([]*pb.IrreparableSegment, error)
 	// Delete removes irreparable segment info based on segmentPath.
 	Delete(ctx context.Context, segmentPath []byte) error
 }
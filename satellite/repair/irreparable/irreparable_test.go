@@ -96,6 +96,52 @@ func TestIrreparable(t *testing.T) {
 			require.Empty(t, cmp.Diff(segments[0], dbxInfo, cmp.Comparer(pb.Equal)))
 		}
 
+		{ // Quarantined segments are skipped by GetLimitedUnquarantined
+			quarantined, err := irrdb.IsQuarantined(ctx, segments[1].Path)
+			require.NoError(t, err)
+			require.False(t, quarantined)
+
+			err = irrdb.Quarantine(ctx, segments[1])
+			require.NoError(t, err)
+			segments[1].RepairAttemptCount *= 2
+
+			quarantined, err = irrdb.IsQuarantined(ctx, segments[1].Path)
+			require.NoError(t, err)
+			require.True(t, quarantined)
+
+			dbxInfo, err := irrdb.Get(ctx, segments[1].Path)
+			require.NoError(t, err)
+			require.Empty(t, cmp.Diff(segments[1], dbxInfo, cmp.Comparer(pb.Equal)))
+
+			segs, err := irrdb.GetLimited(ctx, 3, []byte{})
+			require.NoError(t, err)
+			require.Equal(t, 3, len(segs))
+
+			segs, err = irrdb.GetLimitedUnquarantined(ctx, 3, []byte{})
+			require.NoError(t, err)
+			require.Equal(t, 2, len(segs))
+			require.Empty(t, cmp.Diff(segments[0], segs[0], cmp.Comparer(pb.Equal)))
+			require.Empty(t, cmp.Diff(segments[2], segs[1], cmp.Comparer(pb.Equal)))
+
+			// a new segment is added as quarantined
+			segment := &pb.IrreparableSegment{
+				Path:               []byte(strconv.Itoa(3)),
+				SegmentDetail:      &pb.Pointer{CreationDate: time.Now()},
+				LastRepairAttempt:  time.Now().Unix(),
+				RepairAttemptCount: int64(10),
+			}
+			err = irrdb.Quarantine(ctx, segment)
+			require.NoError(t, err)
+
+			quarantined, err = irrdb.IsQuarantined(ctx, segment.Path)
+			require.NoError(t, err)
+			require.True(t, quarantined)
+
+			quarantined, err = irrdb.IsQuarantined(ctx, []byte("unknown"))
+			require.NoError(t, err)
+			require.False(t, quarantined)
+		}
+
 		{ //Delete existing entry
 			err := irrdb.Delete(ctx, segments[0].Path)
 			require.NoError(t, err)
@@ -6,12 +6,15 @@ package repair_test
 import (
 	"context"
 	"math"
+	"sort"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 
 	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testblobs"
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/testplanet"
 	"storj.io/storj/internal/testrand"
@@ -19,7 +22,10 @@ import (
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/repair/repairer"
+	"storj.io/storj/storagenode"
 	"storj.io/storj/uplink"
+	"storj.io/storj/uplink/ecclient"
 )
 
 // TestDataRepair does the following:
@@ -166,6 +172,7 @@ func TestDataRepair(t *testing.T) {
 // - Kill nodes so that online nodes < minimum threshold
 // - Run the repairer
 // - Verify segment is no longer in the repair queue and segment should be the same
+// - Verify segment has been added to the irreparable db
 func TestRemoveIrreparableSegmentFromQueue(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount:   1,
@@ -194,7 +201,7 @@ func TestRemoveIrreparableSegmentFromQueue(t *testing.T) {
 		}, "testbucket", "test/path", testData)
 		require.NoError(t, err)
 
-		pointer, _ := getRemoteSegment(t, ctx, satellitePeer)
+		pointer, path := getRemoteSegment(t, ctx, satellitePeer)
 
 		// kill nodes and track lost pieces
 		nodesToDQ := make(map[storj.NodeID]bool)
@@ -241,6 +248,11 @@ func TestRemoveIrreparableSegmentFromQueue(t *testing.T) {
 		count, err = satellitePeer.DB.RepairQueue().Count(ctx)
 		require.NoError(t, err)
 		require.Equal(t, count, 0)
+
+		irreparableSegment, err := satellitePeer.DB.Irreparable().Get(ctx, []byte(path))
+		require.NoError(t, err)
+		require.Equal(t, int32(len(remotePieces)), irreparableSegment.GetLostPieces())
+		require.Equal(t, int64(1), irreparableSegment.GetRepairAttemptCount())
 	})
 }
 
@@ -485,6 +497,160 @@ func TestDataRepairUploadLimit(t *testing.T) {
 	})
 }
 
+// TestDataRepairPreservesPieceNumbers checks that repaired pieces are placed
+// at the erasure share numbers of the lost pieces.
+func TestDataRepairPreservesPieceNumbers(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 14,
+		UplinkCount:      1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		// stop discovery service so that we do not get a race condition when we delete nodes from overlay cache
+		satellite.Discovery.Service.Discovery.Stop()
+		satellite.Discovery.Service.Refresh.Stop()
+		// stop audit to prevent possible interactions i.e. repair timeout problems
+		satellite.Audit.Service.Loop.Stop()
+		satellite.Repair.Checker.Loop.Pause()
+		satellite.Repair.Repairer.Loop.Pause()
+
+		const repairThreshold = 5
+
+		err := planet.Uplinks[0].UploadWithConfig(ctx, satellite, &uplink.RSConfig{
+			MinThreshold:     3,
+			RepairThreshold:  repairThreshold,
+			SuccessThreshold: 7,
+			MaxThreshold:     10,
+		}, "testbucket", "test/path", testrand.Bytes(8*memory.KiB))
+		require.NoError(t, err)
+
+		pointer, path := getRemoteSegment(t, ctx, satellite)
+		originalPieces := append([]*pb.RemotePiece{}, pointer.GetRemote().GetRemotePieces()...)
+		originalNodes := make(map[storj.NodeID]bool)
+		for _, piece := range originalPieces {
+			originalNodes[piece.NodeId] = true
+		}
+
+		// lose the pieces with the highest piece numbers, so that any unused
+		// piece numbers are lower than the lost ones
+		sort.Slice(originalPieces, func(i, k int) bool {
+			return originalPieces[i].PieceNum > originalPieces[k].PieceNum
+		})
+		lostPieceNums := make(map[int32]bool)
+		for _, piece := range originalPieces[:len(originalPieces)-repairThreshold] {
+			stopNodeByID(t, ctx, planet, piece.NodeId)
+			lostPieceNums[piece.PieceNum] = true
+		}
+
+		satellite.Repair.Checker.Loop.Restart()
+		satellite.Repair.Checker.Loop.TriggerWait()
+		satellite.Repair.Checker.Loop.Pause()
+		satellite.Repair.Repairer.Loop.Restart()
+		satellite.Repair.Repairer.Loop.TriggerWait()
+		satellite.Repair.Repairer.Loop.Pause()
+		satellite.Repair.Repairer.Limiter.Wait()
+
+		pointer, err = satellite.Metainfo.Service.Get(ctx, path)
+		require.NoError(t, err)
+
+		pieceNums := make(map[int32]bool)
+		repairedPieceNums := make(map[int32]bool)
+		for _, piece := range pointer.GetRemote().GetRemotePieces() {
+			require.False(t, pieceNums[piece.PieceNum], "piece number %d is used twice", piece.PieceNum)
+			pieceNums[piece.PieceNum] = true
+			if !originalNodes[piece.NodeId] {
+				repairedPieceNums[piece.PieceNum] = true
+			}
+		}
+		require.NotEmpty(t, repairedPieceNums)
+
+		// the lost piece numbers are filled before any unused ones
+		if len(repairedPieceNums) <= len(lostPieceNums) {
+			for pieceNum := range repairedPieceNums {
+				require.True(t, lostPieceNums[pieceNum], "repaired piece number %d was not lost", pieceNum)
+			}
+		} else {
+			for pieceNum := range lostPieceNums {
+				require.True(t, repairedPieceNums[pieceNum], "lost piece number %d was not repaired", pieceNum)
+			}
+		}
+	})
+}
+
+// TestDataRepairDownloadTimeout checks that a repair whose healthy nodes are
+// too slow to download from is aborted once the download timeout expires,
+// instead of waiting for the nodes.
+func TestDataRepairDownloadTimeout(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 14,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			NewStorageNodeDB: func(index int, db storagenode.DB, log *zap.Logger) (storagenode.DB, error) {
+				return testblobs.NewSlowDB(log.Named("slowdb"), db), nil
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		// stop discovery service so that we do not get a race condition when we delete nodes from overlay cache
+		satellite.Discovery.Service.Discovery.Stop()
+		satellite.Discovery.Service.Refresh.Stop()
+		// stop audit to prevent possible interactions i.e. repair timeout problems
+		satellite.Audit.Service.Loop.Stop()
+		satellite.Repair.Checker.Loop.Pause()
+		satellite.Repair.Repairer.Loop.Pause()
+
+		const repairThreshold = 5
+
+		err := planet.Uplinks[0].UploadWithConfig(ctx, satellite, &uplink.RSConfig{
+			MinThreshold:     3,
+			RepairThreshold:  repairThreshold,
+			SuccessThreshold: 7,
+			MaxThreshold:     10,
+		}, "testbucket", "test/path", testrand.Bytes(8*memory.KiB))
+		require.NoError(t, err)
+
+		pointer, path := getRemoteSegment(t, ctx, satellite)
+		remotePieces := pointer.GetRemote().GetRemotePieces()
+
+		// lose enough pieces to need a repair and slow down the nodes holding the remaining ones
+		for _, piece := range remotePieces[:len(remotePieces)-repairThreshold] {
+			stopNodeByID(t, ctx, planet, piece.NodeId)
+		}
+		const nodeLatency = 5 * time.Second
+		for _, piece := range remotePieces[len(remotePieces)-repairThreshold:] {
+			for _, node := range planet.StorageNodes {
+				if node.ID() == piece.NodeId {
+					node.DB.(*testblobs.SlowDB).SetLatency(nodeLatency)
+				}
+			}
+		}
+
+		segmentRepairer := repairer.NewSegmentRepairer(
+			satellite.Log.Named("repairer"),
+			satellite.Metainfo.Service,
+			satellite.Orders.Service,
+			satellite.Overlay.Service,
+			ecclient.NewClient(satellite.Log.Named("ecclient"), satellite.Transport, 4*memory.MiB.Int()),
+			time.Second,
+			200*time.Millisecond,
+			0.05,
+		)
+
+		start := time.Now()
+		shouldDelete, err := segmentRepairer.Repair(ctx, path)
+		require.Error(t, err)
+		require.True(t, repairer.DownloadTimeoutError.Has(err), "unexpected error: %+v", err)
+		require.False(t, shouldDelete)
+		require.True(t, time.Since(start) < nodeLatency, "repair waited for the slow nodes")
+
+		// the segment is left as it was, to be repaired later
+		unrepaired, err := satellite.Metainfo.Service.Get(ctx, path)
+		require.NoError(t, err)
+		require.Len(t, unrepaired.GetRemote().GetRemotePieces(), len(remotePieces))
+	})
+}
+
 func isDisqualified(t *testing.T, ctx *testcontext.Context, satellite *satellite.Peer, nodeID storj.NodeID) bool {
 	node, err := satellite.Overlay.Service.Get(ctx, nodeID)
 	require.NoError(t, err)
@@ -0,0 +1,81 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repairer
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+)
+
+// PendingRepair is an injured segment waiting in the repair queue.
+type PendingRepair struct {
+	Path       storj.Path
+	LostPieces int
+	// Health is the number of healthy pieces above the minimum needed to
+	// reconstruct the segment. Segments with a lower health are more urgent,
+	// a negative health means the segment can't be reconstructed anymore.
+	Health       int
+	InsertedTime time.Time
+}
+
+// ListPendingRepairs returns up to limit segments of the repair queue, the
+// most urgent first, without removing them from the queue. At most
+// storage.LookupLimit queued segments are considered.
+//
+// Segments that were deleted since they were queued are left out.
+func (service *Service) ListPendingRepairs(ctx context.Context, limit int) (pending []PendingRepair, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	segments, err := service.queue.SelectN(ctx, storage.LookupLimit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	for _, segment := range segments {
+		path := storj.Path(segment.GetPath())
+
+		pointer, err := service.repairer.metainfo.Get(ctx, path)
+		if err != nil {
+			if storage.ErrKeyNotFound.Has(err) {
+				service.log.Debug("queued segment was deleted", zap.String("path", path))
+				continue
+			}
+			return nil, Error.Wrap(err)
+		}
+
+		remote := pointer.GetRemote()
+		lost := len(segment.GetLostPieces())
+		pending = append(pending, PendingRepair{
+			Path:         path,
+			LostPieces:   lost,
+			Health:       len(remote.GetRemotePieces()) - lost - int(remote.GetRedundancy().GetMinReq()),
+			InsertedTime: segment.GetInsertedTime(),
+		})
+	}
+
+	sort.Slice(pending, func(i, k int) bool {
+		a, b := pending[i], pending[k]
+		if a.Health != b.Health {
+			return a.Health < b.Health
+		}
+		if a.LostPieces != b.LostPieces {
+			return a.LostPieces > b.LostPieces
+		}
+		if !a.InsertedTime.Equal(b.InsertedTime) {
+			return a.InsertedTime.Before(b.InsertedTime)
+		}
+		return a.Path < b.Path
+	})
+
+	if limit > 0 && len(pending) > limit {
+		pending = pending[:limit]
+	}
+	return pending, nil
+}
@@ -0,0 +1,108 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repairer_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/repair/repairer"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+	"storj.io/storj/storage/teststore"
+)
+
+func TestListPendingRepairs(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		log := zaptest.NewLogger(t)
+		metainfoService := metainfo.NewService(log, teststore.New(), db.Buckets())
+		repairQueue := db.RepairQueue()
+
+		service := repairer.NewService(log, repairQueue, db.Irreparable(), &repairer.Config{}, time.Hour, 1, nil, metainfoService, nil, nil)
+
+		const total = 10
+		now := time.Now().UTC()
+
+		queueSegment := func(name string, lost int, inserted time.Time) storj.Path {
+			path := storj.JoinPaths(testrand.UUID().String(), "s0", "bucket", name)
+
+			var pieces []*pb.RemotePiece
+			for i := 0; i < total; i++ {
+				pieces = append(pieces, &pb.RemotePiece{PieceNum: int32(i), NodeId: testrand.NodeID()})
+			}
+			err := metainfoService.Put(ctx, path, &pb.Pointer{
+				Type: pb.Pointer_REMOTE,
+				Remote: &pb.RemoteSegment{
+					RootPieceId: testrand.PieceID(),
+					Redundancy: &pb.RedundancyScheme{
+						Type:             pb.RedundancyScheme_RS,
+						MinReq:           4,
+						RepairThreshold:  7,
+						SuccessThreshold: 9,
+						Total:            total,
+					},
+					RemotePieces: pieces,
+				},
+			})
+			require.NoError(t, err)
+
+			var lostPieces []int32
+			for i := 0; i < lost; i++ {
+				lostPieces = append(lostPieces, int32(i))
+			}
+			err = repairQueue.Insert(ctx, &pb.InjuredSegment{
+				Path:         []byte(path),
+				LostPieces:   lostPieces,
+				InsertedTime: inserted,
+			})
+			require.NoError(t, err)
+			return path
+		}
+
+		slightly := queueSegment("slightly", 3, now)
+		critical := queueSegment("critical", 6, now)
+		badly := queueSegment("badly", 4, now.Add(-time.Hour))
+		badlyLater := queueSegment("badly-later", 4, now)
+
+		// a queued segment that has been deleted since isn't listed
+		deleted := queueSegment("deleted", 5, now)
+		require.NoError(t, metainfoService.Delete(ctx, deleted))
+
+		pending, err := service.ListPendingRepairs(ctx, 0)
+		require.NoError(t, err)
+		require.Len(t, pending, 4)
+
+		var paths []storj.Path
+		var health []int
+		for _, segment := range pending {
+			paths = append(paths, segment.Path)
+			health = append(health, segment.Health)
+		}
+		require.Equal(t, []storj.Path{critical, badly, badlyLater, slightly}, paths)
+		require.Equal(t, []int{0, 2, 2, 3}, health)
+		require.Equal(t, 6, pending[0].LostPieces)
+
+		// listing doesn't dequeue the segments
+		count, err := repairQueue.Count(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 5, count)
+
+		pending, err = service.ListPendingRepairs(ctx, 2)
+		require.NoError(t, err)
+		require.Len(t, pending, 2)
+		require.Equal(t, critical, pending[0].Path)
+		require.Equal(t, badly, pending[1].Path)
+	})
+}
@@ -5,19 +5,24 @@ package repairer
 
 import (
 	"context"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 	"gopkg.in/spacemonkeygo/monkit.v2"
 
+	"storj.io/storj/internal/errs2"
 	"storj.io/storj/internal/memory"
 	"storj.io/storj/internal/sync2"
 	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
 	"storj.io/storj/pkg/transport"
 	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/repair/irreparable"
 	"storj.io/storj/satellite/repair/queue"
 	"storj.io/storj/storage"
 	"storj.io/storj/uplink/ecclient"
@@ -34,37 +39,64 @@ type Config struct {
 	MaxRepair                     int           `help:"maximum segments that can be repaired concurrently" releaseDefault:"5" devDefault:"1"`
 	Interval                      time.Duration `help:"how frequently repairer should try and repair more data" releaseDefault:"1h" devDefault:"0h5m0s"`
 	Timeout                       time.Duration `help:"time limit for uploading repaired pieces to new storage nodes" devDefault:"10m0s" releaseDefault:"2h"`
+	DownloadTimeout               time.Duration `help:"time limit for waiting on the healthy pieces of a segment for repair, the upload of the repaired pieces is not counted, 0 means no limit" devDefault:"10m0s" releaseDefault:"2h"`
 	MaxBufferMem                  memory.Size   `help:"maximum buffer memory (in bytes) to be allocated for read buffers" default:"4M"`
 	MaxExcessRateOptimalThreshold float64       `help:"ratio applied to the optimal threshold to calculate the excess of the maximum number of repaired pieces to upload" default:"0.05"`
+	MaxRepairAttempts             int           `help:"number of consecutive failed repairs of a segment after which it is moved to the irreparable db, 0 means no limit" default:"10"`
 }
 
 // Service contains the information needed to run the repair service
 type Service struct {
 	log      *zap.Logger
 	queue    queue.RepairQueue
+	irrdb    irreparable.DB
 	config   *Config
 	Limiter  *sync2.Limiter
 	Loop     sync2.Cycle
 	repairer *SegmentRepairer
+
+	paused int32
+
+	// failures counts the consecutive failed repairs per segment path.
+	mu       sync.Mutex
+	failures map[storj.Path]int
 }
 
 // NewService creates repairing service
-func NewService(log *zap.Logger, queue queue.RepairQueue, config *Config, interval time.Duration, concurrency int, transport transport.Client, metainfo *metainfo.Service, orders *orders.Service, cache *overlay.Cache) *Service {
+func NewService(log *zap.Logger, queue queue.RepairQueue, irrdb irreparable.DB, config *Config, interval time.Duration, concurrency int, transport transport.Client, metainfo *metainfo.Service, orders *orders.Service, cache *overlay.Cache) *Service {
 	client := ecclient.NewClient(log.Named("ecclient"), transport, config.MaxBufferMem.Int())
-	repairer := NewSegmentRepairer(log.Named("repairer"), metainfo, orders, cache, client, config.Timeout, config.MaxExcessRateOptimalThreshold)
+	repairer := NewSegmentRepairer(log.Named("repairer"), metainfo, orders, cache, client, config.Timeout, config.DownloadTimeout, config.MaxExcessRateOptimalThreshold)
 
 	return &Service{
 		log:      log,
 		queue:    queue,
+		irrdb:    irrdb,
 		config:   config,
 		Limiter:  sync2.NewLimiter(concurrency),
 		Loop:     *sync2.NewCycle(interval),
 		repairer: repairer,
+		failures: make(map[storj.Path]int),
 	}
 }
 
 // Close closes resources
-func (service *Service) Close() error { return nil }
+func (service *Service) Close() error { return service.repairer.ec.Close() }
+
+// Pause stops the repairer from taking new segments from the repair queue
+// until Resume is called. Repairs that have already started are finished.
+func (service *Service) Pause() {
+	atomic.StoreInt32(&service.paused, 1)
+}
+
+// Resume lets the repairer take segments from the repair queue again after Pause.
+func (service *Service) Resume() {
+	atomic.StoreInt32(&service.paused, 0)
+}
+
+// Paused returns whether the repairer has been paused with Pause.
+func (service *Service) Paused() bool {
+	return atomic.LoadInt32(&service.paused) == 1
+}
 
 // Run runs the repairer service
 func (service *Service) Run(ctx context.Context) (err error) {
@@ -86,6 +118,10 @@ func (service *Service) Run(ctx context.Context) (err error) {
 func (service *Service) process(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 	for {
+		if service.Paused() {
+			return nil
+		}
+
 		seg, err := service.queue.Select(ctx)
 		service.log.Info("Retrieved segment from repair queue", zap.Binary("segment", seg.GetPath()))
 		if err != nil {
@@ -112,12 +148,38 @@ func (service *Service) worker(ctx context.Context, seg *pb.InjuredSegment) (err
 	service.log.Info("Limiter running repair on segment", zap.Binary("segment", seg.GetPath()))
 	// note that shouldDelete is used even in the case where err is not null
 	shouldDelete, err := service.repairer.Repair(ctx, string(seg.GetPath()))
+
+	// a repair that was canceled, e.g. when the repairer is stopped, didn't fail
+	attempts := 0
+	if !errs2.IsCanceled(err) && ctx.Err() == nil {
+		attempts = service.trackAttempt(string(seg.GetPath()), shouldDelete || err == nil)
+	}
+	if !shouldDelete && service.config.MaxRepairAttempts > 0 && attempts >= service.config.MaxRepairAttempts {
+		mon.Meter("repair_segments_quarantined").Mark(1)
+		service.log.Error("moving segment that failed too many repairs from the repair queue to the irreparable db",
+			zap.Error(err),
+			zap.Int("attempts", attempts),
+			zap.Binary("segment", seg.GetPath()),
+		)
+		quarantineErr := service.quarantine(ctx, seg, attempts)
+		if quarantineErr != nil {
+			return Error.New("repairing injured segment: %v", errs.Combine(err, quarantineErr))
+		}
+		service.trackAttempt(string(seg.GetPath()), true)
+		return Error.New("repairing injured segment: %v", err)
+	}
+
 	if shouldDelete {
 		if IrreparableError.Has(err) {
-			service.log.Error("deleting irreparable segment from the queue:",
-				zap.Error(service.queue.Delete(ctx, seg)),
+			mon.Meter("repair_segments_irreparable").Mark(1)
+			service.log.Error("moving irreparable segment from the repair queue to the irreparable db",
+				zap.Error(err),
 				zap.Binary("segment", seg.GetPath()),
 			)
+			irrErr := service.markIrreparable(ctx, seg)
+			if irrErr != nil {
+				err = errs.Combine(err, Error.New("adding irreparable segment to the irreparable db: %v", irrErr))
+			}
 		} else {
 			service.log.Info("deleting segment from repair queue", zap.Binary("segment", seg.GetPath()))
 		}
@@ -143,3 +205,64 @@ func (service *Service) worker(ctx context.Context, seg *pb.InjuredSegment) (err
 
 	return nil
 }
+
+// trackAttempt records the outcome of a repair of the segment at path and
+// returns the number of consecutive failed repairs of the segment.
+func (service *Service) trackAttempt(path storj.Path, done bool) int {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	if done {
+		delete(service.failures, path)
+		return 0
+	}
+	service.failures[path]++
+	return service.failures[path]
+}
+
+// quarantine moves a segment whose repair failed attempts times in a row
+// from the repair queue to the irreparable db, where the checker leaves it.
+func (service *Service) quarantine(ctx context.Context, seg *pb.InjuredSegment, attempts int) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pointer, err := service.repairer.metainfo.Get(ctx, string(seg.GetPath()))
+	if err != nil {
+		return err
+	}
+
+	err = service.irrdb.Quarantine(ctx, &pb.IrreparableSegment{
+		Path:               seg.GetPath(),
+		SegmentDetail:      pointer,
+		LostPieces:         int32(len(seg.GetLostPieces())),
+		LastRepairAttempt:  time.Now().Unix(),
+		RepairAttemptCount: int64(attempts),
+	})
+	if err != nil {
+		return err
+	}
+
+	return service.queue.Delete(ctx, seg)
+}
+
+// markIrreparable adds the segment to the irreparable db, or increments its
+// repair attempts when it's already there.
+func (service *Service) markIrreparable(ctx context.Context, seg *pb.InjuredSegment) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pointer, err := service.repairer.metainfo.Get(ctx, string(seg.GetPath()))
+	if err != nil {
+		return err
+	}
+	missingPieces, err := service.repairer.cache.GetMissingPieces(ctx, pointer.GetRemote().GetRemotePieces())
+	if err != nil {
+		return err
+	}
+
+	return service.irrdb.IncrementRepairAttempts(ctx, &pb.IrreparableSegment{
+		Path:               seg.GetPath(),
+		SegmentDetail:      pointer,
+		LostPieces:         int32(len(missingPieces)),
+		LastRepairAttempt:  time.Now().Unix(),
+		RepairAttemptCount: int64(1),
+	})
+}
@@ -0,0 +1,155 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repairer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/repair/irreparable"
+	"storj.io/storj/satellite/repair/queue"
+	"storj.io/storj/storage/teststore"
+)
+
+// failingOverlay fails every lookup of unreliable nodes, so that every repair fails
+type failingOverlay struct {
+	overlay.DB
+}
+
+func (failingOverlay) KnownUnreliableOrOffline(context.Context, *overlay.NodeCriteria, storj.NodeIDList) (storj.NodeIDList, error) {
+	return nil, errors.New("overlay unavailable")
+}
+
+// recordingQueue records the segments deleted from the repair queue
+type recordingQueue struct {
+	queue.RepairQueue
+	deleted [][]byte
+}
+
+func (q *recordingQueue) Delete(ctx context.Context, seg *pb.InjuredSegment) error {
+	q.deleted = append(q.deleted, seg.GetPath())
+	return nil
+}
+
+// recordingIrreparable records the segments quarantined in the irreparable db
+type recordingIrreparable struct {
+	irreparable.DB
+	segments []*pb.IrreparableSegment
+}
+
+func (db *recordingIrreparable) Quarantine(ctx context.Context, segmentInfo *pb.IrreparableSegment) error {
+	db.segments = append(db.segments, segmentInfo)
+	return nil
+}
+
+func TestRepairAttemptBudget(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	const maxAttempts = 3
+
+	log := zaptest.NewLogger(t)
+	metainfoService := metainfo.NewService(log, teststore.New(), nil)
+	cache := overlay.NewCache(log, failingOverlay{}, overlay.Config{})
+	repairQueue := &recordingQueue{}
+	irrdb := &recordingIrreparable{}
+
+	service := NewService(log, repairQueue, irrdb, &Config{MaxRepairAttempts: maxAttempts}, time.Hour, 1, nil, metainfoService, nil, cache)
+
+	path := storj.JoinPaths(testrand.UUID().String(), "s0", "bucket", "object")
+	err := metainfoService.Put(ctx, path, &pb.Pointer{
+		Type: pb.Pointer_REMOTE,
+		Remote: &pb.RemoteSegment{
+			RootPieceId: testrand.PieceID(),
+			Redundancy: &pb.RedundancyScheme{
+				Type:             pb.RedundancyScheme_RS,
+				MinReq:           1,
+				RepairThreshold:  2,
+				SuccessThreshold: 3,
+				Total:            4,
+				ErasureShareSize: 256,
+			},
+			RemotePieces: []*pb.RemotePiece{
+				{PieceNum: 0, NodeId: testrand.NodeID()},
+				{PieceNum: 1, NodeId: testrand.NodeID()},
+			},
+		},
+		SegmentSize: 256,
+	})
+	require.NoError(t, err)
+
+	seg := &pb.InjuredSegment{Path: []byte(path), LostPieces: []int32{2, 3}}
+
+	// the segment stays queued until the budget is exhausted
+	for i := 1; i < maxAttempts; i++ {
+		err := service.worker(ctx, seg)
+		require.Error(t, err)
+		assert.Empty(t, repairQueue.deleted)
+		assert.Empty(t, irrdb.segments)
+	}
+
+	err = service.worker(ctx, seg)
+	require.Error(t, err)
+	require.Len(t, repairQueue.deleted, 1)
+	assert.Equal(t, []byte(path), repairQueue.deleted[0])
+	require.Len(t, irrdb.segments, 1)
+	assert.Equal(t, []byte(path), irrdb.segments[0].Path)
+	assert.EqualValues(t, maxAttempts, irrdb.segments[0].RepairAttemptCount)
+	assert.EqualValues(t, 2, irrdb.segments[0].LostPieces)
+
+	// the failures are counted again from the start afterwards
+	assert.Empty(t, service.failures)
+
+	// canceled repairs aren't failures
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	for i := 0; i < maxAttempts; i++ {
+		_ = service.worker(canceledCtx, seg)
+	}
+	assert.Empty(t, service.failures)
+	assert.Len(t, irrdb.segments, 1)
+}
+
+func TestPauseBeforeRun(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	log := zaptest.NewLogger(t)
+	metainfoService := metainfo.NewService(log, teststore.New(), nil)
+	cache := overlay.NewCache(log, failingOverlay{}, overlay.Config{})
+
+	// the queue panics when a segment is selected
+	service := NewService(log, &recordingQueue{}, &recordingIrreparable{}, &Config{}, time.Hour, 1, nil, metainfoService, nil, cache)
+
+	// doesn't wait for the service to run
+	service.Pause()
+	require.True(t, service.Paused())
+
+	runCtx, cancel := context.WithCancel(ctx)
+	var group errgroup.Group
+	group.Go(func() error {
+		return service.Run(runCtx)
+	})
+
+	// the first iteration of the loop doesn't take segments from the queue
+	service.Loop.TriggerWait()
+	cancel()
+	require.Equal(t, context.Canceled, group.Wait())
+
+	service.Resume()
+	require.False(t, service.Paused())
+}
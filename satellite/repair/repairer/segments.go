@@ -5,7 +5,9 @@ package repairer
 
 import (
 	"context"
+	"io"
 	"math"
+	"sync/atomic"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -23,6 +25,9 @@ import (
 // IrreparableError is the errs class of irreparable segment errors
 var IrreparableError = errs.Class("irreparable error")
 
+// DownloadTimeoutError is the errs class of repairs aborted because downloading the healthy pieces took too long
+var DownloadTimeoutError = errs.Class("repair download timeout")
+
 // SegmentRepairer for segments
 type SegmentRepairer struct {
 	log      *zap.Logger
@@ -32,6 +37,11 @@ type SegmentRepairer struct {
 	ec       ecclient.Client
 	timeout  time.Duration
 
+	// downloadTimeout limits how long is spent waiting for the healthy pieces.
+	// As the pieces are streamed to the new nodes, the time the upload takes
+	// in between isn't counted. A non-positive downloadTimeout means no limit.
+	downloadTimeout time.Duration
+
 	// multiplierOptimalThreshold is the value that multiplied by the optimal
 	// threshold results in the maximum limit of number of nodes to upload
 	// repaired pieces
@@ -43,9 +53,13 @@ type SegmentRepairer struct {
 // excessPercentageOptimalThreshold is the percentage to apply over the optimal
 // threshould to determine the maximum limit of nodes to upload repaired pieces,
 // when negative, 0 is applied.
+//
+// downloadTimeout limits the download of the healthy pieces, while timeout
+// limits the upload of the repaired pieces. A non-positive downloadTimeout
+// means the download isn't limited.
 func NewSegmentRepairer(
 	log *zap.Logger, metainfo *metainfo.Service, orders *orders.Service,
-	cache *overlay.Cache, ec ecclient.Client, timeout time.Duration,
+	cache *overlay.Cache, ec ecclient.Client, timeout, downloadTimeout time.Duration,
 	excessOptimalThreshold float64,
 ) *SegmentRepairer {
 
@@ -60,6 +74,7 @@ func NewSegmentRepairer(
 		cache:                      cache,
 		ec:                         ec.WithForceErrorDetection(true),
 		timeout:                    timeout,
+		downloadTimeout:            downloadTimeout,
 		multiplierOptimalThreshold: 1 + excessOptimalThreshold,
 	}
 }
@@ -157,35 +172,46 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, path storj.Path) (s
 		FreeBandwidth:  pieceSize,
 		FreeDisk:       pieceSize,
 		ExcludedNodes:  excludeNodeIDs,
+		Reserve:        true,
 	}
 	newNodes, err := repairer.cache.FindStorageNodes(ctx, request)
 	if err != nil {
 		return false, Error.Wrap(err)
 	}
+	defer func() {
+		for _, node := range newNodes {
+			repairer.cache.ReleaseCapacity(ctx, node.Id, pieceSize)
+		}
+	}()
 
 	// Create the order limits for the PUT_REPAIR action
-	putLimits, putPrivateKey, err := repairer.orders.CreatePutRepairOrderLimits(ctx, bucketID, pointer, getOrderLimits, newNodes)
+	putLimits, putPrivateKey, err := repairer.orders.CreatePutRepairOrderLimits(ctx, bucketID, pointer, getOrderLimits, missingPieces, newNodes)
 	if err != nil {
 		return false, Error.Wrap(err)
 	}
 
 	// Download the segment using just the healthy pieces
-	rr, err := repairer.ec.Get(ctx, getOrderLimits, getPrivateKey, redundancy, pointer.GetSegmentSize())
+	downloadCtx, cancelDownload := context.WithCancel(ctx)
+	defer cancelDownload()
+	fetch := newFetchTimer(repairer.downloadTimeout, cancelDownload)
+
+	rr, err := repairer.ec.Get(downloadCtx, getOrderLimits, getPrivateKey, redundancy, pointer.GetSegmentSize())
 	if err != nil {
 		// .Get() seems to only fail from input validation, so it would keep failing
 		return true, Error.Wrap(err)
 	}
 
-	r, err := rr.Range(ctx, 0, rr.Size())
+	var r io.ReadCloser
+	fetch.wait(func() { r, err = rr.Range(downloadCtx, 0, rr.Size()) })
 	if err != nil {
-		return false, Error.Wrap(err)
+		return false, Error.Wrap(repairer.downloadError(fetch, err))
 	}
 	defer func() { err = errs.Combine(err, r.Close()) }()
 
 	// Upload the repaired pieces
-	successfulNodes, hashes, err := repairer.ec.Repair(ctx, putLimits, putPrivateKey, redundancy, r, expiration, repairer.timeout, path)
+	successfulNodes, hashes, err := repairer.ec.Repair(ctx, putLimits, putPrivateKey, redundancy, &fetchReader{reader: r, fetch: fetch}, expiration, repairer.timeout, path)
 	if err != nil {
-		return false, Error.Wrap(err)
+		return false, Error.Wrap(repairer.downloadError(fetch, err))
 	}
 
 	// Add the successfully uploaded pieces to repairedPieces
@@ -256,3 +282,63 @@ func createBucketID(path storj.Path) ([]byte, error) {
 	}
 	return []byte(storj.JoinPaths(comps[0], comps[2])), nil
 }
+
+// downloadError marks err as a DownloadTimeoutError when the download of the
+// healthy pieces ran out of time, so that it isn't mistaken for an upload failure.
+func (repairer *SegmentRepairer) downloadError(fetch *fetchTimer, err error) error {
+	if !fetch.expired() {
+		return err
+	}
+	return DownloadTimeoutError.New("downloading healthy pieces took longer than %s: %v", repairer.downloadTimeout, err)
+}
+
+// fetchTimer cancels the download of the healthy pieces once more than
+// timeout was spent waiting for them.
+type fetchTimer struct {
+	limited   bool
+	remaining time.Duration
+	cancel    func()
+	timedOut  int32
+}
+
+// newFetchTimer returns a fetchTimer calling cancel after timeout, a non-positive timeout means no limit.
+func newFetchTimer(timeout time.Duration, cancel func()) *fetchTimer {
+	return &fetchTimer{
+		limited:   timeout > 0,
+		remaining: timeout,
+		cancel:    cancel,
+	}
+}
+
+// wait calls fetch, counting the time it takes against the remaining time.
+func (timer *fetchTimer) wait(fetch func()) {
+	if !timer.limited {
+		fetch()
+		return
+	}
+
+	start := time.Now()
+	deadline := time.AfterFunc(timer.remaining, func() {
+		atomic.StoreInt32(&timer.timedOut, 1)
+		timer.cancel()
+	})
+	fetch()
+	deadline.Stop()
+	timer.remaining -= time.Since(start)
+}
+
+// expired returns whether the download was canceled for running out of time.
+func (timer *fetchTimer) expired() bool {
+	return atomic.LoadInt32(&timer.timedOut) != 0
+}
+
+// fetchReader counts the time spent reading the healthy pieces against the fetchTimer.
+type fetchReader struct {
+	reader io.Reader
+	fetch  *fetchTimer
+}
+
+func (r *fetchReader) Read(p []byte) (n int, err error) {
+	r.fetch.wait(func() { n, err = r.reader.Read(p) })
+	return n, err
+}
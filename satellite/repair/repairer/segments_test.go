@@ -0,0 +1,45 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repairer
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchTimer(t *testing.T) {
+	t.Run("non-positive timeout", func(t *testing.T) {
+		for _, timeout := range []time.Duration{0, -time.Second} {
+			canceled := false
+			fetch := newFetchTimer(timeout, func() { canceled = true })
+
+			fetch.wait(func() { time.Sleep(10 * time.Millisecond) })
+			assert.False(t, canceled, timeout)
+			assert.False(t, fetch.expired(), timeout)
+		}
+	})
+
+	t.Run("only waiting counts", func(t *testing.T) {
+		canceled := make(chan struct{})
+		fetch := newFetchTimer(50*time.Millisecond, func() { close(canceled) })
+
+		// the time between fetches, when the repaired pieces are uploaded, doesn't count
+		reader := &fetchReader{reader: bytes.NewReader(make([]byte, 5)), fetch: fetch}
+		for i := 0; i < 5; i++ {
+			n, err := reader.Read(make([]byte, 1))
+			require.NoError(t, err)
+			require.Equal(t, 1, n)
+			time.Sleep(20 * time.Millisecond)
+		}
+		assert.False(t, fetch.expired())
+
+		// the waits add up
+		fetch.wait(func() { <-canceled })
+		assert.True(t, fetch.expired())
+	})
+}
@@ -5,6 +5,7 @@ package satellitedb
 
 import (
 	"context"
+	"time"
 
 	"github.com/skyrings/skyring-common/tools/uuid"
 	"github.com/zeebo/errs"
@@ -112,6 +113,20 @@ func (keys *apikeys) Update(ctx context.Context, key console.APIKeyInfo) (err er
 	return err
 }
 
+// UpdateLastUsed implements satellite.APIKeys
+func (keys *apikeys) UpdateLastUsed(ctx context.Context, id uuid.UUID, lastUsed time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	_, err = keys.db.Update_ApiKey_By_Id(
+		ctx,
+		dbx.ApiKey_Id(id[:]),
+		dbx.ApiKey_Update_Fields{
+			LastUsed: dbx.ApiKey_LastUsed(lastUsed),
+		},
+	)
+
+	return err
+}
+
 // Delete implements satellite.APIKeys
 func (keys *apikeys) Delete(ctx context.Context, id uuid.UUID) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -140,6 +155,10 @@ func fromDBXAPIKey(ctx context.Context, key *dbx.ApiKey) (_ *console.APIKeyInfo,
 		Secret:    key.Secret,
 	}
 
+	if key.LastUsed != nil {
+		result.LastUsed = *key.LastUsed
+	}
+
 	if key.PartnerId != nil {
 		result.PartnerID, err = bytesToUUID(key.PartnerId)
 		if err != nil {
@@ -81,6 +81,11 @@ func (db *ConsoleDB) ProjectInvoiceStamps() console.ProjectInvoiceStamps {
 	return &projectinvoicestamps{db.methods}
 }
 
+// ProjectActivities is a getter for console.ProjectActivities repository
+func (db *ConsoleDB) ProjectActivities() console.ProjectActivities {
+	return &projectActivities{db.db, db.tx}
+}
+
 // BeginTx is a method for opening transaction
 func (db *ConsoleDB) BeginTx(ctx context.Context) (console.DBTx, error) {
 	if db.db == nil {
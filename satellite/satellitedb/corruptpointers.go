@@ -0,0 +1,131 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/satellite/repair/corrupt"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+type corruptPointers struct {
+	db *dbx.DB
+}
+
+// Insert records a corrupt pointer, or updates the reason and detection time if it is already recorded
+func (db *corruptPointers) Insert(ctx context.Context, path []byte, pointer *pb.Pointer, reason string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bytes, err := proto.Marshal(pointer)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	tx, err := db.db.Open(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	detected := time.Now().UTC()
+
+	_, err = tx.Get_CorruptPointer_By_Path(ctx, dbx.CorruptPointer_Path(path))
+	switch err {
+	case sql.ErrNoRows:
+		_, err = tx.Create_CorruptPointer(ctx,
+			dbx.CorruptPointer_Path(path),
+			dbx.CorruptPointer_Pointer(bytes),
+			dbx.CorruptPointer_Reason(reason),
+			dbx.CorruptPointer_Detected(detected),
+		)
+		if err != nil {
+			return Error.Wrap(errs.Combine(err, tx.Rollback()))
+		}
+	case nil:
+		_, err = tx.Update_CorruptPointer_By_Path(ctx,
+			dbx.CorruptPointer_Path(path),
+			dbx.CorruptPointer_Update_Fields{
+				Pointer:  dbx.CorruptPointer_Pointer(bytes),
+				Reason:   dbx.CorruptPointer_Reason(reason),
+				Detected: dbx.CorruptPointer_Detected(detected),
+			},
+		)
+		if err != nil {
+			return Error.Wrap(errs.Combine(err, tx.Rollback()))
+		}
+	default:
+		return Error.Wrap(errs.Combine(err, tx.Rollback()))
+	}
+
+	return Error.Wrap(tx.Commit())
+}
+
+// Get returns the corrupt pointer recorded for path
+func (db *corruptPointers) Get(ctx context.Context, path []byte) (_ *corrupt.Pointer, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxPointer, err := db.db.Get_CorruptPointer_By_Path(ctx, dbx.CorruptPointer_Path(path))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, corrupt.ErrPointerNotFound.New("%s", path)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	return convertDBCorruptPointer(dbxPointer)
+}
+
+// GetLimited returns a list of corrupt pointers starting after lastSeenPath
+func (db *corruptPointers) GetLimited(ctx context.Context, limit int, lastSeenPath []byte) (_ []*corrupt.Pointer, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the offset is always 0, as lastSeenPath marks where the listing continues
+	const offset = 0
+	rows, err := db.db.Limited_CorruptPointer_By_Path_Greater_OrderBy_Asc_Path(ctx,
+		dbx.CorruptPointer_Path(lastSeenPath),
+		limit, offset,
+	)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var pointers []*corrupt.Pointer
+	for _, row := range rows {
+		pointer, err := convertDBCorruptPointer(row)
+		if err != nil {
+			return nil, err
+		}
+		pointers = append(pointers, pointer)
+	}
+	return pointers, nil
+}
+
+// Delete removes the corrupt pointer recorded for path
+func (db *corruptPointers) Delete(ctx context.Context, path []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.Delete_CorruptPointer_By_Path(ctx, dbx.CorruptPointer_Path(path))
+	return Error.Wrap(err)
+}
+
+// convertDBCorruptPointer converts a corrupt_pointers row
+func convertDBCorruptPointer(row *dbx.CorruptPointer) (*corrupt.Pointer, error) {
+	pointer := &pb.Pointer{}
+	if err := proto.Unmarshal(row.Pointer, pointer); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &corrupt.Pointer{
+		Path:     row.Path,
+		Pointer:  pointer,
+		Reason:   row.Reason,
+		Detected: row.Detected,
+	}, nil
+}
@@ -17,6 +17,7 @@ import (
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/repair/corrupt"
 	"storj.io/storj/satellite/repair/irreparable"
 	"storj.io/storj/satellite/repair/queue"
 	"storj.io/storj/satellite/rewards"
@@ -134,6 +135,11 @@ func (db *DB) Irreparable() irreparable.DB {
 	return &irreparableDB{db: db.db}
 }
 
+// CorruptPointers returns database for storing pointers the checker found to be corrupt
+func (db *DB) CorruptPointers() corrupt.DB {
+	return &corruptPointers{db: db.db}
+}
+
 // Console returns database for storing users, projects and api keys
 func (db *DB) Console() console.DB {
 	return &ConsoleDB{
@@ -61,6 +61,13 @@ CREATE TABLE certRecords (
 	update_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( publickey )
 );
+CREATE TABLE corrupt_pointers (
+	path bytea NOT NULL,
+	pointer bytea NOT NULL,
+	reason text NOT NULL,
+	detected timestamp NOT NULL,
+	PRIMARY KEY ( path )
+);
 CREATE TABLE injuredsegments (
 	path bytea NOT NULL,
 	data bytea NOT NULL,
@@ -73,6 +80,7 @@ CREATE TABLE irreparabledbs (
 	pieces_lost_count bigint NOT NULL,
 	seg_damaged_unix_sec bigint NOT NULL,
 	repair_attempt_count bigint NOT NULL,
+	quarantined boolean NOT NULL,
 	PRIMARY KEY ( segmentpath )
 );
 CREATE TABLE nodes (
@@ -106,6 +114,7 @@ CREATE TABLE nodes (
 	audit_reputation_beta double precision NOT NULL,
 	uptime_reputation_alpha double precision NOT NULL,
 	uptime_reputation_beta double precision NOT NULL,
+	audit_failure_streak bigint NOT NULL,
 	PRIMARY KEY ( id )
 );
 CREATE TABLE offers (
@@ -206,6 +215,7 @@ CREATE TABLE api_keys (
 	secret bytea NOT NULL,
 	partner_id bytea,
 	created_at timestamp with time zone NOT NULL,
+	last_used timestamp with time zone,
 	PRIMARY KEY ( id ),
 	UNIQUE ( head ),
 	UNIQUE ( name, project_id )
@@ -229,6 +239,15 @@ CREATE TABLE bucket_metainfos (
 	PRIMARY KEY ( id ),
 	UNIQUE ( name, project_id )
 );
+CREATE TABLE project_activities (
+	id bigserial NOT NULL,
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	actor_id bytea NOT NULL,
+	type text NOT NULL,
+	target text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE project_invoice_stamps (
 	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
 	invoice_id bytea NOT NULL,
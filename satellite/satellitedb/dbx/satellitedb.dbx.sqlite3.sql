@@ -61,6 +61,13 @@ CREATE TABLE certRecords (
 	update_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( publickey )
 );
+CREATE TABLE corrupt_pointers (
+	path BLOB NOT NULL,
+	pointer BLOB NOT NULL,
+	reason TEXT NOT NULL,
+	detected TIMESTAMP NOT NULL,
+	PRIMARY KEY ( path )
+);
 CREATE TABLE injuredsegments (
 	path BLOB NOT NULL,
 	data BLOB NOT NULL,
@@ -73,6 +80,7 @@ CREATE TABLE irreparabledbs (
 	pieces_lost_count INTEGER NOT NULL,
 	seg_damaged_unix_sec INTEGER NOT NULL,
 	repair_attempt_count INTEGER NOT NULL,
+	quarantined INTEGER NOT NULL,
 	PRIMARY KEY ( segmentpath )
 );
 CREATE TABLE nodes (
@@ -106,6 +114,7 @@ CREATE TABLE nodes (
 	audit_reputation_beta REAL NOT NULL,
 	uptime_reputation_alpha REAL NOT NULL,
 	uptime_reputation_beta REAL NOT NULL,
+	audit_failure_streak INTEGER NOT NULL,
 	PRIMARY KEY ( id )
 );
 CREATE TABLE offers (
@@ -206,6 +215,7 @@ CREATE TABLE api_keys (
 	secret BLOB NOT NULL,
 	partner_id BLOB,
 	created_at TIMESTAMP NOT NULL,
+	last_used TIMESTAMP,
 	PRIMARY KEY ( id ),
 	UNIQUE ( head ),
 	UNIQUE ( name, project_id )
@@ -229,6 +239,15 @@ CREATE TABLE bucket_metainfos (
 	PRIMARY KEY ( id ),
 	UNIQUE ( name, project_id )
 );
+CREATE TABLE project_activities (
+	id INTEGER NOT NULL,
+	project_id BLOB NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	actor_id BLOB NOT NULL,
+	type TEXT NOT NULL,
+	target TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE project_invoice_stamps (
 	project_id BLOB NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
 	invoice_id BLOB NOT NULL,
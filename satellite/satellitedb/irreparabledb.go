@@ -5,6 +5,7 @@ package satellitedb
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/zeebo/errs"
@@ -40,6 +41,7 @@ func (db *irreparableDB) IncrementRepairAttempts(ctx context.Context, segmentInf
 			dbx.Irreparabledb_PiecesLostCount(int64(segmentInfo.LostPieces)),
 			dbx.Irreparabledb_SegDamagedUnixSec(segmentInfo.LastRepairAttempt),
 			dbx.Irreparabledb_RepairAttemptCount(segmentInfo.RepairAttemptCount),
+			dbx.Irreparabledb_Quarantined(false),
 		)
 		if err != nil {
 			return Error.Wrap(errs.Combine(err, tx.Rollback()))
@@ -63,6 +65,69 @@ func (db *irreparableDB) IncrementRepairAttempts(ctx context.Context, segmentInf
 	return Error.Wrap(tx.Commit())
 }
 
+// Quarantine adds a segment whose repair failed too many times, or marks the entry when it already exists
+func (db *irreparableDB) Quarantine(ctx context.Context, segmentInfo *pb.IrreparableSegment) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	bytes, err := proto.Marshal(segmentInfo.SegmentDetail)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	tx, err := db.db.Open(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	dbxInfo, err := tx.Get_Irreparabledb_By_Segmentpath(ctx, dbx.Irreparabledb_Segmentpath(segmentInfo.Path))
+	switch err {
+	case sql.ErrNoRows:
+		_, err = tx.Create_Irreparabledb(
+			ctx,
+			dbx.Irreparabledb_Segmentpath(segmentInfo.Path),
+			dbx.Irreparabledb_Segmentdetail(bytes),
+			dbx.Irreparabledb_PiecesLostCount(int64(segmentInfo.LostPieces)),
+			dbx.Irreparabledb_SegDamagedUnixSec(segmentInfo.LastRepairAttempt),
+			dbx.Irreparabledb_RepairAttemptCount(segmentInfo.RepairAttemptCount),
+			dbx.Irreparabledb_Quarantined(true),
+		)
+		if err != nil {
+			return Error.Wrap(errs.Combine(err, tx.Rollback()))
+		}
+	case nil:
+		updateFields := dbx.Irreparabledb_Update_Fields{}
+		updateFields.Segmentdetail = dbx.Irreparabledb_Segmentdetail(bytes)
+		updateFields.PiecesLostCount = dbx.Irreparabledb_PiecesLostCount(int64(segmentInfo.LostPieces))
+		updateFields.SegDamagedUnixSec = dbx.Irreparabledb_SegDamagedUnixSec(segmentInfo.LastRepairAttempt)
+		updateFields.RepairAttemptCount = dbx.Irreparabledb_RepairAttemptCount(dbxInfo.RepairAttemptCount + segmentInfo.RepairAttemptCount)
+		updateFields.Quarantined = dbx.Irreparabledb_Quarantined(true)
+		_, err = tx.Update_Irreparabledb_By_Segmentpath(
+			ctx,
+			dbx.Irreparabledb_Segmentpath(dbxInfo.Segmentpath),
+			updateFields,
+		)
+		if err != nil {
+			return Error.Wrap(errs.Combine(err, tx.Rollback()))
+		}
+	default:
+		return Error.Wrap(errs.Combine(err, tx.Rollback()))
+	}
+
+	return Error.Wrap(tx.Commit())
+}
+
+// IsQuarantined returns whether the segment was quarantined after failing too many repairs
+func (db *irreparableDB) IsQuarantined(ctx context.Context, segmentPath []byte) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	dbxInfo, err := db.db.Get_Irreparabledb_By_Segmentpath(ctx, dbx.Irreparabledb_Segmentpath(segmentPath))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, Error.Wrap(err)
+	}
+	return dbxInfo.Quarantined, nil
+}
+
 // Get a irreparable's segment info from the db
 func (db *irreparableDB) Get(ctx context.Context, segmentPath []byte) (resp *pb.IrreparableSegment, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -102,6 +167,26 @@ func (db *irreparableDB) GetLimited(ctx context.Context, limit int, lastSeenSegm
 		return nil, err
 	}
 
+	return convertDBIrreparable(rows)
+}
+
+// GetLimitedUnquarantined returns a list of irreparable segment info like GetLimited, skipping the quarantined segments
+func (db *irreparableDB) GetLimitedUnquarantined(ctx context.Context, limit int, lastSeenSegmentPath []byte) (resp []*pb.IrreparableSegment, err error) {
+	defer mon.Task()(&ctx)(&err)
+	const offset = 0
+	rows, err := db.db.Limited_Irreparabledb_By_Segmentpath_Greater_And_Quarantined_Equal_False_OrderBy_Asc_Segmentpath(ctx,
+		dbx.Irreparabledb_Segmentpath(lastSeenSegmentPath),
+		limit, offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertDBIrreparable(rows)
+}
+
+// convertDBIrreparable converts irreparabledb rows to irreparable segment info
+func convertDBIrreparable(rows []*dbx.Irreparabledb) (resp []*pb.IrreparableSegment, err error) {
 	for _, row := range rows {
 		p := &pb.Pointer{}
 		err = proto.Unmarshal(row.Segmentdetail, p)
@@ -117,7 +202,7 @@ func (db *irreparableDB) GetLimited(ctx context.Context, limit int, lastSeenSegm
 		}
 		resp = append(resp, segment)
 	}
-	return resp, err
+	return resp, nil
 }
 
 // Delete a irreparable's segment info from the db
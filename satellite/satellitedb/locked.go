@@ -8,6 +8,7 @@ package satellitedb
 import (
 	"context"
 	"crypto"
+	"io"
 	"sync"
 	"time"
 
@@ -26,6 +27,7 @@ import (
 	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/repair/corrupt"
 	"storj.io/storj/satellite/repair/irreparable"
 	"storj.io/storj/satellite/repair/queue"
 	"storj.io/storj/satellite/rewards"
@@ -233,6 +235,13 @@ func (m *lockedAPIKeys) Update(ctx context.Context, key console.APIKeyInfo) erro
 	return m.db.Update(ctx, key)
 }
 
+// UpdateLastUsed sets the time APIKeyInfo with given ID was last used for authorization
+func (m *lockedAPIKeys) UpdateLastUsed(ctx context.Context, id uuid.UUID, lastUsed time.Time) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.UpdateLastUsed(ctx, id, lastUsed)
+}
+
 // BucketUsage is a getter for accounting.BucketUsage repository
 func (m *lockedConsole) BucketUsage() accounting.BucketUsage {
 	m.Lock()
@@ -270,6 +279,33 @@ func (m *lockedBucketUsage) GetPaged(ctx context.Context, cursor *accounting.Buc
 	return m.db.GetPaged(ctx, cursor)
 }
 
+// ProjectActivities is a getter for ProjectActivities repository
+func (m *lockedConsole) ProjectActivities() console.ProjectActivities {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedProjectActivities{m.Locker, m.db.ProjectActivities()}
+}
+
+// lockedProjectActivities implements locking wrapper for console.ProjectActivities
+type lockedProjectActivities struct {
+	sync.Locker
+	db console.ProjectActivities
+}
+
+// GetByProjectID returns up to limit of the most recent activity events of a project, the most recent first
+func (m *lockedProjectActivities) GetByProjectID(ctx context.Context, projectID uuid.UUID, limit int) ([]console.ProjectActivity, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetByProjectID(ctx, projectID, limit)
+}
+
+// Insert records an activity event of a project
+func (m *lockedProjectActivities) Insert(ctx context.Context, activity console.ProjectActivity) (*console.ProjectActivity, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Insert(ctx, activity)
+}
+
 // ProjectInvoiceStamps is a getter for ProjectInvoiceStamps repository
 func (m *lockedConsole) ProjectInvoiceStamps() console.ProjectInvoiceStamps {
 	m.Lock()
@@ -335,6 +371,13 @@ func (m *lockedProjectMembers) GetByProjectID(ctx context.Context, projectID uui
 	return m.db.GetByProjectID(ctx, projectID, pagination)
 }
 
+// GetPagedByProjectID is a method for querying a page of project members from the database by projectID and cursor.
+func (m *lockedProjectMembers) GetPagedByProjectID(ctx context.Context, projectID uuid.UUID, cursor console.ProjectMembersCursor) (*console.ProjectMembersPage, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetPagedByProjectID(ctx, projectID, cursor)
+}
+
 // Insert is a method for inserting project member into the database.
 func (m *lockedProjectMembers) Insert(ctx context.Context, memberID uuid.UUID, projectID uuid.UUID) (*console.ProjectMember, error) {
 	m.Lock()
@@ -591,6 +634,18 @@ func (m *lockedUserCredits) Create(ctx context.Context, userCredit console.UserC
 	return m.db.Create(ctx, userCredit)
 }
 
+func (m *lockedUserCredits) CreateBatch(ctx context.Context, userCredits []console.UserCredit) (skipped []console.UserCredit, err error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.CreateBatch(ctx, userCredits)
+}
+
+func (m *lockedUserCredits) GetByUserID(ctx context.Context, userID uuid.UUID) ([]console.UserCredit, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetByUserID(ctx, userID)
+}
+
 func (m *lockedUserCredits) GetCreditUsage(ctx context.Context, userID uuid.UUID, expirationEndDate time.Time) (*console.UserCreditUsage, error) {
 	m.Lock()
 	defer m.Unlock()
@@ -668,6 +723,13 @@ func (m *lockedUsers) GetByEmail(ctx context.Context, email string) (*console.Us
 	return m.db.GetByEmail(ctx, email)
 }
 
+// GetInactiveByEmail is a method for querying the latest registered inactive user by email from the database.
+func (m *lockedUsers) GetInactiveByEmail(ctx context.Context, email string) (*console.User, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetInactiveByEmail(ctx, email)
+}
+
 // Insert is a method for inserting user into the database.
 func (m *lockedUsers) Insert(ctx context.Context, user *console.User) (*console.User, error) {
 	m.Lock()
@@ -713,6 +775,47 @@ func (m *lockedContainment) IncrementPending(ctx context.Context, pendingAudit *
 	return m.db.IncrementPending(ctx, pendingAudit)
 }
 
+// CorruptPointers returns database for pointers the checker found to be corrupt
+func (m *locked) CorruptPointers() corrupt.DB {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedCorruptPointers{m.Locker, m.db.CorruptPointers()}
+}
+
+// lockedCorruptPointers implements locking wrapper for corrupt.DB
+type lockedCorruptPointers struct {
+	sync.Locker
+	db corrupt.DB
+}
+
+// Delete removes the corrupt pointer recorded for path.
+func (m *lockedCorruptPointers) Delete(ctx context.Context, path []byte) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Delete(ctx, path)
+}
+
+// Get returns the corrupt pointer recorded for path.
+func (m *lockedCorruptPointers) Get(ctx context.Context, path []byte) (*corrupt.Pointer, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Get(ctx, path)
+}
+
+// GetLimited returns a list of corrupt pointers starting after lastSeenPath.
+func (m *lockedCorruptPointers) GetLimited(ctx context.Context, limit int, lastSeenPath []byte) ([]*corrupt.Pointer, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetLimited(ctx, limit, lastSeenPath)
+}
+
+// Insert records a corrupt pointer, or updates the reason and detection time if it is already recorded.
+func (m *lockedCorruptPointers) Insert(ctx context.Context, path []byte, pointer *pb.Pointer, reason string) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Insert(ctx, path, pointer, reason)
+}
+
 // CreateSchema sets the schema
 func (m *locked) CreateSchema(schema string) error {
 	m.Lock()
@@ -768,6 +871,13 @@ func (m *lockedIrreparable) GetLimited(ctx context.Context, limit int, lastSeenS
 	return m.db.GetLimited(ctx, limit, lastSeenSegmentPath)
 }
 
+// GetLimitedUnquarantined returns a list of irreparable segment info like GetLimited, skipping the quarantined segments.
+func (m *lockedIrreparable) GetLimitedUnquarantined(ctx context.Context, limit int, lastSeenSegmentPath []byte) ([]*pb.IrreparableSegment, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetLimitedUnquarantined(ctx, limit, lastSeenSegmentPath)
+}
+
 // IncrementRepairAttempts increments the repair attempts.
 func (m *lockedIrreparable) IncrementRepairAttempts(ctx context.Context, segmentInfo *pb.IrreparableSegment) error {
 	m.Lock()
@@ -775,6 +885,20 @@ func (m *lockedIrreparable) IncrementRepairAttempts(ctx context.Context, segment
 	return m.db.IncrementRepairAttempts(ctx, segmentInfo)
 }
 
+// IsQuarantined returns whether the segment was quarantined.
+func (m *lockedIrreparable) IsQuarantined(ctx context.Context, segmentPath []byte) (bool, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.IsQuarantined(ctx, segmentPath)
+}
+
+// Quarantine adds a segment whose repair failed too many times, so that the checker doesn't queue it for repair again.
+func (m *lockedIrreparable) Quarantine(ctx context.Context, segmentInfo *pb.IrreparableSegment) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Quarantine(ctx, segmentInfo)
+}
+
 // Orders returns database for orders
 func (m *locked) Orders() orders.DB {
 	m.Lock()
@@ -871,6 +995,13 @@ type lockedOverlayCache struct {
 	db overlay.DB
 }
 
+// BatchUpdateNodeInfo updates the node info of multiple storagenodes, batchSize nodes per transaction.
+func (m *lockedOverlayCache) BatchUpdateNodeInfo(ctx context.Context, updates []*overlay.NodeInfoUpdate, batchSize int) (failed storj.NodeIDList, err error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.BatchUpdateNodeInfo(ctx, updates, batchSize)
+}
+
 // BatchUpdateStats updates multiple storagenode's stats in one transaction
 func (m *lockedOverlayCache) BatchUpdateStats(ctx context.Context, updateRequests []*overlay.UpdateRequest, batchSize int) (failed storj.NodeIDList, err error) {
 	m.Lock()
@@ -878,6 +1009,13 @@ func (m *lockedOverlayCache) BatchUpdateStats(ctx context.Context, updateRequest
 	return m.db.BatchUpdateStats(ctx, updateRequests, batchSize)
 }
 
+// CountByVersion returns the number of online nodes per version string
+func (m *lockedOverlayCache) CountByVersion(ctx context.Context, criteria *overlay.NodeCriteria) (map[string]int, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.CountByVersion(ctx, criteria)
+}
+
 // Get looks up the node by nodeID
 func (m *lockedOverlayCache) Get(ctx context.Context, nodeID storj.NodeID) (*overlay.NodeDossier, error) {
 	m.Lock()
@@ -913,6 +1051,13 @@ func (m *lockedOverlayCache) Paginate(ctx context.Context, offset int64, limit i
 	return m.db.Paginate(ctx, offset, limit)
 }
 
+// NodesWithFailureStreakAtLeast returns the qualified nodes that failed at least n audits in a row.
+func (m *lockedOverlayCache) NodesWithFailureStreakAtLeast(ctx context.Context, n int64) (storj.NodeIDList, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.NodesWithFailureStreakAtLeast(ctx, n)
+}
+
 // PaginateQualified will page through the qualified nodes
 func (m *lockedOverlayCache) PaginateQualified(ctx context.Context, offset int64, limit int) ([]*pb.Node, bool, error) {
 	m.Lock()
@@ -920,6 +1065,27 @@ func (m *lockedOverlayCache) PaginateQualified(ctx context.Context, offset int64
 	return m.db.PaginateQualified(ctx, offset, limit)
 }
 
+// PruneStaleNodes marks the nodes that haven't been contacted successfully since olderThan as offline.
+func (m *lockedOverlayCache) PruneStaleNodes(ctx context.Context, olderThan time.Time) (count int64, err error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.PruneStaleNodes(ctx, olderThan)
+}
+
+// RecentlyDisqualified returns the nodes disqualified after since, ordered by the disqualification time.
+func (m *lockedOverlayCache) RecentlyDisqualified(ctx context.Context, since time.Time) ([]*overlay.NodeDossier, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.RecentlyDisqualified(ctx, since)
+}
+
+// RecomputeReputation recomputes the reputation of all qualified nodes with the parameters in config.
+func (m *lockedOverlayCache) RecomputeReputation(ctx context.Context, config overlay.NodeSelectionConfig) (err error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.RecomputeReputation(ctx, config)
+}
+
 // Reliable returns all nodes that are reliable
 func (m *lockedOverlayCache) Reliable(ctx context.Context, a1 *overlay.NodeCriteria) (storj.NodeIDList, error) {
 	m.Lock()
@@ -928,14 +1094,14 @@ func (m *lockedOverlayCache) Reliable(ctx context.Context, a1 *overlay.NodeCrite
 }
 
 // SelectNewStorageNodes looks up nodes based on new node criteria
-func (m *lockedOverlayCache) SelectNewStorageNodes(ctx context.Context, count int, criteria *overlay.NodeCriteria) ([]*pb.Node, error) {
+func (m *lockedOverlayCache) SelectNewStorageNodes(ctx context.Context, count int, criteria *overlay.NodeCriteria) ([]*overlay.NodeDossier, error) {
 	m.Lock()
 	defer m.Unlock()
 	return m.db.SelectNewStorageNodes(ctx, count, criteria)
 }
 
 // SelectStorageNodes looks up nodes based on criteria
-func (m *lockedOverlayCache) SelectStorageNodes(ctx context.Context, count int, criteria *overlay.NodeCriteria) ([]*pb.Node, error) {
+func (m *lockedOverlayCache) SelectStorageNodes(ctx context.Context, count int, criteria *overlay.NodeCriteria) ([]*overlay.NodeDossier, error) {
 	m.Lock()
 	defer m.Unlock()
 	return m.db.SelectStorageNodes(ctx, count, criteria)
@@ -969,6 +1135,13 @@ func (m *lockedOverlayCache) UpdateUptime(ctx context.Context, nodeID storj.Node
 	return m.db.UpdateUptime(ctx, nodeID, isUp, lambda, weight, uptimeDQ)
 }
 
+// WouldDisqualify returns the qualified nodes whose audit reputation is at or below auditDQ.
+func (m *lockedOverlayCache) WouldDisqualify(ctx context.Context, auditDQ float64) (storj.NodeIDList, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.WouldDisqualify(ctx, auditDQ)
+}
+
 // ProjectAccounting returns database for storing information about project data use
 func (m *locked) ProjectAccounting() accounting.ProjectAccounting {
 	m.Lock()
@@ -1143,6 +1316,13 @@ func (m *lockedStoragenodeAccounting) GetTalliesSince(ctx context.Context, lates
 	return m.db.GetTalliesSince(ctx, latestRollup)
 }
 
+// GetTalliesSinceForNode retrieves all tallies for a single node since latestRollup
+func (m *lockedStoragenodeAccounting) GetTalliesSinceForNode(ctx context.Context, nodeID storj.NodeID, latestRollup time.Time) ([]*accounting.StoragenodeStorageTally, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetTalliesSinceForNode(ctx, nodeID, latestRollup)
+}
+
 // LastTimestamp records and returns the latest last tallied time.
 func (m *lockedStoragenodeAccounting) LastTimestamp(ctx context.Context, timestampType string) (time.Time, error) {
 	m.Lock()
@@ -1157,6 +1337,13 @@ func (m *lockedStoragenodeAccounting) QueryNodeDailySpaceUsage(ctx context.Conte
 	return m.db.QueryNodeDailySpaceUsage(ctx, nodeID, start, end)
 }
 
+// QueryNodePaymentTotals returns the summed usage totals of nodeID for given period
+func (m *lockedStoragenodeAccounting) QueryNodePaymentTotals(ctx context.Context, nodeID storj.NodeID, start time.Time, end time.Time) (*accounting.NodePaymentTotals, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.QueryNodePaymentTotals(ctx, nodeID, start, end)
+}
+
 // QueryPaymentInfo queries Nodes and Accounting_Rollup on nodeID
 func (m *lockedStoragenodeAccounting) QueryPaymentInfo(ctx context.Context, start time.Time, end time.Time) ([]*accounting.CSVRow, error) {
 	m.Lock()
@@ -1177,3 +1364,10 @@ func (m *lockedStoragenodeAccounting) SaveTallies(ctx context.Context, latestTal
 	defer m.Unlock()
 	return m.db.SaveTallies(ctx, latestTally, nodeData)
 }
+
+// WritePaymentInfoCSV streams the rows of QueryPaymentInfo as CSV into w
+func (m *lockedStoragenodeAccounting) WritePaymentInfoCSV(ctx context.Context, w io.Writer, start time.Time, end time.Time) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.WritePaymentInfoCSV(ctx, w, start, end)
+}
@@ -1081,6 +1081,57 @@ func (db *DB) PostgresMigration() *migrate.Migration {
 					CREATE INDEX certrecord_id_update_at ON certRecords ( id, update_at );`,
 				},
 			},
+			{
+				Description: "Add corrupt_pointers table",
+				Version:     51,
+				Action: migrate.SQL{
+					`CREATE TABLE corrupt_pointers (
+						path bytea NOT NULL,
+						pointer bytea NOT NULL,
+						reason text NOT NULL,
+						detected timestamp NOT NULL,
+						PRIMARY KEY ( path )
+					);`,
+				},
+			},
+			{
+				Description: "Add last_used to api_keys",
+				Version:     52,
+				Action: migrate.SQL{
+					`ALTER TABLE api_keys ADD COLUMN last_used timestamp with time zone;`,
+				},
+			},
+			{
+				Description: "Add audit_failure_streak to nodes",
+				Version:     53,
+				Action: migrate.SQL{
+					`ALTER TABLE nodes ADD COLUMN audit_failure_streak bigint NOT NULL DEFAULT 0;`,
+					`ALTER TABLE nodes ALTER COLUMN audit_failure_streak DROP DEFAULT;`,
+				},
+			},
+			{
+				Description: "Add project_activities table",
+				Version:     54,
+				Action: migrate.SQL{
+					`CREATE TABLE project_activities (
+						id bigserial NOT NULL,
+						project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+						actor_id bytea NOT NULL,
+						type text NOT NULL,
+						target text NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( id )
+					);`,
+				},
+			},
+			{
+				Description: "Add quarantined to irreparabledbs",
+				Version:     55,
+				Action: migrate.SQL{
+					`ALTER TABLE irreparabledbs ADD COLUMN quarantined boolean NOT NULL DEFAULT false;`,
+					`ALTER TABLE irreparabledbs ALTER COLUMN quarantined DROP DEFAULT;`,
+				},
+			},
 		},
 	}
 }
@@ -8,6 +8,7 @@ import (
 	"database/sql"
 	"encoding/hex"
 	"fmt"
+	"math"
 	"strings"
 	"time"
 
@@ -34,7 +35,7 @@ type overlaycache struct {
 	db *dbx.DB
 }
 
-func (cache *overlaycache) SelectStorageNodes(ctx context.Context, count int, criteria *overlay.NodeCriteria) (nodes []*pb.Node, err error) {
+func (cache *overlaycache) SelectStorageNodes(ctx context.Context, count int, criteria *overlay.NodeCriteria) (nodes []*overlay.NodeDossier, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	nodeType := int(pb.NodeType_STORAGE)
@@ -46,11 +47,12 @@ func (cache *overlaycache) SelectStorageNodes(ctx context.Context, count int, cr
 		AND free_disk >= ?
 		AND total_audit_count >= ?
 		AND total_uptime_count >= ?
+		AND created_at <= ?
 		AND (last_contact_success > ?
 		     OR last_contact_success > last_contact_failure)`
-	args := append(make([]interface{}, 0, 13),
+	args := append(make([]interface{}, 0, 14),
 		nodeType, criteria.FreeBandwidth, criteria.FreeDisk, criteria.AuditCount,
-		criteria.UptimeCount, time.Now().Add(-criteria.OnlineWindow))
+		criteria.UptimeCount, time.Now().Add(-criteria.MinimumAge), time.Now().Add(-criteria.OnlineWindow))
 
 	if criteria.MinimumVersion != "" {
 		v, err := version.NewSemVer(criteria.MinimumVersion)
@@ -63,12 +65,17 @@ func (cache *overlaycache) SelectStorageNodes(ctx context.Context, count int, cr
 		args = append(args, v.Major, v.Major, v.Minor, v.Minor, v.Patch)
 	}
 
+	nodes, err = cache.queryPreferredNodes(ctx, count, criteria, safeQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+
 	if !criteria.DistinctIP {
-		nodes, err = cache.queryNodes(ctx, criteria.ExcludedNodes, count, safeQuery, args...)
+		moreNodes, err := cache.queryNodes(ctx, criteria.ExcludedNodes, count-len(nodes), safeQuery, args...)
 		if err != nil {
 			return nil, err
 		}
-		return nodes, nil
+		return append(nodes, moreNodes...), nil
 	}
 
 	// query for distinct IPs
@@ -90,7 +97,7 @@ func (cache *overlaycache) SelectStorageNodes(ctx context.Context, count int, cr
 	return nodes, nil
 }
 
-func (cache *overlaycache) SelectNewStorageNodes(ctx context.Context, count int, criteria *overlay.NodeCriteria) (nodes []*pb.Node, err error) {
+func (cache *overlaycache) SelectNewStorageNodes(ctx context.Context, count int, criteria *overlay.NodeCriteria) (nodes []*overlay.NodeDossier, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	nodeType := int(pb.NodeType_STORAGE)
@@ -100,11 +107,12 @@ func (cache *overlaycache) SelectNewStorageNodes(ctx context.Context, count int,
 		AND type = ?
 		AND free_bandwidth >= ?
 		AND free_disk >= ?
-		AND (total_audit_count < ? OR total_uptime_count < ?)
+		AND (total_audit_count < ? OR total_uptime_count < ? OR created_at > ?)
 		AND (last_contact_success > ?
 		     OR last_contact_success > last_contact_failure)`
-	args := append(make([]interface{}, 0, 10),
-		nodeType, criteria.FreeBandwidth, criteria.FreeDisk, criteria.AuditCount, criteria.UptimeCount, time.Now().Add(-criteria.OnlineWindow))
+	args := append(make([]interface{}, 0, 11),
+		nodeType, criteria.FreeBandwidth, criteria.FreeDisk, criteria.AuditCount, criteria.UptimeCount,
+		time.Now().Add(-criteria.MinimumAge), time.Now().Add(-criteria.OnlineWindow))
 
 	if criteria.MinimumVersion != "" {
 		v, err := version.NewSemVer(criteria.MinimumVersion)
@@ -117,12 +125,17 @@ func (cache *overlaycache) SelectNewStorageNodes(ctx context.Context, count int,
 		args = append(args, v.Major, v.Major, v.Minor, v.Minor, v.Patch)
 	}
 
+	nodes, err = cache.queryPreferredNodes(ctx, count, criteria, safeQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+
 	if !criteria.DistinctIP {
-		nodes, err = cache.queryNodes(ctx, criteria.ExcludedNodes, count, safeQuery, args...)
+		moreNodes, err := cache.queryNodes(ctx, criteria.ExcludedNodes, count-len(nodes), safeQuery, args...)
 		if err != nil {
 			return nil, err
 		}
-		return nodes, nil
+		return append(nodes, moreNodes...), nil
 	}
 
 	// query for distinct IPs
@@ -144,7 +157,41 @@ func (cache *overlaycache) SelectNewStorageNodes(ctx context.Context, count int,
 	return nodes, nil
 }
 
-func (cache *overlaycache) queryNodes(ctx context.Context, excludedNodes []storj.NodeID, count int, safeQuery string, args ...interface{}) (_ []*pb.Node, err error) {
+// queryPreferredNodes selects up to count of the preferred nodes in criteria
+// that match safeQuery. The selected nodes are added to the excluded nodes and
+// IPs of criteria, such that the remaining nodes can be selected as usual.
+func (cache *overlaycache) queryPreferredNodes(ctx context.Context, count int, criteria *overlay.NodeCriteria, safeQuery string, args ...interface{}) (nodes []*overlay.NodeDossier, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(criteria.PreferredNodes) == 0 {
+		return nil, nil
+	}
+
+	safePreferredQuery := safeQuery + `
+		AND id IN (?` + strings.Repeat(", ?", len(criteria.PreferredNodes)-1) + `)`
+	preferredArgs := append([]interface{}{}, args...)
+	for _, id := range criteria.PreferredNodes {
+		preferredArgs = append(preferredArgs, id.Bytes())
+	}
+
+	if criteria.DistinctIP {
+		nodes, err = cache.queryNodesDistinct(ctx, criteria.ExcludedNodes, criteria.ExcludedIPs, count, safePreferredQuery, criteria.DistinctIP, preferredArgs...)
+	} else {
+		nodes, err = cache.queryNodes(ctx, criteria.ExcludedNodes, count, safePreferredQuery, preferredArgs...)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, n := range nodes {
+		criteria.ExcludedNodes = append(criteria.ExcludedNodes, n.Id)
+		criteria.ExcludedIPs = append(criteria.ExcludedIPs, n.LastIp)
+	}
+
+	return nodes, nil
+}
+
+func (cache *overlaycache) queryNodes(ctx context.Context, excludedNodes []storj.NodeID, count int, safeQuery string, args ...interface{}) (_ []*overlay.NodeDossier, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	if count == 0 {
@@ -175,7 +222,7 @@ func (cache *overlaycache) queryNodes(ctx context.Context, excludedNodes []storj
 		return nil, err
 	}
 	defer func() { err = errs.Combine(err, rows.Close()) }()
-	var nodes []*pb.Node
+	var nodes []*overlay.NodeDossier
 	for rows.Next() {
 		dbNode := &dbx.Node{}
 		err = rows.Scan(&dbNode.Id, &dbNode.Type,
@@ -193,13 +240,13 @@ func (cache *overlaycache) queryNodes(ctx context.Context, excludedNodes []storj
 		if err != nil {
 			return nil, err
 		}
-		nodes = append(nodes, &dossier.Node)
+		nodes = append(nodes, dossier)
 	}
 
 	return nodes, rows.Err()
 }
 
-func (cache *overlaycache) queryNodesDistinct(ctx context.Context, excludedNodes []storj.NodeID, excludedIPs []string, count int, safeQuery string, distinctIP bool, args ...interface{}) (_ []*pb.Node, err error) {
+func (cache *overlaycache) queryNodesDistinct(ctx context.Context, excludedNodes []storj.NodeID, excludedIPs []string, count int, safeQuery string, distinctIP bool, args ...interface{}) (_ []*overlay.NodeDossier, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	switch t := cache.db.DB.Driver().(type) {
@@ -208,11 +255,11 @@ func (cache *overlaycache) queryNodesDistinct(ctx context.Context, excludedNodes
 	case *pq.Driver:
 		return cache.postgresQueryNodesDistinct(ctx, excludedNodes, excludedIPs, count, safeQuery, distinctIP, args...)
 	default:
-		return []*pb.Node{}, Error.New("Unsupported database %t", t)
+		return []*overlay.NodeDossier{}, Error.New("Unsupported database %t", t)
 	}
 }
 
-func (cache *overlaycache) sqliteQueryNodesDistinct(ctx context.Context, excludedNodes []storj.NodeID, excludedIPs []string, count int, safeQuery string, distinctIP bool, args ...interface{}) (_ []*pb.Node, err error) {
+func (cache *overlaycache) sqliteQueryNodesDistinct(ctx context.Context, excludedNodes []storj.NodeID, excludedIPs []string, count int, safeQuery string, distinctIP bool, args ...interface{}) (_ []*overlay.NodeDossier, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	if count == 0 {
@@ -252,7 +299,7 @@ func (cache *overlaycache) sqliteQueryNodesDistinct(ctx context.Context, exclude
 		return nil, err
 	}
 	defer func() { err = errs.Combine(err, rows.Close()) }()
-	var nodes []*pb.Node
+	var nodes []*overlay.NodeDossier
 	for rows.Next() {
 		dbNode := &dbx.Node{}
 		err = rows.Scan(&dbNode.Id, &dbNode.Type,
@@ -270,13 +317,13 @@ func (cache *overlaycache) sqliteQueryNodesDistinct(ctx context.Context, exclude
 		if err != nil {
 			return nil, err
 		}
-		nodes = append(nodes, &dossier.Node)
+		nodes = append(nodes, dossier)
 	}
 
 	return nodes, rows.Err()
 }
 
-func (cache *overlaycache) postgresQueryNodesDistinct(ctx context.Context, excludedNodes []storj.NodeID, excludedIPs []string, count int, safeQuery string, distinctIP bool, args ...interface{}) (_ []*pb.Node, err error) {
+func (cache *overlaycache) postgresQueryNodesDistinct(ctx context.Context, excludedNodes []storj.NodeID, excludedIPs []string, count int, safeQuery string, distinctIP bool, args ...interface{}) (_ []*overlay.NodeDossier, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	if count == 0 {
@@ -320,7 +367,7 @@ func (cache *overlaycache) postgresQueryNodesDistinct(ctx context.Context, exclu
 		return nil, err
 	}
 	defer func() { err = errs.Combine(err, rows.Close()) }()
-	var nodes []*pb.Node
+	var nodes []*overlay.NodeDossier
 	for rows.Next() {
 		dbNode := &dbx.Node{}
 		err = rows.Scan(&dbNode.LastNet, &dbNode.Id, &dbNode.Type,
@@ -337,7 +384,7 @@ func (cache *overlaycache) postgresQueryNodesDistinct(ctx context.Context, exclu
 		if err != nil {
 			return nil, err
 		}
-		nodes = append(nodes, &dossier.Node)
+		nodes = append(nodes, dossier)
 	}
 
 	return nodes, rows.Err()
@@ -373,7 +420,8 @@ func (cache *overlaycache) IsVetted(ctx context.Context, id storj.NodeID, criter
 		AND type = ?
 		AND total_audit_count >= ?
 		AND total_uptime_count >= ?
-		`), id, pb.NodeType_STORAGE, criteria.AuditCount, criteria.UptimeCount)
+		AND created_at <= ?
+		`), id, pb.NodeType_STORAGE, criteria.AuditCount, criteria.UptimeCount, time.Now().Add(-criteria.MinimumAge))
 	var bytes *[]byte
 	err = row.Scan(&bytes)
 	if err != nil {
@@ -529,6 +577,33 @@ func (cache *overlaycache) Reliable(ctx context.Context, criteria *overlay.NodeC
 	return nodes, nil
 }
 
+// CountByVersion returns the number of online nodes per version string.
+func (cache *overlaycache) CountByVersion(ctx context.Context, criteria *overlay.NodeCriteria) (counts map[string]int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := cache.db.Query(cache.db.Rebind(`
+		SELECT major, minor, patch, COUNT(*) FROM nodes
+		WHERE disqualified IS NULL
+		  AND (last_contact_success > ? OR last_contact_success > last_contact_failure)
+		GROUP BY major, minor, patch`),
+		time.Now().Add(-criteria.OnlineWindow))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	counts = make(map[string]int)
+	for rows.Next() {
+		var ver version.SemVer
+		var count int
+		if err := rows.Scan(&ver.Major, &ver.Minor, &ver.Patch, &count); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		counts[ver.String()] = count
+	}
+	return counts, Error.Wrap(rows.Err())
+}
+
 // Paginate will run through
 func (cache *overlaycache) Paginate(ctx context.Context, offset int64, limit int) (_ []*overlay.NodeDossier, _ bool, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -643,6 +718,7 @@ func (cache *overlaycache) UpdateAddress(ctx context.Context, info *pb.Node, def
 			dbx.Node_AuditReputationBeta(defaults.AuditReputationBeta0),
 			dbx.Node_UptimeReputationAlpha(defaults.UptimeReputationAlpha0),
 			dbx.Node_UptimeReputationBeta(defaults.UptimeReputationBeta0),
+			dbx.Node_AuditFailureStreak(0),
 			dbx.Node_Create_Fields{
 				Disqualified: dbx.Node_Disqualified_Null(),
 			},
@@ -785,39 +861,282 @@ func (cache *overlaycache) UpdateStats(ctx context.Context, updateReq *overlay.U
 func (cache *overlaycache) UpdateNodeInfo(ctx context.Context, nodeID storj.NodeID, nodeInfo *pb.InfoResponse) (stats *overlay.NodeDossier, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var updateFields dbx.Node_Update_Fields
-	if nodeInfo != nil {
-		if nodeInfo.GetType() != pb.NodeType_INVALID {
-			updateFields.Type = dbx.Node_Type(int(nodeInfo.GetType()))
+	updateFields, err := nodeInfoUpdateFields(nodeInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedDBNode, err := cache.db.Update_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()), updateFields)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return convertDBNode(ctx, updatedDBNode)
+}
+
+// BatchUpdateNodeInfo updates the node info of multiple storagenodes, batchSize nodes per transaction.
+// When a transaction fails, all nodes of that batch are returned as failed.
+func (cache *overlaycache) BatchUpdateNodeInfo(ctx context.Context, updates []*overlay.NodeInfoUpdate, batchSize int) (failed storj.NodeIDList, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if len(updates) == 0 {
+		return failed, nil
+	}
+	if batchSize <= 0 {
+		batchSize = len(updates)
+	}
+
+	doUpdate := func(updateSlice []*overlay.NodeInfoUpdate) (err error) {
+		tx, err := cache.db.Open(ctx)
+		if err != nil {
+			return Error.Wrap(err)
 		}
-		if nodeInfo.GetOperator() != nil {
-			updateFields.Wallet = dbx.Node_Wallet(nodeInfo.GetOperator().GetWallet())
-			updateFields.Email = dbx.Node_Email(nodeInfo.GetOperator().GetEmail())
+
+		for _, update := range updateSlice {
+			updateFields, err := nodeInfoUpdateFields(update.Info)
+			if err != nil {
+				return errs.Combine(err, tx.Rollback())
+			}
+
+			_, err = tx.Update_Node_By_Id(ctx, dbx.Node_Id(update.NodeID.Bytes()), updateFields)
+			if err != nil {
+				return Error.Wrap(errs.Combine(err, tx.Rollback()))
+			}
 		}
-		if nodeInfo.GetCapacity() != nil {
-			updateFields.FreeDisk = dbx.Node_FreeDisk(nodeInfo.GetCapacity().GetFreeDisk())
-			updateFields.FreeBandwidth = dbx.Node_FreeBandwidth(nodeInfo.GetCapacity().GetFreeBandwidth())
+
+		return Error.Wrap(tx.Commit())
+	}
+
+	var errlist errs.Group
+	for i := 0; i < len(updates); i += batchSize {
+		end := i + batchSize
+		if end > len(updates) {
+			end = len(updates)
 		}
-		if nodeInfo.GetVersion() != nil {
-			semVer, err := version.NewSemVer(nodeInfo.GetVersion().GetVersion())
-			if err != nil {
-				return nil, errs.New("unable to convert version to semVer")
+
+		if err := doUpdate(updates[i:end]); err != nil {
+			errlist.Add(err)
+			for _, update := range updates[i:end] {
+				failed = append(failed, update.NodeID)
 			}
-			updateFields.Major = dbx.Node_Major(semVer.Major)
-			updateFields.Minor = dbx.Node_Minor(semVer.Minor)
-			updateFields.Patch = dbx.Node_Patch(semVer.Patch)
-			updateFields.Hash = dbx.Node_Hash(nodeInfo.GetVersion().GetCommitHash())
-			updateFields.Timestamp = dbx.Node_Timestamp(nodeInfo.GetVersion().Timestamp)
-			updateFields.Release = dbx.Node_Release(nodeInfo.GetVersion().GetRelease())
 		}
 	}
+	return failed, errlist.Err()
+}
+
+// nodeInfoUpdateFields converts the info requested from a node to the fields to update.
+func nodeInfoUpdateFields(nodeInfo *pb.InfoResponse) (updateFields dbx.Node_Update_Fields, err error) {
+	if nodeInfo == nil {
+		return updateFields, nil
+	}
 
-	updatedDBNode, err := cache.db.Update_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()), updateFields)
+	if nodeInfo.GetType() != pb.NodeType_INVALID {
+		updateFields.Type = dbx.Node_Type(int(nodeInfo.GetType()))
+	}
+	if nodeInfo.GetOperator() != nil {
+		updateFields.Wallet = dbx.Node_Wallet(nodeInfo.GetOperator().GetWallet())
+		updateFields.Email = dbx.Node_Email(nodeInfo.GetOperator().GetEmail())
+	}
+	if nodeInfo.GetCapacity() != nil {
+		updateFields.FreeDisk = dbx.Node_FreeDisk(nodeInfo.GetCapacity().GetFreeDisk())
+		updateFields.FreeBandwidth = dbx.Node_FreeBandwidth(nodeInfo.GetCapacity().GetFreeBandwidth())
+	}
+	if nodeInfo.GetVersion() != nil {
+		semVer, err := version.NewSemVer(nodeInfo.GetVersion().GetVersion())
+		if err != nil {
+			return updateFields, errs.New("unable to convert version to semVer")
+		}
+		updateFields.Major = dbx.Node_Major(semVer.Major)
+		updateFields.Minor = dbx.Node_Minor(semVer.Minor)
+		updateFields.Patch = dbx.Node_Patch(semVer.Patch)
+		updateFields.Hash = dbx.Node_Hash(nodeInfo.GetVersion().GetCommitHash())
+		updateFields.Timestamp = dbx.Node_Timestamp(nodeInfo.GetVersion().Timestamp)
+		updateFields.Release = dbx.Node_Release(nodeInfo.GetVersion().GetRelease())
+	}
+	return updateFields, nil
+}
+
+// RecomputeReputation recomputes the reputation of all qualified nodes with the parameters in config.
+//
+// Only the number of successful and total audits and uptime checks are
+// stored, not their order, so the reputation is recomputed as if the
+// successes were spread evenly over the history. This is exact when lambda
+// is 1 or when all checks had the same result.
+func (cache *overlaycache) RecomputeReputation(ctx context.Context, config overlay.NodeSelectionConfig) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	tx, err := cache.db.Open(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() {
+		if err != nil {
+			err = Error.Wrap(errs.Combine(err, tx.Rollback()))
+		} else {
+			err = Error.Wrap(tx.Commit())
+		}
+	}()
+
+	type nodeCounts struct {
+		id                 []byte
+		totalAuditCount    int64
+		auditSuccessCount  int64
+		totalUptimeCount   int64
+		uptimeSuccessCount int64
+	}
+
+	rows, err := tx.Tx.QueryContext(ctx, cache.db.Rebind(`
+		SELECT id, total_audit_count, audit_success_count, total_uptime_count, uptime_success_count
+		FROM nodes
+		WHERE disqualified IS NULL`))
+	if err != nil {
+		return err
+	}
+
+	var nodes []nodeCounts
+	for rows.Next() {
+		var node nodeCounts
+		err = rows.Scan(&node.id, &node.totalAuditCount, &node.auditSuccessCount, &node.totalUptimeCount, &node.uptimeSuccessCount)
+		if err != nil {
+			return errs.Combine(err, rows.Close())
+		}
+		nodes = append(nodes, node)
+	}
+	if err := errs.Combine(rows.Err(), rows.Close()); err != nil {
+		return err
+	}
+
+	for _, node := range nodes {
+		auditAlpha, auditBeta := recomputeReputation(
+			config.AuditReputationAlpha0, config.AuditReputationBeta0,
+			config.AuditReputationLambda, config.AuditReputationWeight,
+			node.auditSuccessCount, node.totalAuditCount,
+		)
+		uptimeAlpha, uptimeBeta := recomputeReputation(
+			config.UptimeReputationAlpha0, config.UptimeReputationBeta0,
+			config.UptimeReputationLambda, config.UptimeReputationWeight,
+			node.uptimeSuccessCount, node.totalUptimeCount,
+		)
+
+		_, err = tx.Tx.ExecContext(ctx, cache.db.Rebind(`
+			UPDATE nodes
+			SET audit_reputation_alpha = ?, audit_reputation_beta = ?,
+				uptime_reputation_alpha = ?, uptime_reputation_beta = ?
+			WHERE id = ?`),
+			auditAlpha, auditBeta, uptimeAlpha, uptimeBeta, node.id)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WouldDisqualify returns the qualified nodes whose audit reputation is at or below auditDQ.
+func (cache *overlaycache) WouldDisqualify(ctx context.Context, auditDQ float64) (nodes storj.NodeIDList, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// audit_reputation_alpha / (audit_reputation_alpha + audit_reputation_beta) <= auditDQ
+	// without dividing, so that nodes without any reputation don't cause errors
+	rows, err := cache.db.Query(cache.db.Rebind(`
+		SELECT id FROM nodes
+		WHERE disqualified IS NULL
+		AND audit_reputation_alpha <= ? * (audit_reputation_alpha + audit_reputation_beta)
+		ORDER BY id`), auditDQ)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
 
-	return convertDBNode(ctx, updatedDBNode)
+	for rows.Next() {
+		var id storj.NodeID
+		if err := rows.Scan(&id); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		nodes = append(nodes, id)
+	}
+	return nodes, Error.Wrap(rows.Err())
+}
+
+// PruneStaleNodes marks the nodes that haven't been contacted successfully since olderThan as offline.
+func (cache *overlaycache) PruneStaleNodes(ctx context.Context, olderThan time.Time) (count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// a node is offline when its last failed contact is after its last
+	// successful one, so only the nodes still considered online are updated
+	result, err := cache.db.ExecContext(ctx, cache.db.Rebind(`
+		UPDATE nodes SET last_contact_failure = ?
+		WHERE last_contact_success < ?
+		  AND last_contact_success >= last_contact_failure`),
+		time.Now(), olderThan)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	count, err = result.RowsAffected()
+	return count, Error.Wrap(err)
+}
+
+// NodesWithFailureStreakAtLeast returns the qualified nodes that failed at least n audits in a row.
+func (cache *overlaycache) NodesWithFailureStreakAtLeast(ctx context.Context, n int64) (nodes storj.NodeIDList, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := cache.db.Query(cache.db.Rebind(`
+		SELECT id FROM nodes
+		WHERE disqualified IS NULL
+		AND audit_failure_streak >= ?
+		ORDER BY audit_failure_streak DESC, id`), n)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var id storj.NodeID
+		if err := rows.Scan(&id); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		nodes = append(nodes, id)
+	}
+	return nodes, Error.Wrap(rows.Err())
+}
+
+// RecentlyDisqualified returns the nodes disqualified after since, ordered by the disqualification time.
+func (cache *overlaycache) RecentlyDisqualified(ctx context.Context, since time.Time) (nodes []*overlay.NodeDossier, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := cache.db.Query(cache.db.Rebind(`
+		SELECT id FROM nodes
+		WHERE disqualified > ?
+		ORDER BY disqualified, id`), since.UTC())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var ids []storj.NodeID
+	for rows.Next() {
+		var id storj.NodeID
+		if err := rows.Scan(&id); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	for _, id := range ids {
+		dbNode, err := cache.db.Get_Node_By_Id(ctx, dbx.Node_Id(id.Bytes()))
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		node, err := convertDBNode(ctx, dbNode)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
 }
 
 // UpdateUptime updates a single storagenode's uptime stats in the db
@@ -982,6 +1301,7 @@ func getNodeStats(dbNode *dbx.Node) *overlay.NodeStats {
 		UptimeReputationAlpha: dbNode.UptimeReputationAlpha,
 		UptimeReputationBeta:  dbNode.UptimeReputationBeta,
 		Disqualified:          dbNode.Disqualified,
+		AuditFailureStreak:    dbNode.AuditFailureStreak,
 	}
 	return nodeStats
 }
@@ -1000,6 +1320,27 @@ func updateReputation(isSuccess bool, alpha, beta, lambda, w float64, totalCount
 	return newAlpha, newBeta, totalCount + 1
 }
 
+// recomputeReputation calculates alpha and beta starting from alpha0 and beta0
+// after total updates with updateReputation, of which successes were successful
+// and evenly spread.
+func recomputeReputation(alpha0, beta0, lambda, w float64, successes, total int64) (alpha, beta float64) {
+	if total <= 0 {
+		return alpha0, beta0
+	}
+
+	// decay is lambda^total and sum is the sum of lambda^k for k in [0, total)
+	decay := math.Pow(lambda, float64(total))
+	sum := float64(total)
+	if lambda != 1 {
+		sum = (1 - decay) / (1 - lambda)
+	}
+
+	successRatio := float64(successes) / float64(total)
+	alpha = decay*alpha0 + w*successRatio*sum
+	beta = decay*beta0 + w*(1-successRatio)*sum
+	return alpha, beta
+}
+
 func buildUpdateStatement(db *dbx.DB, update updateNodeStats) string {
 	if update.NodeID.IsZero() {
 		return ""
@@ -1080,6 +1421,13 @@ func buildUpdateStatement(db *dbx.DB, update updateNodeStats) string {
 		atLeastOne = true
 		sql += fmt.Sprintf("audit_success_count = %v", update.AuditSuccessCount.value)
 	}
+	if update.AuditFailureStreak.set {
+		if atLeastOne {
+			sql += ","
+		}
+		atLeastOne = true
+		sql += fmt.Sprintf("audit_failure_streak = %v", update.AuditFailureStreak.value)
+	}
 	if update.Contained.set {
 		if atLeastOne {
 			sql += ","
@@ -1139,6 +1487,7 @@ type updateNodeStats struct {
 	LastContactSuccess    timeField
 	LastContactFailure    timeField
 	AuditSuccessCount     int64Field
+	AuditFailureStreak    int64Field
 	Contained             boolField
 }
 
@@ -1196,6 +1545,9 @@ func populateUpdateNodeStats(dbNode *dbx.Node, updateReq *overlay.UpdateRequest)
 
 	if updateReq.AuditSuccess {
 		updateFields.AuditSuccessCount = int64Field{set: true, value: dbNode.AuditSuccessCount + 1}
+		updateFields.AuditFailureStreak = int64Field{set: true, value: 0}
+	} else {
+		updateFields.AuditFailureStreak = int64Field{set: true, value: dbNode.AuditFailureStreak + 1}
 	}
 
 	// Updating node stats always exits it from containment mode
@@ -1241,6 +1593,9 @@ func populateUpdateFields(dbNode *dbx.Node, updateReq *overlay.UpdateRequest) db
 	if update.AuditSuccessCount.set {
 		updateFields.AuditSuccessCount = dbx.Node_AuditSuccessCount(update.AuditSuccessCount.value)
 	}
+	if update.AuditFailureStreak.set {
+		updateFields.AuditFailureStreak = dbx.Node_AuditFailureStreak(update.AuditFailureStreak.value)
+	}
 	if update.Contained.set {
 		updateFields.Contained = dbx.Node_Contained(update.Contained.value)
 	}
@@ -0,0 +1,83 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/satellite/console"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+var _ console.ProjectActivities = (*projectActivities)(nil)
+
+// projectActivities exposes methods to manage the project_activities table in database
+type projectActivities struct {
+	db *dbx.DB
+	tx *dbx.Tx
+}
+
+// Insert records an activity event of a project
+func (activities *projectActivities) Insert(ctx context.Context, activity console.ProjectActivity) (_ *console.ProjectActivity, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var exec execer = activities.db.DB
+	if activities.tx != nil {
+		exec = activities.tx.Tx
+	}
+
+	activity.CreatedAt = time.Now().UTC()
+	_, err = exec.ExecContext(ctx, activities.db.Rebind(`
+		INSERT INTO project_activities ( project_id, actor_id, type, target, created_at )
+		VALUES ( ?, ?, ?, ?, ? )`),
+		activity.ProjectID[:], activity.ActorID[:], string(activity.Type), activity.Target, activity.CreatedAt,
+	)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	return &activity, nil
+}
+
+// GetByProjectID returns up to limit of the most recent activity events of a project, the most recent first
+func (activities *projectActivities) GetByProjectID(ctx context.Context, projectID uuid.UUID, limit int) (_ []console.ProjectActivity, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := activities.db.QueryContext(ctx, activities.db.Rebind(`
+		SELECT id, actor_id, type, target, created_at FROM project_activities
+		WHERE project_id = ?
+		ORDER BY id DESC
+		LIMIT ?`), projectID[:], limit)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var result []console.ProjectActivity
+	for rows.Next() {
+		activity := console.ProjectActivity{ProjectID: projectID}
+
+		var actorID []byte
+		var activityType string
+		err := rows.Scan(&activity.ID, &actorID, &activityType, &activity.Target, &activity.CreatedAt)
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+
+		actor, err := bytesToUUID(actorID)
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		activity.ActorID = actor
+		activity.Type = console.ActivityType(activityType)
+
+		result = append(result, activity)
+	}
+
+	return result, errs.Wrap(rows.Err())
+}
@@ -94,6 +94,100 @@ func (pm *projectMembers) GetByProjectID(ctx context.Context, projectID uuid.UUI
 	return projectMembers, err
 }
 
+// GetPagedByProjectID is a method for querying a page of project members from the database by projectID and cursor.
+// Members with the same order value are ordered by their id, so that pages don't overlap.
+func (pm *projectMembers) GetPagedByProjectID(ctx context.Context, projectID uuid.UUID, cursor console.ProjectMembersCursor) (_ *console.ProjectMembersPage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	search := "%" + strings.Replace(cursor.Search, " ", "%", -1) + "%"
+
+	if cursor.Limit > 50 {
+		cursor.Limit = 50
+	}
+	if cursor.Limit == 0 {
+		return nil, errs.New("limit can not be 0")
+	}
+	if cursor.Page == 0 {
+		return nil, errs.New("page can not be 0")
+	}
+
+	page := &console.ProjectMembersPage{
+		Search:      cursor.Search,
+		Limit:       cursor.Limit,
+		Order:       cursor.Order,
+		Offset:      uint64((cursor.Page - 1) * cursor.Limit),
+		CurrentPage: cursor.Page,
+	}
+
+	countQuery := pm.db.Rebind(`
+		SELECT COUNT(*)
+			FROM project_members pm
+				INNER JOIN users u ON pm.member_id = u.id
+					WHERE pm.project_id = ?
+					AND ( u.email LIKE ? OR
+						  u.full_name LIKE ? OR
+						  u.short_name LIKE ? )`)
+
+	countRow := pm.db.QueryRowContext(ctx, countQuery, projectID[:], search, search, search)
+	err = countRow.Scan(&page.TotalCount)
+	if err != nil {
+		return nil, err
+	}
+	if page.TotalCount == 0 {
+		return page, nil
+	}
+	if page.Offset > page.TotalCount-1 {
+		return nil, errs.New("page is out of range")
+	}
+
+	membersQuery := pm.db.Rebind(`
+		SELECT pm.member_id, pm.project_id, pm.created_at
+			FROM project_members pm
+				INNER JOIN users u ON pm.member_id = u.id
+					WHERE pm.project_id = ?
+					AND ( u.email LIKE ? OR
+						  u.full_name LIKE ? OR
+						  u.short_name LIKE ? )
+						ORDER BY ` + sanitizedOrderColumnName(cursor.Order) + ` ASC, u.id ASC
+						LIMIT ? OFFSET ?`)
+
+	rows, err := pm.db.QueryContext(ctx, membersQuery, projectID[:], search, search, search, page.Limit, page.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var memberIDBytes, projectIDBytes []uint8
+		member := console.ProjectMember{}
+
+		err = rows.Scan(&memberIDBytes, &projectIDBytes, &member.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		memberID, err := bytesToUUID(memberIDBytes)
+		if err != nil {
+			return nil, err
+		}
+		projectID, err := bytesToUUID(projectIDBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		member.MemberID = memberID
+		member.ProjectID = projectID
+		page.ProjectMembers = append(page.ProjectMembers, member)
+	}
+
+	page.PageCount = uint(page.TotalCount / uint64(cursor.Limit))
+	if page.TotalCount%uint64(cursor.Limit) != 0 {
+		page.PageCount++
+	}
+
+	return page, rows.Err()
+}
+
 // Insert is a method for inserting project member into the database.
 func (pm *projectMembers) Insert(ctx context.Context, memberID, projectID uuid.UUID) (_ *console.ProjectMember, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -6,6 +6,8 @@ package satellitedb
 import (
 	"context"
 	"database/sql"
+	"encoding/csv"
+	"io"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -83,6 +85,31 @@ func (db *StoragenodeAccounting) GetTalliesSince(ctx context.Context, latestRoll
 	return out, Error.Wrap(err)
 }
 
+// GetTalliesSinceForNode retrieves all raw tallies for nodeID since latestRollup
+func (db *StoragenodeAccounting) GetTalliesSinceForNode(ctx context.Context, nodeID storj.NodeID, latestRollup time.Time) (_ []*accounting.StoragenodeStorageTally, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var sqlStmt = `SELECT id, interval_end_time, data_total
+		FROM storagenode_storage_tallies
+		WHERE node_id = ? AND interval_end_time >= ?
+		ORDER BY interval_end_time`
+	rows, err := db.db.DB.QueryContext(ctx, db.db.Rebind(sqlStmt), nodeID.Bytes(), latestRollup)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	out := []*accounting.StoragenodeStorageTally{}
+	for rows.Next() {
+		tally := &accounting.StoragenodeStorageTally{NodeID: nodeID}
+		err := rows.Scan(&tally.ID, &tally.IntervalEndTime, &tally.DataTotal)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		out = append(out, tally)
+	}
+	return out, Error.Wrap(rows.Err())
+}
+
 // GetBandwidthSince retrieves all storagenode_bandwidth_rollup entires since latestRollup
 func (db *StoragenodeAccounting) GetBandwidthSince(ctx context.Context, latestRollup time.Time) (_ []*accounting.StoragenodeBandwidthRollup, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -103,13 +130,23 @@ func (db *StoragenodeAccounting) GetBandwidthSince(ctx context.Context, latestRo
 	return out, Error.Wrap(err)
 }
 
-// SaveRollup records raw tallies of at rest data to the database
+// SaveRollup records raw tallies of at rest data to the database.
+// If latestRollup is not after the stored LastRollup timestamp the interval
+// has already been processed and SaveRollup is a no-op.
 func (db *StoragenodeAccounting) SaveRollup(ctx context.Context, latestRollup time.Time, stats accounting.RollupStats) (err error) {
 	defer mon.Task()(&ctx)(&err)
 	if len(stats) == 0 {
 		return Error.New("In SaveRollup with empty nodeData")
 	}
 	err = db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		lastRollup, err := tx.Find_AccountingTimestamps_Value_By_Name(ctx, dbx.AccountingTimestamps_Name(accounting.LastRollup))
+		if err != nil {
+			return err
+		}
+		if lastRollup != nil && !latestRollup.After(lastRollup.Value) {
+			return nil
+		}
+
 		for _, arsByDate := range stats {
 			for _, ar := range arsByDate {
 				nID := dbx.AccountingRollup_NodeId(ar.NodeID.Bytes())
@@ -126,8 +163,12 @@ func (db *StoragenodeAccounting) SaveRollup(ctx context.Context, latestRollup ti
 				}
 			}
 		}
+		if lastRollup == nil {
+			_, err = tx.Create_AccountingTimestamps(ctx, dbx.AccountingTimestamps_Name(accounting.LastRollup), dbx.AccountingTimestamps_Value(latestRollup))
+			return err
+		}
 		update := dbx.AccountingTimestamps_Update_Fields{Value: dbx.AccountingTimestamps_Value(latestRollup)}
-		_, err := tx.Update_AccountingTimestamps_By_Name(ctx, dbx.AccountingTimestamps_Name(accounting.LastRollup), update)
+		_, err = tx.Update_AccountingTimestamps_By_Name(ctx, dbx.AccountingTimestamps_Name(accounting.LastRollup), update)
 		return err
 	})
 	return Error.Wrap(err)
@@ -153,6 +194,33 @@ func (db *StoragenodeAccounting) LastTimestamp(ctx context.Context, timestampTyp
 // QueryPaymentInfo queries Overlay, Accounting Rollup on nodeID
 func (db *StoragenodeAccounting) QueryPaymentInfo(ctx context.Context, start time.Time, end time.Time) (_ []*accounting.CSVRow, err error) {
 	defer mon.Task()(&ctx)(&err)
+	csv := make([]*accounting.CSVRow, 0, 0)
+	err = db.iteratePaymentInfo(ctx, start, end, func(r *accounting.CSVRow) error {
+		csv = append(csv, r)
+		return nil
+	})
+	return csv, err
+}
+
+// WritePaymentInfoCSV streams the rows of QueryPaymentInfo as CSV into w while they are scanned
+func (db *StoragenodeAccounting) WritePaymentInfoCSV(ctx context.Context, w io.Writer, start time.Time, end time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	cw := csv.NewWriter(w)
+	if err := cw.Write(accounting.CSVHeader); err != nil {
+		return Error.Wrap(err)
+	}
+	err = db.iteratePaymentInfo(ctx, start, end, func(r *accounting.CSVRow) error {
+		return cw.Write(r.Record())
+	})
+	if err != nil {
+		return err
+	}
+	cw.Flush()
+	return Error.Wrap(cw.Error())
+}
+
+// iteratePaymentInfo calls fn for every row of the payment info query
+func (db *StoragenodeAccounting) iteratePaymentInfo(ctx context.Context, start time.Time, end time.Time, fn func(*accounting.CSVRow) error) (err error) {
 	var sqlStmt = `SELECT n.id, n.created_at, r.at_rest_total, r.get_repair_total,
 	    r.put_repair_total, r.get_audit_total, r.put_total, r.get_total, n.wallet, n.disqualified
 	    FROM (
@@ -167,10 +235,9 @@ func (db *StoragenodeAccounting) QueryPaymentInfo(ctx context.Context, start tim
 	    ORDER BY n.id`
 	rows, err := db.db.DB.QueryContext(ctx, db.db.Rebind(sqlStmt), start.UTC(), end.UTC())
 	if err != nil {
-		return nil, Error.Wrap(err)
+		return Error.Wrap(err)
 	}
 	defer func() { err = errs.Combine(err, rows.Close()) }()
-	csv := make([]*accounting.CSVRow, 0, 0)
 	for rows.Next() {
 		var nodeID []byte
 		r := &accounting.CSVRow{}
@@ -179,20 +246,41 @@ func (db *StoragenodeAccounting) QueryPaymentInfo(ctx context.Context, start tim
 		err := rows.Scan(&nodeID, &r.NodeCreationDate, &r.AtRestTotal, &r.GetRepairTotal,
 			&r.PutRepairTotal, &r.GetAuditTotal, &r.PutTotal, &r.GetTotal, &wallet, &disqualified)
 		if err != nil {
-			return csv, Error.Wrap(err)
+			return Error.Wrap(err)
 		}
 		if wallet.Valid {
 			r.Wallet = wallet.String
 		}
 		id, err := storj.NodeIDFromBytes(nodeID)
 		if err != nil {
-			return csv, Error.Wrap(err)
+			return Error.Wrap(err)
 		}
 		r.NodeID = id
 		r.Disqualified = disqualified
-		csv = append(csv, r)
+		if err := fn(r); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// QueryNodePaymentTotals sums Accounting Rollup on nodeID for given period
+func (db *StoragenodeAccounting) QueryNodePaymentTotals(ctx context.Context, nodeID storj.NodeID, start time.Time, end time.Time) (_ *accounting.NodePaymentTotals, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var sqlStmt = `SELECT COALESCE(SUM(at_rest_total), 0), COALESCE(SUM(get_total), 0),
+		COALESCE(SUM(put_total), 0), COALESCE(SUM(get_audit_total), 0),
+		COALESCE(SUM(get_repair_total), 0), COALESCE(SUM(put_repair_total), 0)
+		FROM accounting_rollups
+		WHERE node_id = ? AND start_time >= ? AND start_time < ?`
+
+	totals := &accounting.NodePaymentTotals{NodeID: nodeID}
+	err = db.db.QueryRowContext(ctx, db.db.Rebind(sqlStmt), nodeID.Bytes(), start.UTC(), end.UTC()).Scan(
+		&totals.AtRestTotal, &totals.GetTotal, &totals.PutTotal,
+		&totals.GetAuditTotal, &totals.GetRepairTotal, &totals.PutRepairTotal)
+	if err != nil {
+		return nil, Error.Wrap(err)
 	}
-	return csv, nil
+	return totals, nil
 }
 
 // QueryNodeDailySpaceUsage returns slice of NodeSpaceUsage for given period
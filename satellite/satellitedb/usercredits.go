@@ -57,12 +57,110 @@ func (c *usercredits) GetCreditUsage(ctx context.Context, userID uuid.UUID, expi
 	return &usage, nil
 }
 
+// GetByUserID returns all credits of a user
+func (c *usercredits) GetByUserID(ctx context.Context, userID uuid.UUID) (_ []console.UserCredit, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := c.db.DB.QueryContext(ctx, c.db.Rebind(`
+		SELECT id, user_id, offer_id, referred_by, type, credits_earned_in_cents, credits_used_in_cents, expires_at, created_at
+		FROM user_credits WHERE user_id = ? ORDER BY id`), userID[:])
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var credits []console.UserCredit
+	for rows.Next() {
+		var credit console.UserCredit
+		var userIDBytes, referredBy []byte
+		var earned, used int
+
+		err = rows.Scan(&credit.ID, &userIDBytes, &credit.OfferID, &referredBy, &credit.Type, &earned, &used, &credit.ExpiresAt, &credit.CreatedAt)
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+
+		credit.UserID, err = bytesToUUID(userIDBytes)
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		if referredBy != nil {
+			referrerID, err := bytesToUUID(referredBy)
+			if err != nil {
+				return nil, errs.Wrap(err)
+			}
+			credit.ReferredBy = &referrerID
+		}
+		credit.CreditsEarned = currency.Cents(earned)
+		credit.CreditsUsed = currency.Cents(used)
+
+		credits = append(credits, credit)
+	}
+
+	return credits, errs.Wrap(rows.Err())
+}
+
 // Create insert a new record of user credit
 func (c *usercredits) Create(ctx context.Context, userCredit console.UserCredit) error {
+	var exec execer = c.db.DB
+	if c.tx != nil {
+		exec = c.tx.Tx
+	}
+
+	inserted, err := c.insert(ctx, exec, userCredit)
+	if err != nil {
+		return err
+	}
+	if !inserted {
+		return rewards.MaxRedemptionErr.New("create credit failed")
+	}
+
+	return nil
+}
+
+// CreateBatch inserts new records of user credits in a single transaction.
+// Credits that would exceed the redeemable cap of their offer are skipped and returned.
+func (c *usercredits) CreateBatch(ctx context.Context, userCredits []console.UserCredit) (skipped []console.UserCredit, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	createAll := func(exec execer) error {
+		for _, userCredit := range userCredits {
+			inserted, err := c.insert(ctx, exec, userCredit)
+			if err != nil {
+				return err
+			}
+			if !inserted {
+				skipped = append(skipped, userCredit)
+			}
+		}
+		return nil
+	}
+
+	if c.tx != nil {
+		err = createAll(c.tx.Tx)
+	} else {
+		err = c.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+			return createAll(tx.Tx)
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return skipped, nil
+}
+
+// execer executes sql statements, either in a transaction or directly on the database
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// insert inserts userCredit unless it would exceed the redeemable cap of its offer
+func (c *usercredits) insert(ctx context.Context, exec execer, userCredit console.UserCredit) (inserted bool, err error) {
 	var statement string
 
 	if userCredit.ExpiresAt.Before(time.Now().UTC()) {
-		return errs.New("user credit is already expired")
+		return false, errs.New("user credit is already expired")
 	}
 
 	var referrerID []byte
@@ -71,7 +169,6 @@ func (c *usercredits) Create(ctx context.Context, userCredit console.UserCredit)
 	}
 
 	var result sql.Result
-	var err error
 
 	switch t := c.db.Driver().(type) {
 	case *sqlite3.SQLiteDriver:
@@ -80,40 +177,28 @@ func (c *usercredits) Create(ctx context.Context, userCredit console.UserCredit)
 				SELECT * FROM (VALUES (?, ?, ?, 0, ?, ?, ?, time('now'))) AS v
 					WHERE COALESCE((SELECT COUNT(offer_id) FROM user_credits WHERE offer_id = ? ) < (SELECT redeemable_cap FROM offers WHERE id = ? AND redeemable_cap > 0), TRUE);
 		`
-		if c.tx != nil {
-			result, err = c.tx.Tx.ExecContext(ctx, c.db.Rebind(statement), userCredit.UserID[:], userCredit.OfferID, userCredit.CreditsEarned.Cents(), userCredit.ExpiresAt, referrerID, userCredit.Type, userCredit.OfferID, userCredit.OfferID)
-		} else {
-			result, err = c.db.DB.ExecContext(ctx, c.db.Rebind(statement), userCredit.UserID[:], userCredit.OfferID, userCredit.CreditsEarned.Cents(), userCredit.ExpiresAt, referrerID, userCredit.Type, userCredit.OfferID, userCredit.OfferID)
-		}
+		result, err = exec.ExecContext(ctx, c.db.Rebind(statement), userCredit.UserID[:], userCredit.OfferID, userCredit.CreditsEarned.Cents(), userCredit.ExpiresAt, referrerID, userCredit.Type, userCredit.OfferID, userCredit.OfferID)
 	case *pq.Driver:
 		statement = `
 			INSERT INTO user_credits (user_id, offer_id, credits_earned_in_cents, credits_used_in_cents, expires_at, referred_by, type, created_at)
 				SELECT * FROM (VALUES (?::bytea, ?::int, ?::int, 0, ?::timestamp, NULLIF(?::bytea, ?::bytea), ?::text, now())) AS v
 					WHERE COALESCE((SELECT COUNT(offer_id) FROM user_credits WHERE offer_id = ? ) < (SELECT redeemable_cap FROM offers WHERE id = ? AND redeemable_cap > 0), TRUE);
 		`
-		if c.tx != nil {
-			result, err = c.tx.Tx.ExecContext(ctx, c.db.Rebind(statement), userCredit.UserID[:], userCredit.OfferID, userCredit.CreditsEarned.Cents(), userCredit.ExpiresAt, referrerID, new([]byte), userCredit.Type, userCredit.OfferID, userCredit.OfferID)
-		} else {
-			result, err = c.db.DB.ExecContext(ctx, c.db.Rebind(statement), userCredit.UserID[:], userCredit.OfferID, userCredit.CreditsEarned.Cents(), userCredit.ExpiresAt, referrerID, new([]byte), userCredit.Type, userCredit.OfferID, userCredit.OfferID)
-		}
+		result, err = exec.ExecContext(ctx, c.db.Rebind(statement), userCredit.UserID[:], userCredit.OfferID, userCredit.CreditsEarned.Cents(), userCredit.ExpiresAt, referrerID, new([]byte), userCredit.Type, userCredit.OfferID, userCredit.OfferID)
 	default:
-		return errs.New("Unsupported database %t", t)
+		return false, errs.New("Unsupported database %t", t)
 	}
 
 	if err != nil {
-		return errs.Wrap(err)
+		return false, errs.Wrap(err)
 	}
 
 	rows, err := result.RowsAffected()
 	if err != nil {
-		return errs.Wrap(err)
-	}
-
-	if rows != 1 {
-		return rewards.MaxRedemptionErr.New("create credit failed")
+		return false, errs.Wrap(err)
 	}
 
-	return nil
+	return rows == 1, nil
 }
 
 // UpdateEarnedCredits updates user credits after user activated their account
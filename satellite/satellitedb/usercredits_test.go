@@ -5,6 +5,7 @@ package satellitedb_test
 
 import (
 	"context"
+	"strconv"
 	"testing"
 	"time"
 
@@ -264,6 +265,74 @@ func TestUsercredits(t *testing.T) {
 	})
 }
 
+func TestUsercreditsCreateBatch(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		consoleDB := db.Console()
+
+		var users []*console.User
+		for i := 0; i < 3; i++ {
+			user, err := consoleDB.Users().Insert(ctx, &console.User{
+				FullName:     "campaign user",
+				Email:        "campaign" + strconv.Itoa(i) + "@mail.test",
+				PasswordHash: testrand.Bytes(8),
+				Status:       console.Active,
+			})
+			require.NoError(t, err)
+			users = append(users, user)
+		}
+
+		cappedOffer, err := db.Rewards().Create(ctx, &rewards.NewOffer{
+			Name:                      "campaign",
+			Description:               "campaign offer",
+			AwardCredit:               currency.Cents(0),
+			InviteeCredit:             currency.Cents(100),
+			AwardCreditDurationDays:   0,
+			InviteeCreditDurationDays: 30,
+			RedeemableCap:             2,
+			ExpiresAt:                 time.Now().UTC().Add(time.Hour * 1),
+			Status:                    rewards.Active,
+			Type:                      rewards.FreeCredit,
+		})
+		require.NoError(t, err)
+
+		var credits []console.UserCredit
+		for _, user := range users {
+			credits = append(credits, console.UserCredit{
+				UserID:        user.ID,
+				OfferID:       cappedOffer.ID,
+				Type:          console.Invitee,
+				CreditsEarned: currency.Cents(100),
+				ExpiresAt:     time.Now().UTC().AddDate(0, 1, 0),
+			})
+		}
+
+		skipped, err := consoleDB.UserCredits().CreateBatch(ctx, credits)
+		require.NoError(t, err)
+		require.Len(t, skipped, 1)
+		require.Equal(t, users[2].ID, skipped[0].UserID)
+
+		for i, user := range users {
+			userCredits, err := consoleDB.UserCredits().GetByUserID(ctx, user.ID)
+			require.NoError(t, err)
+			if i < 2 {
+				require.Len(t, userCredits, 1)
+				require.Equal(t, currency.Cents(100), userCredits[0].CreditsEarned)
+			} else {
+				require.Len(t, userCredits, 0)
+			}
+		}
+
+		// expired credits fail the batch
+		expired := credits[0]
+		expired.ExpiresAt = time.Now().UTC().AddDate(0, 0, -1)
+		_, err = consoleDB.UserCredits().CreateBatch(ctx, []console.UserCredit{expired})
+		require.Error(t, err)
+	})
+}
+
 func setupData(ctx context.Context, t *testing.T, db satellite.DB) (user *console.User, referrer *console.User, activeOffer *rewards.Offer, defaultOffer *rewards.Offer) {
 	consoleDB := db.Console()
 	offersDB := db.Rewards()
@@ -5,6 +5,7 @@ package satellitedb
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/skyrings/skyring-common/tools/uuid"
 	"github.com/zeebo/errs"
@@ -41,6 +42,20 @@ func (users *users) GetByEmail(ctx context.Context, email string) (_ *console.Us
 	return userFromDBX(ctx, user)
 }
 
+// GetInactiveByEmail is a method for querying the latest registered inactive user by email from the database.
+func (users *users) GetInactiveByEmail(ctx context.Context, email string) (_ *console.User, err error) {
+	defer mon.Task()(&ctx)(&err)
+	user, err := users.db.First_User_By_Email_And_Status_Number_OrderBy_Desc_CreatedAt(ctx, dbx.User_Email(email))
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, sql.ErrNoRows
+	}
+
+	return userFromDBX(ctx, user)
+}
+
 // Insert is a method for inserting user into the database
 func (users *users) Insert(ctx context.Context, user *console.User) (_ *console.User, err error) {
 	defer mon.Task()(&ctx)(&err)
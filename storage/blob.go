@@ -54,6 +54,10 @@ type Blobs interface {
 	Open(ctx context.Context, ref BlobRef) (BlobReader, error)
 	// Delete deletes the blob with the namespace and key
 	Delete(ctx context.Context, ref BlobRef) error
+	// Exists returns whether the blob with the namespace and key is stored, without opening it
+	Exists(ctx context.Context, ref BlobRef) (bool, error)
 	// FreeSpace return how much free space left for writing
 	FreeSpace() (int64, error)
+	// WalkNamespace calls walkFunc for every blob stored in the namespace
+	WalkNamespace(ctx context.Context, namespace []byte, walkFunc func(BlobRef) error) error
 }
@@ -11,10 +11,13 @@ import (
 	"math"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 
 	"github.com/zeebo/errs"
+	"golang.org/x/sync/errgroup"
 
+	"storj.io/storj/internal/sync2"
 	"storj.io/storj/storage"
 )
 
@@ -27,28 +30,52 @@ var pathEncoding = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPa
 
 // Dir represents single folder for storing blobs
 type Dir struct {
-	path string
+	path     string
+	syncMode SyncMode
 
 	mu          sync.Mutex
 	deleteQueue []string
+	unsynced    []string
+	syncErr     error
+
+	syncCycle *sync2.Cycle
+	syncGroup errgroup.Group
 }
 
 // NewDir returns folder for storing blobs
 func NewDir(path string) (*Dir, error) {
+	return NewDirWithSync(path, SyncAlways)
+}
+
+// NewDirWithSync returns folder for storing blobs, which flushes the
+// committed blobs to the disk according to syncMode.
+func NewDirWithSync(path string, syncMode SyncMode) (*Dir, error) {
 	dir := &Dir{
-		path: path,
+		path:     path,
+		syncMode: syncMode,
 	}
 
-	return dir, errs.Combine(
+	err := errs.Combine(
 		os.MkdirAll(dir.blobsdir(), dirPermission),
 		os.MkdirAll(dir.tempdir(), dirPermission),
 		os.MkdirAll(dir.garbagedir(), dirPermission),
 	)
+	if err != nil {
+		return dir, err
+	}
+
+	if syncMode == SyncBatch {
+		dir.startSyncing()
+	}
+	return dir, nil
 }
 
 // Path returns the directory path
 func (dir *Dir) Path() string { return dir.path }
 
+// SyncMode returns when the committed blobs are flushed to the disk.
+func (dir *Dir) SyncMode() SyncMode { return dir.syncMode }
+
 func (dir *Dir) blobsdir() string   { return filepath.Join(dir.path, "blobs") }
 func (dir *Dir) tempdir() string    { return filepath.Join(dir.path, "temp") }
 func (dir *Dir) garbagedir() string { return filepath.Join(dir.path, "garbage") }
@@ -110,7 +137,10 @@ func (dir *Dir) Commit(ctx context.Context, file *os.File, ref storage.BlobRef)
 	defer mon.Task()(&ctx)(&err)
 	position, seekErr := file.Seek(0, io.SeekCurrent)
 	truncErr := file.Truncate(position)
-	syncErr := file.Sync()
+	var syncErr error
+	if dir.syncMode == SyncAlways {
+		syncErr = file.Sync()
+	}
 	chmodErr := os.Chmod(file.Name(), blobPermission)
 	closeErr := file.Close()
 
@@ -141,6 +171,9 @@ func (dir *Dir) Commit(ctx context.Context, file *os.File, ref storage.BlobRef)
 		return errs.Combine(renameErr, removeErr)
 	}
 
+	if dir.syncMode == SyncBatch {
+		return dir.addUnsynced(ctx, path)
+	}
 	return nil
 }
 
@@ -161,6 +194,23 @@ func (dir *Dir) Open(ctx context.Context, ref storage.BlobRef) (_ *os.File, err
 	return file, nil
 }
 
+// Exists returns whether the file with the specified ref exists
+func (dir *Dir) Exists(ctx context.Context, ref storage.BlobRef) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	path, err := dir.blobToPath(ref)
+	if err != nil {
+		return false, err
+	}
+	_, err = os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, Error.New("unable to stat %q: %v", path, err)
+	}
+	return true, nil
+}
+
 // Delete deletes file with the specified ref
 func (dir *Dir) Delete(ctx context.Context, ref storage.BlobRef) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -206,6 +256,62 @@ func (dir *Dir) Delete(ctx context.Context, ref storage.BlobRef) (err error) {
 	return err
 }
 
+// WalkNamespace calls walkFunc for every blob stored in the namespace
+func (dir *Dir) WalkNamespace(ctx context.Context, namespace []byte, walkFunc func(storage.BlobRef) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	namespaceDir := filepath.Join(dir.blobsdir(), pathEncoding.EncodeToString(namespace))
+	prefixes, err := ioutil.ReadDir(namespaceDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	for _, prefix := range prefixes {
+		if !prefix.IsDir() {
+			continue
+		}
+
+		files, err := ioutil.ReadDir(filepath.Join(namespaceDir, prefix.Name()))
+		if err != nil {
+			if os.IsNotExist(err) {
+				// all blobs with this prefix were deleted after listing
+				continue
+			}
+			return err
+		}
+
+		for _, file := range files {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if !file.Mode().IsRegular() {
+				continue
+			}
+
+			// short keys are prefixed with "11" by blobToPath
+			encodedKey := strings.TrimPrefix(prefix.Name()+file.Name(), "11")
+			key, err := pathEncoding.DecodeString(encodedKey)
+			if err != nil {
+				// not a blob stored by us
+				continue
+			}
+
+			err = walkFunc(storage.BlobRef{
+				Namespace: namespace,
+				Key:       key,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // GarbageCollect collects files that are pending deletion
 func (dir *Dir) GarbageCollect(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
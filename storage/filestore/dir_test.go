@@ -3,7 +3,15 @@
 
 package filestore
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/storage"
+)
 
 func TestDiskInfoFromPath(t *testing.T) {
 	info, err := diskInfoFromPath(".")
@@ -19,3 +27,32 @@ func TestDiskInfoFromPath(t *testing.T) {
 
 	t.Logf("Got: %v %v", info.ID, info.AvailableSpace)
 }
+
+func TestSyncBatchInterval(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	dir, err := NewDirWithSync(ctx.Dir("pieces"), SyncBatch)
+	require.NoError(t, err)
+
+	file, err := dir.CreateTemporaryFile(ctx, 0)
+	require.NoError(t, err)
+	_, err = file.Write(testrand.Bytes(1 << 10))
+	require.NoError(t, err)
+	ref := storage.BlobRef{Namespace: testrand.Bytes(32), Key: testrand.Bytes(32)}
+	require.NoError(t, dir.Commit(ctx, file, ref))
+
+	unsynced := func() int {
+		dir.mu.Lock()
+		defer dir.mu.Unlock()
+		return len(dir.unsynced)
+	}
+
+	// the batch isn't full, so the blob waits for the interval
+	require.Equal(t, 1, unsynced())
+
+	dir.syncCycle.TriggerWait()
+	require.Equal(t, 0, unsynced())
+
+	require.NoError(t, dir.Close(ctx))
+}
@@ -41,8 +41,11 @@ func NewAt(path string) (*Store, error) {
 	return &Store{dir}, nil
 }
 
-// Close closes the store.
-func (store *Store) Close() error { return nil }
+// Close closes the store, flushing the blobs that haven't been flushed to the disk yet.
+func (store *Store) Close() error { return Error.Wrap(store.dir.Close(context.TODO())) }
+
+// SyncMode returns when the committed blobs are flushed to the disk.
+func (store *Store) SyncMode() SyncMode { return store.dir.SyncMode() }
 
 // Open loads blob with the specified hash
 func (store *Store) Open(ctx context.Context, ref storage.BlobRef) (_ storage.BlobReader, err error) {
@@ -64,6 +67,13 @@ func (store *Store) Delete(ctx context.Context, ref storage.BlobRef) (err error)
 	return Error.Wrap(err)
 }
 
+// Exists returns whether the blob with the specified ref is stored
+func (store *Store) Exists(ctx context.Context, ref storage.BlobRef) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	exists, err := store.dir.Exists(ctx, ref)
+	return exists, Error.Wrap(err)
+}
+
 // GarbageCollect tries to delete any files that haven't yet been deleted
 func (store *Store) GarbageCollect(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -82,6 +92,12 @@ func (store *Store) Create(ctx context.Context, ref storage.BlobRef, size int64)
 	return newBlobWriter(ref, store, file), nil
 }
 
+// WalkNamespace calls walkFunc for every blob stored in the namespace
+func (store *Store) WalkNamespace(ctx context.Context, namespace []byte, walkFunc func(storage.BlobRef) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.dir.WalkNamespace(ctx, namespace, walkFunc)
+}
+
 // FreeSpace returns how much space left in underlying directory
 func (store *Store) FreeSpace() (int64, error) {
 	info, err := store.dir.Info()
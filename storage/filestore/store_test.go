@@ -219,3 +219,51 @@ func TestDeleteWhileReading(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestStoreSyncModes(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	for _, name := range []string{"always", "batch", "never"} {
+		var mode filestore.SyncMode
+		require.NoError(t, mode.Set(name))
+		require.Equal(t, name, mode.String())
+
+		dir, err := filestore.NewDirWithSync(ctx.Dir("store", name), mode)
+		require.NoError(t, err)
+		store := filestore.New(dir)
+		require.Equal(t, mode, store.SyncMode())
+
+		data := testrand.Bytes(1 << 10)
+		namespace := testrand.Bytes(32)
+
+		// more blobs than fit a single batch
+		var refs []storage.BlobRef
+		for i := 0; i < 100; i++ {
+			ref := storage.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+			refs = append(refs, ref)
+
+			writer, err := store.Create(ctx, ref, int64(len(data)))
+			require.NoError(t, err)
+			_, err = writer.Write(data)
+			require.NoError(t, err)
+			require.NoError(t, writer.Commit(ctx))
+		}
+
+		// deleted blobs waiting to be flushed are skipped
+		require.NoError(t, store.Delete(ctx, refs[len(refs)-1]))
+		require.NoError(t, store.Close())
+
+		for _, ref := range refs[:len(refs)-1] {
+			reader, err := store.Open(ctx, ref)
+			require.NoError(t, err)
+			read, err := ioutil.ReadAll(reader)
+			require.NoError(t, err)
+			require.Equal(t, data, read)
+			require.NoError(t, reader.Close())
+		}
+	}
+
+	var mode filestore.SyncMode
+	require.Error(t, mode.Set("sometimes"))
+}
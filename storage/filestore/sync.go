@@ -0,0 +1,145 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package filestore
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/internal/sync2"
+)
+
+// SyncMode defines when committed blobs are flushed to the disk.
+type SyncMode uint32
+
+const (
+	// SyncAlways flushes every blob to the disk before it is committed.
+	SyncAlways SyncMode = iota
+	// SyncBatch flushes the committed blobs to the disk once syncBatchSize
+	// of them have accumulated or syncBatchInterval has passed, and when the
+	// store is closed.
+	SyncBatch
+	// SyncNever leaves flushing the blobs to the operating system.
+	SyncNever
+)
+
+const (
+	// syncBatchSize is the number of committed blobs that are flushed together with SyncBatch.
+	syncBatchSize = 64
+	// syncBatchInterval is how often the committed blobs are flushed with SyncBatch,
+	// when the batch doesn't fill up earlier.
+	syncBatchInterval = 5 * time.Second
+)
+
+// Set implements pflag.Value
+func (mode *SyncMode) Set(s string) error {
+	switch s {
+	case "always":
+		*mode = SyncAlways
+	case "batch":
+		*mode = SyncBatch
+	case "never":
+		*mode = SyncNever
+	default:
+		return Error.New("invalid SyncMode %q", s)
+	}
+	return nil
+}
+
+// Type implements pflag.Value
+func (*SyncMode) Type() string { return "filestore.SyncMode" }
+
+// String implements pflag.Value
+func (mode *SyncMode) String() string {
+	switch *mode {
+	case SyncAlways:
+		return "always"
+	case SyncBatch:
+		return "batch"
+	case SyncNever:
+		return "never"
+	default:
+		return "invalid"
+	}
+}
+
+// startSyncing starts flushing the committed blobs every syncBatchInterval.
+func (dir *Dir) startSyncing() {
+	dir.syncCycle = sync2.NewCycle(syncBatchInterval)
+	dir.syncCycle.Start(context.Background(), &dir.syncGroup, func(ctx context.Context) error {
+		// there's no caller to return the error to, so it's returned on Close
+		err := dir.Sync(ctx)
+		if err != nil {
+			dir.mu.Lock()
+			if dir.syncErr == nil {
+				dir.syncErr = err
+			}
+			dir.mu.Unlock()
+		}
+		return nil
+	})
+}
+
+// Close stops flushing the committed blobs in the background and flushes
+// the ones that haven't been flushed to the disk yet.
+func (dir *Dir) Close(ctx context.Context) (err error) {
+	if dir.syncCycle != nil {
+		dir.syncCycle.Close()
+		err = dir.syncGroup.Wait()
+		dir.syncCycle = nil
+	}
+
+	dir.mu.Lock()
+	syncErr := dir.syncErr
+	dir.syncErr = nil
+	dir.mu.Unlock()
+
+	return errs.Combine(err, syncErr, dir.Sync(ctx))
+}
+
+// addUnsynced records a committed blob that hasn't been flushed to the disk
+// yet, flushing all of them once the batch is full.
+func (dir *Dir) addUnsynced(ctx context.Context, path string) (err error) {
+	dir.mu.Lock()
+	dir.unsynced = append(dir.unsynced, path)
+	full := len(dir.unsynced) >= syncBatchSize
+	dir.mu.Unlock()
+
+	if !full {
+		return nil
+	}
+	return dir.Sync(ctx)
+}
+
+// Sync flushes the committed blobs that haven't been flushed to the disk yet.
+func (dir *Dir) Sync(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dir.mu.Lock()
+	unsynced := dir.unsynced
+	dir.unsynced = nil
+	dir.mu.Unlock()
+
+	var group errs.Group
+	for _, path := range unsynced {
+		group.Add(syncFile(path))
+	}
+	return group.Err()
+}
+
+// syncFile flushes the file at path to the disk. Files that have been
+// deleted meanwhile are ignored.
+func syncFile(path string) error {
+	file, err := os.OpenFile(path, os.O_RDWR, blobPermission)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	return errs.Combine(file.Sync(), file.Close())
+}
@@ -83,14 +83,16 @@ func (service *Service) Collect(ctx context.Context, now time.Time) (err error)
 		}
 	}()
 
+	var cursor pieces.ExpiredCursor
 	for k := 0; k < maxBatches; k++ {
-		infos, err := service.pieceinfos.GetExpired(ctx, now, batchSize)
+		infos, next, err := service.pieceinfos.GetExpiredPaged(ctx, now, cursor, batchSize)
 		if err != nil {
 			return err
 		}
 		if len(infos) == 0 {
 			return nil
 		}
+		cursor = next
 
 		for _, expired := range infos {
 			err := service.pieces.Delete(ctx, expired.SatelliteID, expired.PieceID)
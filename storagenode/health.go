@@ -0,0 +1,39 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storagenode
+
+import (
+	"context"
+	"time"
+)
+
+// Health is the status of the storage node subsystems.
+// A nil error means that the subsystem is healthy.
+type Health struct {
+	// Database is the error from reaching the database.
+	Database error
+	// Pieces is the error from accessing the pieces blob store.
+	Pieces error
+	// OrdersSender is the error from the last run of the orders sender.
+	OrdersSender error
+	// OrdersSentAt is when the orders sender last ran, zero when it hasn't run yet.
+	OrdersSentAt time.Time
+}
+
+// OK returns true when all subsystems are healthy.
+func (health Health) OK() bool {
+	return health.Database == nil && health.Pieces == nil && health.OrdersSender == nil
+}
+
+// Health checks the database, the pieces blob store and the orders sender.
+func (peer *Peer) Health(ctx context.Context) (health Health) {
+	defer mon.Task()(&ctx)(nil)
+
+	health.Database = peer.DB.Ping(ctx)
+	_, health.Pieces = peer.DB.Pieces().FreeSpace()
+	if peer.Storage2.Sender != nil {
+		health.OrdersSentAt, health.OrdersSender = peer.Storage2.Sender.LastRun()
+	}
+	return health
+}
@@ -0,0 +1,35 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storagenode_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+)
+
+func TestHealth(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		node := planet.StorageNodes[0]
+
+		node.Storage2.Sender.Loop.TriggerWait()
+
+		health := node.Health(ctx)
+		require.True(t, health.OK(), "unexpected health: %+v", health)
+		require.False(t, health.OrdersSentAt.IsZero())
+
+		require.NoError(t, planet.StopPeer(node))
+		require.NoError(t, node.DB.Close())
+
+		health = node.Health(ctx)
+		require.False(t, health.OK())
+		require.Error(t, health.Database)
+		require.NoError(t, health.Pieces)
+	})
+}
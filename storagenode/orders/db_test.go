@@ -169,3 +169,57 @@ func TestDB_Trivial(t *testing.T) {
 		}
 	})
 }
+
+func TestCountByAge(t *testing.T) {
+	storagenodedbtest.Run(t, func(t *testing.T, db storagenode.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		ordersdb := db.Orders()
+
+		now := time.Now()
+		satellite0, satellite1 := testrand.NodeID(), testrand.NodeID()
+
+		enqueue := func(satelliteID storj.NodeID, created time.Time) storj.SerialNumber {
+			serialNumber := testrand.SerialNumber()
+			err := ordersdb.Enqueue(ctx, &orders.Info{
+				Limit: &pb.OrderLimit{
+					SerialNumber:    serialNumber,
+					SatelliteId:     satelliteID,
+					OrderCreation:   created,
+					OrderExpiration: created.Add(7 * 24 * time.Hour),
+				},
+				Order: &pb.Order{SerialNumber: serialNumber, Amount: 10},
+			})
+			require.NoError(t, err)
+			return serialNumber
+		}
+
+		enqueue(satellite0, now.Add(-time.Minute))
+		enqueue(satellite0, now.Add(-2*time.Hour))
+		enqueue(satellite0, now.Add(-3*time.Hour))
+		enqueue(satellite0, now.Add(-3*24*time.Hour))
+		enqueue(satellite1, now.Add(-3*24*time.Hour))
+
+		archivedSerial := enqueue(satellite1, now.Add(-time.Minute))
+		err := ordersdb.Archive(ctx, orders.ArchiveRequest{satellite1, archivedSerial, orders.StatusAccepted})
+		require.NoError(t, err)
+
+		bounds := []time.Duration{24 * time.Hour, time.Hour}
+
+		counts, err := ordersdb.CountByAge(ctx, now, bounds)
+		require.NoError(t, err)
+		require.Equal(t, map[orders.AgeBucket]int{
+			{Satellite: satellite0, Status: orders.StatusUnsent, MinAge: 0}:              1,
+			{Satellite: satellite0, Status: orders.StatusUnsent, MinAge: time.Hour}:      2,
+			{Satellite: satellite0, Status: orders.StatusUnsent, MinAge: 24 * time.Hour}: 1,
+			{Satellite: satellite1, Status: orders.StatusUnsent, MinAge: 24 * time.Hour}: 1,
+			{Satellite: satellite1, Status: orders.StatusAccepted, MinAge: 0}:            1,
+		}, counts)
+
+		// archived orders age from the time they were archived
+		counts, err = ordersdb.CountByAge(ctx, now.Add(2*24*time.Hour), bounds)
+		require.NoError(t, err)
+		require.Equal(t, 1, counts[orders.AgeBucket{Satellite: satellite1, Status: orders.StatusAccepted, MinAge: 24 * time.Hour}])
+	})
+}
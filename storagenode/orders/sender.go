@@ -6,6 +6,7 @@ package orders
 import (
 	"context"
 	"io"
+	"sync"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -23,6 +24,8 @@ import (
 var (
 	// OrderError represents errors with orders
 	OrderError = errs.Class("order")
+	// ErrDraining is returned when enqueueing orders while the sender is draining
+	ErrDraining = errs.Class("orders draining")
 
 	mon = monkit.Package()
 )
@@ -59,6 +62,21 @@ type ArchiveRequest struct {
 	Status    Status
 }
 
+// AgeBucket groups orders by satellite, status and age.
+type AgeBucket struct {
+	Satellite storj.NodeID
+	Status    Status
+	// MinAge is the largest of the bounds given to CountByAge that the age
+	// of the orders reaches, or zero when they are younger than all bounds.
+	MinAge time.Duration
+}
+
+// Queue accepts orders that need to be sent to the satellite.
+type Queue interface {
+	// Enqueue inserts order to the list of orders needing to be sent to the satellite.
+	Enqueue(ctx context.Context, info *Info) error
+}
+
 // DB implements storing orders for sending to the satellite.
 type DB interface {
 	// Enqueue inserts order to the list of orders needing to be sent to the satellite.
@@ -72,6 +90,9 @@ type DB interface {
 	Archive(ctx context.Context, requests ...ArchiveRequest) error
 	// ListArchived returns orders that have been sent.
 	ListArchived(ctx context.Context, limit int) ([]*ArchivedInfo, error)
+
+	// CountByAge returns the number of unsent and archived orders per satellite, status and age bucket.
+	CountByAge(ctx context.Context, now time.Time, bounds []time.Duration) (map[AgeBucket]int, error)
 }
 
 // SenderConfig defines configuration for sending orders.
@@ -90,6 +111,16 @@ type Sender struct {
 	trust     *trust.Pool
 
 	Loop sync2.Cycle
+
+	// sending ensures that orders are sent by a single run at a time
+	sending sync.Mutex
+
+	drainMu  sync.RWMutex
+	draining bool
+
+	mu      sync.Mutex
+	lastRun time.Time
+	lastErr error
 }
 
 // NewSender creates an order sender.
@@ -111,8 +142,54 @@ func (sender *Sender) Run(ctx context.Context) (err error) {
 	return sender.Loop.Run(ctx, sender.runOnce)
 }
 
+// Enqueue adds the order to the orders that need to be sent to the satellite.
+// It fails with ErrDraining once Drain has been called.
+func (sender *Sender) Enqueue(ctx context.Context, info *Info) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	sender.drainMu.RLock()
+	defer sender.drainMu.RUnlock()
+
+	if sender.draining {
+		return ErrDraining.New("not accepting new orders")
+	}
+	return sender.orders.Enqueue(ctx, info)
+}
+
+// Drain stops accepting new orders and sends all unsent orders to the
+// satellites. It returns an error when some orders could not be sent.
+func (sender *Sender) Drain(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// waits for the orders that are being enqueued
+	sender.drainMu.Lock()
+	sender.draining = true
+	sender.drainMu.Unlock()
+
+	if err := sender.runOnce(ctx); err != nil {
+		return err
+	}
+
+	unsent, err := sender.orders.ListUnsentBySatellite(ctx)
+	if err != nil {
+		return OrderError.Wrap(err)
+	}
+	count := 0
+	for _, orders := range unsent {
+		count += len(orders)
+	}
+	if count > 0 {
+		return OrderError.New("%d orders left unsent", count)
+	}
+	return nil
+}
+
 func (sender *Sender) runOnce(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
+
+	sender.sending.Lock()
+	defer sender.sending.Unlock()
+
 	sender.log.Debug("sending")
 
 	const batchSize = 1000
@@ -120,6 +197,7 @@ func (sender *Sender) runOnce(ctx context.Context) (err error) {
 	ordersBySatellite, err := sender.orders.ListUnsentBySatellite(ctx)
 	if err != nil {
 		sender.log.Error("listing orders", zap.Error(err))
+		sender.setLastRun(err)
 		return nil
 	}
 
@@ -146,7 +224,25 @@ func (sender *Sender) runOnce(ctx context.Context) (err error) {
 	}
 
 	close(requests)
-	return batchGroup.Wait()
+	err = batchGroup.Wait()
+	sender.setLastRun(err)
+	return err
+}
+
+// LastRun returns when the orders were last sent and the error that
+// prevented sending them, if any. It returns a zero time when no orders
+// have been sent yet.
+func (sender *Sender) LastRun() (time.Time, error) {
+	sender.mu.Lock()
+	defer sender.mu.Unlock()
+	return sender.lastRun, sender.lastErr
+}
+
+func (sender *Sender) setLastRun(err error) {
+	sender.mu.Lock()
+	defer sender.mu.Unlock()
+	sender.lastRun = time.Now()
+	sender.lastErr = err
 }
 
 // Settle uploads orders to the satellite.
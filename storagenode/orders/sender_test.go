@@ -0,0 +1,59 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package orders_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/storagenode/orders"
+)
+
+func TestSenderDrain(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		for _, storageNode := range planet.StorageNodes {
+			storageNode.Storage2.Sender.Loop.Pause()
+		}
+
+		err := planet.Uplinks[0].Upload(ctx, planet.Satellites[0], "testbucket", "test/path", testrand.Bytes(50*memory.KiB))
+		require.NoError(t, err)
+
+		drained := 0
+		for _, storageNode := range planet.StorageNodes {
+			unsent, err := storageNode.DB.Orders().ListUnsent(ctx, 100)
+			require.NoError(t, err)
+			if len(unsent) == 0 {
+				// the upload finished without this node
+				continue
+			}
+			drained++
+
+			sender := storageNode.Storage2.Sender
+			require.NoError(t, sender.Drain(ctx))
+
+			remaining, err := storageNode.DB.Orders().ListUnsent(ctx, 100)
+			require.NoError(t, err)
+			require.Empty(t, remaining)
+
+			archived, err := storageNode.DB.Orders().ListArchived(ctx, 100)
+			require.NoError(t, err)
+			require.Len(t, archived, len(unsent))
+
+			err = sender.Enqueue(ctx, &orders.Info{
+				Limit: unsent[0].Limit,
+				Order: &pb.Order{SerialNumber: testrand.SerialNumber(), Amount: 1},
+			})
+			require.True(t, orders.ErrDraining.Has(err), err)
+		}
+		require.NotZero(t, drained)
+	})
+}
@@ -49,6 +49,8 @@ type DB interface {
 	CreateTables() error
 	// Close closes the database
 	Close() error
+	// Ping checks that the database can be reached
+	Ping(ctx context.Context) error
 
 	Pieces() storage.Blobs
 
@@ -225,7 +227,19 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config Config, ver
 			return nil, errs.Combine(err, peer.Close())
 		}
 
-		peer.Storage2.Store = pieces.NewStore(peer.Log.Named("pieces"), peer.DB.Pieces())
+		peer.Storage2.Store = pieces.NewStore(peer.Log.Named("pieces"), peer.DB.Pieces(), peer.DB.PieceInfo(), config.Storage2.PreallocSize)
+
+		initCtx, cancel := context.WithCancel(context.TODO())
+		if config.Storage2.SpaceUsedInitTimeout > 0 {
+			initCtx, cancel = context.WithTimeout(initCtx, config.Storage2.SpaceUsedInitTimeout)
+		}
+		_, err = peer.Storage2.Store.InitSpaceUsedLive(initCtx)
+		cancel()
+		if err != nil {
+			peer.Log.Warn("unable to initialize space used", zap.Error(err))
+		}
+		peer.Storage2.Store.SetRateLimit(pieces.OperationScrub, config.Storage2.ScrubRateLimit)
+		peer.Storage2.Store.SetRateLimit(pieces.OperationTransfer, config.Storage2.TransferRateLimit)
 
 		peer.Storage2.Monitor = monitor.NewService(
 			log.Named("piecestore:monitor"),
@@ -240,6 +254,15 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config Config, ver
 			config.Storage2.Monitor,
 		)
 
+		// orders are enqueued through the sender, so that it can stop accepting them when draining
+		peer.Storage2.Sender = orders.NewSender(
+			log.Named("piecestore:orderssender"),
+			peer.Transport,
+			peer.DB.Orders(),
+			peer.Storage2.Trust,
+			config.Storage2.Sender,
+		)
+
 		peer.Storage2.Endpoint, err = piecestore.NewEndpoint(
 			peer.Log.Named("piecestore"),
 			signing.SignerFromFullIdentity(peer.Identity),
@@ -247,7 +270,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config Config, ver
 			peer.Storage2.Monitor,
 			peer.Storage2.Store,
 			peer.DB.PieceInfo(),
-			peer.DB.Orders(),
+			peer.Storage2.Sender,
 			peer.DB.Bandwidth(),
 			peer.DB.UsedSerials(),
 			config.Storage2,
@@ -256,14 +279,6 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config Config, ver
 			return nil, errs.Combine(err, peer.Close())
 		}
 		pb.RegisterPiecestoreServer(peer.Server.GRPC(), peer.Storage2.Endpoint)
-
-		peer.Storage2.Sender = orders.NewSender(
-			log.Named("piecestore:orderssender"),
-			peer.Transport,
-			peer.DB.Orders(),
-			peer.Storage2.Trust,
-			config.Storage2.Sender,
-		)
 	}
 
 	{ // setup node stats service
@@ -4,12 +4,14 @@
 package pieces_test
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
 
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/testidentity"
@@ -20,6 +22,7 @@ import (
 	"storj.io/storj/storagenode"
 	"storj.io/storj/storagenode/orders"
 	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/storagenodedb"
 	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
 )
 
@@ -172,6 +175,68 @@ func TestPieceInfo(t *testing.T) {
 	})
 }
 
+func TestPieceInfo_GetExpiredPaged(t *testing.T) {
+	storagenodedbtest.Run(t, func(t *testing.T, db storagenode.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		pieceinfos := db.PieceInfo()
+
+		now := time.Now()
+		satellites := []storj.NodeID{testrand.NodeID(), testrand.NodeID()}
+
+		// add expired pieces, several of them sharing an expiration time
+		expected := map[storj.PieceID]bool{}
+		for i := 0; i < 25; i++ {
+			pieceID := testrand.PieceID()
+			err := pieceinfos.Add(ctx, &pieces.Info{
+				SatelliteID:     satellites[i%len(satellites)],
+				PieceID:         pieceID,
+				PieceSize:       int64(i),
+				PieceCreation:   now.Add(-48 * time.Hour),
+				PieceExpiration: now.Add(-time.Duration(i/3+1) * time.Hour),
+				OrderLimit:      &pb.OrderLimit{},
+				UplinkPieceHash: &pb.PieceHash{},
+			})
+			require.NoError(t, err)
+			expected[pieceID] = true
+		}
+
+		// add a piece that isn't expired yet
+		err := pieceinfos.Add(ctx, &pieces.Info{
+			SatelliteID:     satellites[0],
+			PieceID:         testrand.PieceID(),
+			PieceSize:       1,
+			PieceCreation:   now.Add(-48 * time.Hour),
+			PieceExpiration: now.Add(time.Hour),
+			OrderLimit:      &pb.OrderLimit{},
+			UplinkPieceHash: &pb.PieceHash{},
+		})
+		require.NoError(t, err)
+
+		const limit = 7
+		seen := map[storj.PieceID]bool{}
+		var cursor pieces.ExpiredCursor
+		for {
+			page, next, err := pieceinfos.GetExpiredPaged(ctx, now, cursor, limit)
+			require.NoError(t, err)
+			require.True(t, len(page) <= limit)
+
+			for _, info := range page {
+				require.False(t, seen[info.PieceID], "piece returned twice")
+				seen[info.PieceID] = true
+			}
+
+			if len(page) < limit {
+				break
+			}
+			cursor = next
+		}
+
+		assert.Equal(t, expected, seen)
+	})
+}
+
 func TestPieceInfo_Trivial(t *testing.T) {
 	storagenodedbtest.Run(t, func(t *testing.T, db storagenode.DB) {
 		ctx := testcontext.New(t)
@@ -216,3 +281,64 @@ func TestPieceInfo_Trivial(t *testing.T) {
 		}
 	})
 }
+
+func TestSpaceUsedSnapshot(t *testing.T) {
+	storagenodedbtest.Run(t, func(t *testing.T, db storagenode.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		pieceinfos := db.PieceInfo()
+		satelliteID := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion()).ID
+
+		// load the space used before adding pieces, so that they are counted only once
+		spaceUsed, err := pieceinfos.SpaceUsed(ctx)
+		require.NoError(t, err)
+		require.Equal(t, int64(0), spaceUsed)
+
+		for _, size := range []int64{100, 250} {
+			pieceID := testrand.PieceID()
+			require.NoError(t, pieceinfos.Add(ctx, &pieces.Info{
+				SatelliteID:     satelliteID,
+				PieceID:         pieceID,
+				PieceSize:       size,
+				PieceCreation:   time.Now(),
+				OrderLimit:      &pb.OrderLimit{},
+				UplinkPieceHash: &pb.PieceHash{PieceId: pieceID},
+			}))
+		}
+
+		snapshot, err := pieceinfos.SnapshotSpaceUsed(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(350), snapshot.Total)
+
+		// the snapshot survives being persisted
+		data, err := json.Marshal(snapshot)
+		require.NoError(t, err)
+		var persisted pieces.SpaceUsedSnapshot
+		require.NoError(t, json.Unmarshal(data, &persisted))
+		assert.Equal(t, snapshot.Total, persisted.Total)
+		assert.True(t, snapshot.CreatedAt.Equal(persisted.CreatedAt))
+
+		// restore into an empty database, so that a calculation would find no pieces
+		infodb, err := storagenodedb.NewInfoTest()
+		require.NoError(t, err)
+		defer ctx.Check(infodb.Close)
+		require.NoError(t, infodb.CreateTables(zaptest.NewLogger(t)))
+
+		restored := infodb.PieceInfo()
+		require.NoError(t, restored.RestoreSpaceUsed(ctx, persisted))
+
+		spaceUsed, err = restored.SpaceUsed(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, snapshot.Total, spaceUsed)
+
+		// restoring is only possible before the space used is loaded
+		require.Error(t, restored.RestoreSpaceUsed(ctx, persisted))
+
+		// verification finds that none of the restored pieces are stored
+		store := pieces.NewStore(zaptest.NewLogger(t), db.Pieces(), restored, 0)
+		difference, err := store.VerifySpaceUsed(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, -snapshot.Total, difference)
+	})
+}
@@ -0,0 +1,116 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pieces
+
+import (
+	"context"
+	"time"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+)
+
+// OperationClass identifies the kind of background work a piece is read or written for.
+// Each class can be rate limited separately, so that it doesn't compete with uplink traffic.
+type OperationClass int
+
+const (
+	// OperationScrub is used when comparing pieces on disk against their piece information.
+	OperationScrub OperationClass = iota + 1
+	// OperationTransfer is used when transferring pieces to another node.
+	OperationTransfer
+)
+
+// SetRateLimit limits reads and writes of class to rate bytes per second.
+// A non-positive rate removes the limit. It must be called before the store is used.
+func (store *Store) SetRateLimit(class OperationClass, rate memory.Size) {
+	if rate <= 0 {
+		delete(store.rateLimits, class)
+		return
+	}
+	if store.rateLimits == nil {
+		store.rateLimits = make(map[OperationClass]memory.Size)
+	}
+	store.rateLimits[class] = rate
+}
+
+// ThrottledWriter returns a new piece writer, limited to the rate configured for class.
+func (store *Store) ThrottledWriter(ctx context.Context, class OperationClass, satellite storj.NodeID, pieceID storj.PieceID) (_ *Writer, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	writer, err := store.Writer(ctx, satellite, pieceID)
+	if err != nil {
+		return nil, err
+	}
+	if rate, ok := store.rateLimits[class]; ok {
+		writer.blob = &rateLimitedBlobWriter{writer.blob, newRateLimiter(rate)}
+	}
+	return writer, nil
+}
+
+// ThrottledReader returns a new piece reader, limited to the rate configured for class.
+func (store *Store) ThrottledReader(ctx context.Context, class OperationClass, satellite storj.NodeID, pieceID storj.PieceID) (_ *Reader, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	reader, err := store.Reader(ctx, satellite, pieceID)
+	if err != nil {
+		return nil, err
+	}
+	if rate, ok := store.rateLimits[class]; ok {
+		reader.blob = &rateLimitedBlobReader{reader.blob, newRateLimiter(rate)}
+	}
+	return reader, nil
+}
+
+// rateLimiter delays a transfer so that its average rate doesn't exceed the limit.
+type rateLimiter struct {
+	rate  memory.Size
+	start time.Time
+	total int64
+}
+
+func newRateLimiter(rate memory.Size) *rateLimiter {
+	return &rateLimiter{rate: rate, start: time.Now()}
+}
+
+// transferred accounts for n transferred bytes, sleeping until the transfer is back under the limit.
+func (limiter *rateLimiter) transferred(n int) {
+	limiter.total += int64(n)
+
+	expected := time.Duration(float64(limiter.total) / float64(limiter.rate) * float64(time.Second))
+	if elapsed := time.Since(limiter.start); elapsed < expected {
+		time.Sleep(expected - elapsed)
+	}
+}
+
+// rateLimitedBlobReader limits the rate of reads from a blob.
+type rateLimitedBlobReader struct {
+	storage.BlobReader
+	limiter *rateLimiter
+}
+
+func (blob *rateLimitedBlobReader) Read(data []byte) (int, error) {
+	n, err := blob.BlobReader.Read(data)
+	blob.limiter.transferred(n)
+	return n, err
+}
+
+func (blob *rateLimitedBlobReader) ReadAt(data []byte, offset int64) (int, error) {
+	n, err := blob.BlobReader.ReadAt(data, offset)
+	blob.limiter.transferred(n)
+	return n, err
+}
+
+// rateLimitedBlobWriter limits the rate of writes to a blob.
+type rateLimitedBlobWriter struct {
+	storage.BlobWriter
+	limiter *rateLimiter
+}
+
+func (blob *rateLimitedBlobWriter) Write(data []byte) (int, error) {
+	n, err := blob.BlobWriter.Write(data)
+	blob.limiter.transferred(n)
+	return n, err
+}
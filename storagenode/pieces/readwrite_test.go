@@ -27,7 +27,7 @@ func BenchmarkReadWrite(b *testing.B) {
 	blobs := filestore.New(dir)
 	defer ctx.Check(blobs.Close)
 
-	store := pieces.NewStore(zap.NewNop(), blobs)
+	store := pieces.NewStore(zap.NewNop(), blobs, nil, 0)
 
 	// setup test parameters
 	const blockSize = int(256 * memory.KiB)
@@ -0,0 +1,165 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pieces
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"io"
+	"os"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/pkcrypto"
+	"storj.io/storj/pkg/storj"
+)
+
+// scrubBatchSize is the number of piece ids loaded from the database at once.
+const scrubBatchSize = 1000
+
+// ScrubIssue describes how a blob differs from its piece information.
+type ScrubIssue int
+
+const (
+	// ScrubMissingBlob means the piece information exists, but the blob does not.
+	ScrubMissingBlob ScrubIssue = iota + 1
+	// ScrubSizeMismatch means the blob size differs from the recorded piece size.
+	ScrubSizeMismatch
+	// ScrubHashMismatch means the blob content does not match the uplink piece hash.
+	ScrubHashMismatch
+)
+
+// String returns a human readable description of the issue.
+func (issue ScrubIssue) String() string {
+	switch issue {
+	case ScrubMissingBlob:
+		return "missing blob"
+	case ScrubSizeMismatch:
+		return "size mismatch"
+	case ScrubHashMismatch:
+		return "hash mismatch"
+	default:
+		return "unknown issue"
+	}
+}
+
+// ScrubV0 compares the pieces of satellite, which are stored in the V0 format
+// with their size and hash kept in the pieceinfo database instead of a blob
+// header, against the blobs on disk. Every inconsistency is reported to fn,
+// which may delete or re-fetch the piece.
+func (store *Store) ScrubV0(ctx context.Context, satellite storj.NodeID, fn func(pieceID storj.PieceID, issue ScrubIssue) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// collect all ids first, deletions by fn would otherwise shift the pages
+	var pieceIDs []storj.PieceID
+	createdBefore := time.Now()
+	for offset := 0; ; offset += scrubBatchSize {
+		batch, err := store.pieceinfos.GetPieceIDs(ctx, satellite, createdBefore, scrubBatchSize, offset)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		pieceIDs = append(pieceIDs, batch...)
+		if len(batch) < scrubBatchSize {
+			break
+		}
+	}
+
+	for _, pieceID := range pieceIDs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		issue, err := store.scrubPiece(ctx, satellite, pieceID)
+		if err != nil {
+			return err
+		}
+		if issue == 0 {
+			continue
+		}
+
+		err = fn(pieceID, issue)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// scrubPiece checks a single piece and returns the issue found, if any.
+func (store *Store) scrubPiece(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) (_ ScrubIssue, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	info, err := store.pieceinfos.Get(ctx, satellite, pieceID)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	reader, err := store.ThrottledReader(ctx, OperationScrub, satellite, pieceID)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return ScrubMissingBlob, nil
+		}
+		return 0, err
+	}
+	defer func() { err = errs.Combine(err, reader.Close()) }()
+
+	if reader.Size() != info.PieceSize {
+		return ScrubSizeMismatch, nil
+	}
+
+	if info.UplinkPieceHash == nil {
+		return 0, nil
+	}
+
+	hash := pkcrypto.NewHash()
+	_, err = io.Copy(hash, reader)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	if !bytes.Equal(hash.Sum(nil), info.UplinkPieceHash.Hash) {
+		return ScrubHashMismatch, nil
+	}
+
+	return 0, nil
+}
+
+// PieceFingerprint returns a content hash of a piece, so that copies of a piece
+// held by different nodes can be compared without transferring them. The hash
+// the uplink signed when uploading the piece is reused when it's known,
+// otherwise the hash is computed from the blob, which is read at the scrub
+// rate limit. A reused hash doesn't reflect later corruption on disk, which
+// ScrubV0 detects.
+func (store *Store) PieceFingerprint(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if store.pieceinfos != nil {
+		info, err := store.pieceinfos.Get(ctx, satellite, pieceID)
+		switch {
+		case err == nil:
+			if hash := info.UplinkPieceHash.GetHash(); len(hash) > 0 {
+				return hash, nil
+			}
+		case errs.Unwrap(err) == sql.ErrNoRows:
+			// the piece isn't recorded, its hash is computed below
+		default:
+			return nil, Error.Wrap(err)
+		}
+	}
+
+	reader, err := store.ThrottledReader(ctx, OperationScrub, satellite, pieceID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, reader.Close()) }()
+
+	hash := pkcrypto.NewHash()
+	_, err = io.Copy(hash, reader)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return hash.Sum(nil), nil
+}
@@ -5,7 +5,10 @@ package pieces
 
 import (
 	"context"
+	"database/sql"
 	"os"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -13,13 +16,15 @@ import (
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
 	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/sync2"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/storage"
 )
 
 const (
-	preallocSize = 4 * memory.MiB
+	// DefaultPreallocSize is the size preallocated for new blobs, when not configured otherwise.
+	DefaultPreallocSize = 4 * memory.MiB
 )
 
 var (
@@ -49,6 +54,13 @@ type ExpiredInfo struct {
 	PieceSize   int64
 }
 
+// ExpiredCursor is the position of the last expired piece of a page returned by GetExpiredPaged
+type ExpiredCursor struct {
+	PieceExpiration time.Time
+	SatelliteID     storj.NodeID
+	PieceID         storj.PieceID
+}
+
 // DB stores meta information about a piece, the actual piece is stored in storage.Blobs
 type DB interface {
 	// Add inserts Info to the database.
@@ -67,31 +79,89 @@ type DB interface {
 	CalculatedSpaceUsed(ctx context.Context) (int64, error)
 	// SpaceUsedBySatellite calculates disk space used by all pieces by satellite
 	SpaceUsedBySatellite(ctx context.Context, satelliteID storj.NodeID) (int64, error)
+	// SnapshotSpaceUsed returns the in memory value for disk space used by all pieces, to be restored with RestoreSpaceUsed
+	SnapshotSpaceUsed(ctx context.Context) (SpaceUsedSnapshot, error)
+	// RestoreSpaceUsed sets the in memory value for disk space used by all pieces from a snapshot, instead of calculating it
+	RestoreSpaceUsed(ctx context.Context, snapshot SpaceUsedSnapshot) error
+	// InitSpaceUsed calculates the in memory value for disk space used by all pieces, unless it's already loaded.
+	// It returns false when ctx is done before the calculation finishes, leaving the value to be calculated when needed.
+	InitSpaceUsed(ctx context.Context) (complete bool, err error)
+	// ReconcileSpaceUsed calculates disk space used by all pieces and resets the in memory value to it.
+	// It returns the correction applied to the in memory value.
+	ReconcileSpaceUsed(ctx context.Context) (correction int64, err error)
 	// GetExpired gets orders that are expired and were created before some time
 	GetExpired(ctx context.Context, expiredAt time.Time, limit int64) ([]ExpiredInfo, error)
+	// GetExpiredPaged gets a page of pieces that are expired, ordered by expiration, starting after cursor.
+	// The zero cursor starts from the beginning. It returns the cursor for the next page.
+	GetExpiredPaged(ctx context.Context, expiredAt time.Time, cursor ExpiredCursor, limit int64) ([]ExpiredInfo, ExpiredCursor, error)
+}
+
+// SpaceUsedSnapshot is the disk space used by all pieces at some point in time.
+// It can be persisted on shutdown and restored on startup, so the space used
+// doesn't have to be calculated again.
+type SpaceUsedSnapshot struct {
+	Total     int64     `json:"total"`
+	CreatedAt time.Time `json:"createdAt"`
 }
 
 // Store implements storing pieces onto a blob storage implementation.
 type Store struct {
-	log   *zap.Logger
-	blobs storage.Blobs
+	log          *zap.Logger
+	blobs        storage.Blobs
+	pieceinfos   DB
+	preallocSize memory.Size
+	rateLimits   map[OperationClass]memory.Size
+
+	spaceUsedComplete bool
 }
 
-// NewStore creates a new piece store
-func NewStore(log *zap.Logger, blobs storage.Blobs) *Store {
+// NewStore creates a new piece store. New blobs get preallocSize bytes
+// preallocated, a non-positive value uses DefaultPreallocSize.
+//
+// The space used by pieces isn't calculated until it's needed. A snapshot can
+// be restored with RestoreSpaceUsed before calling InitSpaceUsedLive to
+// calculate it up front.
+func NewStore(log *zap.Logger, blobs storage.Blobs, pieceinfos DB, preallocSize memory.Size) *Store {
+	if preallocSize <= 0 {
+		preallocSize = DefaultPreallocSize
+	}
 	return &Store{
-		log:   log,
-		blobs: blobs,
+		log:          log,
+		blobs:        blobs,
+		pieceinfos:   pieceinfos,
+		preallocSize: preallocSize,
+	}
+}
+
+// InitSpaceUsedLive calculates the disk space used by all pieces, unless it
+// was already restored with RestoreSpaceUsed. When ctx is done before the
+// calculation finishes, it returns false and the space used is calculated
+// the first time it's needed instead.
+func (store *Store) InitSpaceUsedLive(ctx context.Context) (complete bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	complete, err = store.pieceinfos.InitSpaceUsed(ctx)
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+	if !complete {
+		store.log.Warn("calculating space used was interrupted, it will be calculated when needed")
 	}
+	store.spaceUsedComplete = complete
+	return complete, nil
 }
 
+// SpaceUsedComplete returns whether InitSpaceUsedLive finished initializing
+// the disk space used by all pieces.
+func (store *Store) SpaceUsedComplete() bool { return store.spaceUsedComplete }
+
 // Writer returns a new piece writer.
 func (store *Store) Writer(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) (_ *Writer, err error) {
 	defer mon.Task()(&ctx)(&err)
 	blob, err := store.blobs.Create(ctx, storage.BlobRef{
 		Namespace: satellite.Bytes(),
 		Key:       pieceID.Bytes(),
-	}, preallocSize.Int64())
+	}, store.preallocSize.Int64())
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -128,6 +198,240 @@ func (store *Store) Delete(ctx context.Context, satellite storj.NodeID, pieceID
 	return Error.Wrap(err)
 }
 
+// ExistsBatch returns whether each of pieceIDs of satellite is stored on disk.
+// Only the presence of the blobs is checked, they aren't opened.
+func (store *Store) ExistsBatch(ctx context.Context, satellite storj.NodeID, pieceIDs []storj.PieceID) (_ map[storj.PieceID]bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	exists := make(map[storj.PieceID]bool, len(pieceIDs))
+	for _, pieceID := range pieceIDs {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		found, err := store.blobs.Exists(ctx, storage.BlobRef{
+			Namespace: satellite.Bytes(),
+			Key:       pieceID.Bytes(),
+		})
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		exists[pieceID] = found
+	}
+	return exists, nil
+}
+
+// TransferPiece opens the specified piece together with its stored information
+// so that it can be uploaded to another node during graceful exit.
+func (store *Store) TransferPiece(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) (_ *Reader, _ *Info, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	info, err := store.pieceinfos.Get(ctx, satellite, pieceID)
+	if err != nil {
+		return nil, nil, Error.Wrap(err)
+	}
+
+	reader, err := store.ThrottledReader(ctx, OperationTransfer, satellite, pieceID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return reader, info, nil
+}
+
+// MarkTransferred deletes the local copy of a piece after the new node has
+// confirmed the transfer. Deleting the piece information also updates the
+// space used by pieces.
+func (store *Store) MarkTransferred(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = store.Delete(ctx, satellite, pieceID)
+	if err != nil {
+		return err
+	}
+
+	return Error.Wrap(store.pieceinfos.Delete(ctx, satellite, pieceID))
+}
+
+// StoredPieceAccess identifies a piece stored on disk.
+type StoredPieceAccess struct {
+	Satellite storj.NodeID
+	PieceID   storj.PieceID
+}
+
+// ForAllPieceIDsOwnedBySatellite calls fn for every piece of satellite
+// stored on disk.
+//
+// Pieces may be deleted while the walk is in progress, so when fn fails
+// because its piece doesn't exist anymore, the piece is skipped and the
+// walk continues with the remaining pieces.
+func (store *Store) ForAllPieceIDsOwnedBySatellite(ctx context.Context, satellite storj.NodeID, fn func(StoredPieceAccess) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return store.blobs.WalkNamespace(ctx, satellite.Bytes(), func(ref storage.BlobRef) error {
+		pieceID, err := storj.PieceIDFromBytes(ref.Key)
+		if err != nil {
+			store.log.Warn("unexpected blob", zap.Stringer("satellite id", satellite), zap.Binary("key", ref.Key))
+			return nil
+		}
+
+		err = fn(StoredPieceAccess{Satellite: satellite, PieceID: pieceID})
+		if err != nil && os.IsNotExist(errs.Unwrap(err)) {
+			store.log.Debug("piece deleted during walk", zap.Stringer("satellite id", satellite), zap.Stringer("piece id", pieceID))
+			return nil
+		}
+		return err
+	})
+}
+
+// FindOrphanedBlobs calls fn for every blob of satellite which has no piece
+// information in the database, e.g. after a crash between writing the blob and
+// saving its information.
+func (store *Store) FindOrphanedBlobs(ctx context.Context, satellite storj.NodeID, fn func(StoredPieceAccess) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return store.blobs.WalkNamespace(ctx, satellite.Bytes(), func(ref storage.BlobRef) error {
+		pieceID, err := storj.PieceIDFromBytes(ref.Key)
+		if err != nil {
+			store.log.Warn("unexpected blob", zap.Stringer("satellite id", satellite), zap.Binary("key", ref.Key))
+			return nil
+		}
+
+		_, err = store.pieceinfos.Get(ctx, satellite, pieceID)
+		switch {
+		case err == nil:
+			return nil
+		case errs.Unwrap(err) == sql.ErrNoRows:
+			return fn(StoredPieceAccess{Satellite: satellite, PieceID: pieceID})
+		default:
+			return Error.Wrap(err)
+		}
+	})
+}
+
+// SpaceUsedBySatelliteSlow calculates the disk space used by the pieces of
+// satellite by summing the sizes of its blobs on disk, rather than relying on
+// the piece information in the database. Up to workers blobs are examined in
+// parallel; the walk waits while all of them are busy, so the disk isn't
+// flooded with requests. A workers value below 1 examines one blob at a time.
+func (store *Store) SpaceUsedBySatelliteSlow(ctx context.Context, satellite storj.NodeID, workers int) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if workers < 1 {
+		workers = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var total int64
+	var mu sync.Mutex
+	var firstErr error
+
+	limiter := sync2.NewLimiter(workers)
+	walkErr := store.blobs.WalkNamespace(ctx, satellite.Bytes(), func(ref storage.BlobRef) error {
+		started := limiter.Go(ctx, func() {
+			size, err := store.blobSize(ctx, ref)
+			if err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mu.Unlock()
+				cancel()
+				return
+			}
+			atomic.AddInt64(&total, size)
+		})
+		if !started {
+			return ctx.Err()
+		}
+		return nil
+	})
+	limiter.Wait()
+
+	if firstErr != nil {
+		return 0, Error.Wrap(firstErr)
+	}
+	if walkErr != nil {
+		return 0, Error.Wrap(walkErr)
+	}
+	return total, nil
+}
+
+// blobSize returns the size of the blob on disk. A blob which was deleted
+// after it was found has no size.
+func (store *Store) blobSize(ctx context.Context, ref storage.BlobRef) (_ int64, err error) {
+	blob, err := store.blobs.Open(ctx, ref)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	defer func() { err = errs.Combine(err, blob.Close()) }()
+
+	return blob.Size()
+}
+
+// SnapshotSpaceUsed returns the disk space used by all pieces, to be restored
+// with RestoreSpaceUsed.
+func (store *Store) SnapshotSpaceUsed(ctx context.Context) (_ SpaceUsedSnapshot, err error) {
+	defer mon.Task()(&ctx)(&err)
+	snapshot, err := store.pieceinfos.SnapshotSpaceUsed(ctx)
+	return snapshot, Error.Wrap(err)
+}
+
+// RestoreSpaceUsed sets the disk space used by all pieces from snapshot
+// instead of calculating it. It fails when the space used has already been
+// needed, so it should be called on startup. As pieces may have changed since
+// the snapshot was made, VerifySpaceUsed can be run in the background afterwards.
+func (store *Store) RestoreSpaceUsed(ctx context.Context, snapshot SpaceUsedSnapshot) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return Error.Wrap(store.pieceinfos.RestoreSpaceUsed(ctx, snapshot))
+}
+
+// VerifySpaceUsed calculates the disk space used by all pieces and returns
+// how much it differs from the in memory value. Pieces stored or deleted
+// during the calculation may cause a difference as well.
+func (store *Store) VerifySpaceUsed(ctx context.Context) (difference int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	calculated, err := store.pieceinfos.CalculatedSpaceUsed(ctx)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	live, err := store.pieceinfos.SpaceUsed(ctx)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	difference = calculated - live
+	if difference != 0 {
+		store.log.Warn("space used differs from the pieces stored", zap.Int64("calculated", calculated), zap.Int64("live", live))
+	}
+	return difference, nil
+}
+
+// ReconcileSpaceUsed calculates the disk space used by all pieces and resets
+// the in memory value to it exactly, returning the correction that was applied.
+// Pieces stored or deleted during the calculation are missed until the next
+// reconciliation, so it's best run while there is little traffic.
+func (store *Store) ReconcileSpaceUsed(ctx context.Context) (correction int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	correction, err = store.pieceinfos.ReconcileSpaceUsed(ctx)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	mon.IntVal("space_used_correction").Observe(correction)
+	if correction != 0 {
+		store.log.Info("space used reconciled", zap.Int64("correction", correction))
+	}
+	return correction, nil
+}
+
 // StorageStatus contains information about the disk store is using.
 type StorageStatus struct {
 	DiskUsed int64
@@ -5,20 +5,29 @@ package pieces_test
 
 import (
 	"bytes"
+	"context"
 	"io"
+	"io/ioutil"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
 
+	"storj.io/storj/internal/memory"
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/testidentity"
 	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/pkcrypto"
 	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
 	"storj.io/storj/storage/filestore"
+	"storj.io/storj/storagenode"
 	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
 )
 
 func TestPieces(t *testing.T) {
@@ -31,7 +40,7 @@ func TestPieces(t *testing.T) {
 	blobs := filestore.New(dir)
 	defer ctx.Check(blobs.Close)
 
-	store := pieces.NewStore(zaptest.NewLogger(t), blobs)
+	store := pieces.NewStore(zaptest.NewLogger(t), blobs, nil, 0)
 
 	satelliteID := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion()).ID
 	pieceID := storj.NewPieceID()
@@ -127,3 +136,563 @@ func TestPieces(t *testing.T) {
 		assert.Error(t, err)
 	}
 }
+
+func TestExistsBatch(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	dir, err := filestore.NewDir(ctx.Dir("pieces"))
+	require.NoError(t, err)
+
+	blobs := filestore.New(dir)
+	defer ctx.Check(blobs.Close)
+
+	store := pieces.NewStore(zaptest.NewLogger(t), blobs, nil, 0)
+
+	satelliteID := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion()).ID
+	otherSatelliteID := testidentity.MustPregeneratedSignedIdentity(1, storj.LatestIDVersion()).ID
+
+	writePiece := func(satelliteID storj.NodeID) storj.PieceID {
+		pieceID := testrand.PieceID()
+		writer, err := store.Writer(ctx, satelliteID, pieceID)
+		require.NoError(t, err)
+		_, err = writer.Write(testrand.Bytes(memory.KiB))
+		require.NoError(t, err)
+		require.NoError(t, writer.Commit(ctx))
+		return pieceID
+	}
+
+	stored := writePiece(satelliteID)
+	deleted := writePiece(satelliteID)
+	require.NoError(t, store.Delete(ctx, satelliteID, deleted))
+	otherSatellite := writePiece(otherSatelliteID)
+	missing := testrand.PieceID()
+
+	exists, err := store.ExistsBatch(ctx, satelliteID, []storj.PieceID{stored, deleted, otherSatellite, missing})
+	require.NoError(t, err)
+	assert.Equal(t, map[storj.PieceID]bool{
+		stored:         true,
+		deleted:        false,
+		otherSatellite: false,
+		missing:        false,
+	}, exists)
+
+	exists, err = store.ExistsBatch(ctx, otherSatelliteID, []storj.PieceID{stored, otherSatellite})
+	require.NoError(t, err)
+	assert.Equal(t, map[storj.PieceID]bool{
+		stored:         false,
+		otherSatellite: true,
+	}, exists)
+}
+
+func TestTransferPiece(t *testing.T) {
+	storagenodedbtest.Run(t, func(t *testing.T, db storagenode.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		store := pieces.NewStore(zaptest.NewLogger(t), db.Pieces(), db.PieceInfo(), 0)
+
+		satelliteID := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion()).ID
+		pieceID := storj.NewPieceID()
+		source := testrand.Bytes(8000)
+
+		writer, err := store.Writer(ctx, satelliteID, pieceID)
+		require.NoError(t, err)
+		_, err = io.Copy(writer, bytes.NewReader(source))
+		require.NoError(t, err)
+		require.NoError(t, writer.Commit(ctx))
+
+		now := time.Now()
+		require.NoError(t, db.PieceInfo().Add(ctx, &pieces.Info{
+			SatelliteID:     satelliteID,
+			PieceID:         pieceID,
+			PieceSize:       writer.Size(),
+			PieceCreation:   now,
+			PieceExpiration: now.Add(time.Hour),
+			OrderLimit:      &pb.OrderLimit{},
+			UplinkPieceHash: &pb.PieceHash{PieceId: pieceID, Hash: writer.Hash()},
+		}))
+
+		usedBefore, err := db.PieceInfo().SpaceUsed(ctx)
+		require.NoError(t, err)
+
+		reader, info, err := store.TransferPiece(ctx, satelliteID, pieceID)
+		require.NoError(t, err)
+		assert.Equal(t, writer.Size(), info.PieceSize)
+		assert.Equal(t, writer.Hash(), info.UplinkPieceHash.Hash)
+
+		data, err := ioutil.ReadAll(reader)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+		assert.Equal(t, source, data)
+
+		require.NoError(t, store.MarkTransferred(ctx, satelliteID, pieceID))
+
+		_, err = store.Reader(ctx, satelliteID, pieceID)
+		assert.True(t, os.IsNotExist(err))
+
+		_, err = db.PieceInfo().Get(ctx, satelliteID, pieceID)
+		assert.Error(t, err)
+
+		usedAfter, err := db.PieceInfo().SpaceUsed(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, usedBefore-int64(len(source)), usedAfter)
+	})
+}
+
+func TestScrubV0(t *testing.T) {
+	storagenodedbtest.Run(t, func(t *testing.T, db storagenode.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		store := pieces.NewStore(zaptest.NewLogger(t), db.Pieces(), db.PieceInfo(), 0)
+
+		satelliteID := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion()).ID
+
+		writePiece := func(data []byte, recordedSize int64, recordedHash []byte) storj.PieceID {
+			pieceID := storj.NewPieceID()
+
+			writer, err := store.Writer(ctx, satelliteID, pieceID)
+			require.NoError(t, err)
+			_, err = writer.Write(data)
+			require.NoError(t, err)
+			require.NoError(t, writer.Commit(ctx))
+
+			if recordedHash == nil {
+				recordedHash = writer.Hash()
+			}
+			require.NoError(t, db.PieceInfo().Add(ctx, &pieces.Info{
+				SatelliteID:     satelliteID,
+				PieceID:         pieceID,
+				PieceSize:       recordedSize,
+				PieceCreation:   time.Now().Add(-time.Minute),
+				OrderLimit:      &pb.OrderLimit{},
+				UplinkPieceHash: &pb.PieceHash{PieceId: pieceID, Hash: recordedHash},
+			}))
+			return pieceID
+		}
+
+		data := testrand.Bytes(1000)
+		valid := writePiece(data, 1000, nil)
+		wrongSize := writePiece(data, 2000, nil)
+		wrongHash := writePiece(data, 1000, []byte{1, 2, 3})
+		missing := writePiece(data, 1000, nil)
+		require.NoError(t, store.Delete(ctx, satelliteID, missing))
+
+		issues := map[storj.PieceID]pieces.ScrubIssue{}
+		err := store.ScrubV0(ctx, satelliteID, func(pieceID storj.PieceID, issue pieces.ScrubIssue) error {
+			issues[pieceID] = issue
+			return nil
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, map[storj.PieceID]pieces.ScrubIssue{
+			wrongSize: pieces.ScrubSizeMismatch,
+			wrongHash: pieces.ScrubHashMismatch,
+			missing:   pieces.ScrubMissingBlob,
+		}, issues)
+		assert.NotContains(t, issues, valid)
+	})
+}
+
+func TestPieceFingerprint(t *testing.T) {
+	storagenodedbtest.Run(t, func(t *testing.T, db storagenode.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		store := pieces.NewStore(zaptest.NewLogger(t), db.Pieces(), db.PieceInfo(), 0)
+
+		satelliteID := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion()).ID
+
+		writePiece := func(pieceID storj.PieceID, data []byte) []byte {
+			writer, err := store.Writer(ctx, satelliteID, pieceID)
+			require.NoError(t, err)
+			_, err = writer.Write(data)
+			require.NoError(t, err)
+			require.NoError(t, writer.Commit(ctx))
+			return writer.Hash()
+		}
+
+		data := testrand.Bytes(1000)
+
+		// without piece information the fingerprint is computed from the blob
+		unrecorded := storj.NewPieceID()
+		written := writePiece(unrecorded, data)
+
+		fingerprint, err := store.PieceFingerprint(ctx, satelliteID, unrecorded)
+		require.NoError(t, err)
+		assert.Equal(t, written, fingerprint)
+
+		reread, err := store.PieceFingerprint(ctx, satelliteID, unrecorded)
+		require.NoError(t, err)
+		assert.Equal(t, fingerprint, reread)
+
+		corrupted := append([]byte{}, data...)
+		corrupted[500]++
+		writePiece(unrecorded, corrupted)
+
+		fingerprint, err = store.PieceFingerprint(ctx, satelliteID, unrecorded)
+		require.NoError(t, err)
+		assert.NotEqual(t, written, fingerprint)
+
+		// the hash signed by the uplink is reused without reading the blob
+		recorded := storj.NewPieceID()
+		writePiece(recorded, data)
+		require.NoError(t, db.PieceInfo().Add(ctx, &pieces.Info{
+			SatelliteID:     satelliteID,
+			PieceID:         recorded,
+			PieceSize:       int64(len(data)),
+			PieceCreation:   time.Now(),
+			OrderLimit:      &pb.OrderLimit{},
+			UplinkPieceHash: &pb.PieceHash{PieceId: recorded, Hash: written},
+		}))
+		require.NoError(t, store.Delete(ctx, satelliteID, recorded))
+
+		fingerprint, err = store.PieceFingerprint(ctx, satelliteID, recorded)
+		require.NoError(t, err)
+		assert.Equal(t, written, fingerprint)
+
+		// missing pieces don't have a fingerprint
+		_, err = store.PieceFingerprint(ctx, satelliteID, storj.NewPieceID())
+		require.Error(t, err)
+		assert.True(t, os.IsNotExist(err))
+	})
+}
+
+// preallocBlobs records the size requested when creating blobs.
+type preallocBlobs struct {
+	storage.Blobs
+	sizes []int64
+}
+
+func (blobs *preallocBlobs) Create(ctx context.Context, ref storage.BlobRef, size int64) (storage.BlobWriter, error) {
+	blobs.sizes = append(blobs.sizes, size)
+	return blobs.Blobs.Create(ctx, ref, size)
+}
+
+func TestPreallocSize(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	dir, err := filestore.NewDir(ctx.Dir("pieces"))
+	require.NoError(t, err)
+
+	store := filestore.New(dir)
+	defer ctx.Check(store.Close)
+
+	blobs := &preallocBlobs{Blobs: store}
+
+	satelliteID := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion()).ID
+
+	custom := pieces.NewStore(zaptest.NewLogger(t), blobs, nil, 256*memory.KiB)
+	writer, err := custom.Writer(ctx, satelliteID, storj.NewPieceID())
+	require.NoError(t, err)
+	require.NoError(t, writer.Cancel(ctx))
+
+	defaults := pieces.NewStore(zaptest.NewLogger(t), blobs, nil, 0)
+	writer, err = defaults.Writer(ctx, satelliteID, storj.NewPieceID())
+	require.NoError(t, err)
+	require.NoError(t, writer.Cancel(ctx))
+
+	assert.Equal(t, []int64{256 * memory.KiB.Int64(), pieces.DefaultPreallocSize.Int64()}, blobs.sizes)
+}
+
+func TestFindOrphanedBlobs(t *testing.T) {
+	storagenodedbtest.Run(t, func(t *testing.T, db storagenode.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		store := pieces.NewStore(zaptest.NewLogger(t), db.Pieces(), db.PieceInfo(), 0)
+
+		satelliteID := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion()).ID
+		otherSatelliteID := testidentity.MustPregeneratedSignedIdentity(1, storj.LatestIDVersion()).ID
+
+		writePiece := func(satelliteID storj.NodeID, record bool) storj.PieceID {
+			pieceID := storj.NewPieceID()
+
+			writer, err := store.Writer(ctx, satelliteID, pieceID)
+			require.NoError(t, err)
+			_, err = writer.Write(testrand.Bytes(100))
+			require.NoError(t, err)
+			require.NoError(t, writer.Commit(ctx))
+
+			if record {
+				require.NoError(t, db.PieceInfo().Add(ctx, &pieces.Info{
+					SatelliteID:     satelliteID,
+					PieceID:         pieceID,
+					PieceSize:       writer.Size(),
+					PieceCreation:   time.Now(),
+					OrderLimit:      &pb.OrderLimit{},
+					UplinkPieceHash: &pb.PieceHash{PieceId: pieceID, Hash: writer.Hash()},
+				}))
+			}
+			return pieceID
+		}
+
+		_ = writePiece(satelliteID, true)
+		orphaned := writePiece(satelliteID, false)
+		_ = writePiece(otherSatelliteID, false)
+
+		var found []pieces.StoredPieceAccess
+		err := store.FindOrphanedBlobs(ctx, satelliteID, func(access pieces.StoredPieceAccess) error {
+			found = append(found, access)
+			return nil
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, []pieces.StoredPieceAccess{{Satellite: satelliteID, PieceID: orphaned}}, found)
+	})
+}
+
+func TestForAllPieceIDsOwnedBySatelliteDeletedMidWalk(t *testing.T) {
+	storagenodedbtest.Run(t, func(t *testing.T, db storagenode.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		store := pieces.NewStore(zaptest.NewLogger(t), db.Pieces(), db.PieceInfo(), 0)
+
+		satelliteID := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion()).ID
+
+		stored := make(map[storj.PieceID]bool)
+		for i := 0; i < 5; i++ {
+			pieceID := storj.NewPieceID()
+
+			writer, err := store.Writer(ctx, satelliteID, pieceID)
+			require.NoError(t, err)
+			_, err = writer.Write(testrand.Bytes(100))
+			require.NoError(t, err)
+			require.NoError(t, writer.Commit(ctx))
+
+			stored[pieceID] = true
+		}
+
+		var deleted storj.PieceID
+		visited := make(map[storj.PieceID]bool)
+		err := store.ForAllPieceIDsOwnedBySatellite(ctx, satelliteID, func(access pieces.StoredPieceAccess) error {
+			if deleted.IsZero() {
+				// delete another piece, as a concurrent delete would
+				for pieceID := range stored {
+					if pieceID != access.PieceID {
+						deleted = pieceID
+						break
+					}
+				}
+				require.NoError(t, store.Delete(ctx, satelliteID, deleted))
+			}
+
+			reader, err := store.Reader(ctx, access.Satellite, access.PieceID)
+			if err != nil {
+				return err
+			}
+			visited[access.PieceID] = true
+			return reader.Close()
+		})
+		require.NoError(t, err)
+
+		delete(stored, deleted)
+		assert.Equal(t, stored, visited)
+	})
+}
+
+func TestSpaceUsedBySatelliteSlow(t *testing.T) {
+	storagenodedbtest.Run(t, func(t *testing.T, db storagenode.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		store := pieces.NewStore(zaptest.NewLogger(t), db.Pieces(), db.PieceInfo(), 0)
+
+		satelliteID := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion()).ID
+		otherSatelliteID := testidentity.MustPregeneratedSignedIdentity(1, storj.LatestIDVersion()).ID
+
+		writePiece := func(satelliteID storj.NodeID, size int) int64 {
+			writer, err := store.Writer(ctx, satelliteID, storj.NewPieceID())
+			require.NoError(t, err)
+			_, err = writer.Write(testrand.BytesInt(size))
+			require.NoError(t, err)
+			require.NoError(t, writer.Commit(ctx))
+			return writer.Size()
+		}
+
+		var expected int64
+		for i := 0; i < 100; i++ {
+			expected += writePiece(satelliteID, 100+testrand.Intn(1000))
+		}
+		_ = writePiece(otherSatelliteID, 1000)
+
+		sequential, err := store.SpaceUsedBySatelliteSlow(ctx, satelliteID, 1)
+		require.NoError(t, err)
+
+		parallel, err := store.SpaceUsedBySatelliteSlow(ctx, satelliteID, 8)
+		require.NoError(t, err)
+
+		assert.Equal(t, expected, sequential)
+		assert.Equal(t, sequential, parallel)
+	})
+}
+
+func TestInitSpaceUsedCancelled(t *testing.T) {
+	storagenodedbtest.Run(t, func(t *testing.T, db storagenode.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		cancelled, cancel := context.WithCancel(ctx)
+		cancel()
+
+		store := pieces.NewStore(zaptest.NewLogger(t), db.Pieces(), db.PieceInfo(), 0)
+		complete, err := store.InitSpaceUsedLive(cancelled)
+		require.NoError(t, err)
+		assert.False(t, complete)
+		assert.False(t, store.SpaceUsedComplete())
+
+		// the space used is calculated when needed instead
+		spaceUsed, err := db.PieceInfo().SpaceUsed(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), spaceUsed)
+
+		satelliteID := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion()).ID
+		pieceID := testrand.PieceID()
+		require.NoError(t, db.PieceInfo().Add(ctx, &pieces.Info{
+			SatelliteID:     satelliteID,
+			PieceID:         pieceID,
+			PieceSize:       1000,
+			PieceCreation:   time.Now(),
+			OrderLimit:      &pb.OrderLimit{},
+			UplinkPieceHash: &pb.PieceHash{PieceId: pieceID},
+		}))
+
+		complete, err = store.InitSpaceUsedLive(ctx)
+		require.NoError(t, err)
+		assert.True(t, complete)
+
+		spaceUsed, err = db.PieceInfo().SpaceUsed(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1000), spaceUsed)
+	})
+}
+
+func TestRestoreSpaceUsedAfterNewStore(t *testing.T) {
+	storagenodedbtest.Run(t, func(t *testing.T, db storagenode.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		store := pieces.NewStore(zaptest.NewLogger(t), db.Pieces(), db.PieceInfo(), 0)
+
+		// the snapshot can be restored, because NewStore doesn't calculate the space used
+		require.NoError(t, store.RestoreSpaceUsed(ctx, pieces.SpaceUsedSnapshot{Total: 5000}))
+
+		satelliteID := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion()).ID
+		pieceID := testrand.PieceID()
+		require.NoError(t, db.PieceInfo().Add(ctx, &pieces.Info{
+			SatelliteID:     satelliteID,
+			PieceID:         pieceID,
+			PieceSize:       1000,
+			PieceCreation:   time.Now(),
+			OrderLimit:      &pb.OrderLimit{},
+			UplinkPieceHash: &pb.PieceHash{PieceId: pieceID},
+		}))
+
+		// initializing afterwards keeps the restored value instead of calculating it
+		complete, err := store.InitSpaceUsedLive(ctx)
+		require.NoError(t, err)
+		assert.True(t, complete)
+
+		spaceUsed, err := db.PieceInfo().SpaceUsed(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(6000), spaceUsed)
+
+		// a snapshot can't be restored once the space used is loaded
+		require.Error(t, store.RestoreSpaceUsed(ctx, pieces.SpaceUsedSnapshot{Total: 1}))
+	})
+}
+
+func TestReconcileSpaceUsed(t *testing.T) {
+	storagenodedbtest.Run(t, func(t *testing.T, db storagenode.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		// skew the space used before any piece is stored
+		pieceinfos := db.PieceInfo()
+		require.NoError(t, pieceinfos.RestoreSpaceUsed(ctx, pieces.SpaceUsedSnapshot{Total: 5000}))
+
+		store := pieces.NewStore(zaptest.NewLogger(t), db.Pieces(), pieceinfos, 0)
+
+		satelliteID := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion()).ID
+		for _, size := range []int64{100, 250} {
+			pieceID := testrand.PieceID()
+			require.NoError(t, pieceinfos.Add(ctx, &pieces.Info{
+				SatelliteID:     satelliteID,
+				PieceID:         pieceID,
+				PieceSize:       size,
+				PieceCreation:   time.Now(),
+				OrderLimit:      &pb.OrderLimit{},
+				UplinkPieceHash: &pb.PieceHash{PieceId: pieceID},
+			}))
+		}
+
+		spaceUsed, err := pieceinfos.SpaceUsed(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(5350), spaceUsed)
+
+		correction, err := store.ReconcileSpaceUsed(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(-5000), correction)
+
+		spaceUsed, err = pieceinfos.SpaceUsed(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(350), spaceUsed)
+
+		// nothing is corrected once the space used is exact
+		correction, err = store.ReconcileSpaceUsed(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, correction)
+	})
+}
+
+func TestThrottledReader(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	dir, err := filestore.NewDir(ctx.Dir("pieces"))
+	require.NoError(t, err)
+
+	blobs := filestore.New(dir)
+	defer ctx.Check(blobs.Close)
+
+	store := pieces.NewStore(zaptest.NewLogger(t), blobs, nil, 0)
+	store.SetRateLimit(pieces.OperationScrub, 1*memory.MiB)
+
+	satelliteID := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion()).ID
+	pieceID := storj.NewPieceID()
+	source := testrand.Bytes(512 * memory.KiB)
+
+	writer, err := store.Writer(ctx, satelliteID, pieceID)
+	require.NoError(t, err)
+	_, err = writer.Write(source)
+	require.NoError(t, err)
+	require.NoError(t, writer.Commit(ctx))
+
+	{ // reading with the limited class takes at least size / rate
+		reader, err := store.ThrottledReader(ctx, pieces.OperationScrub, satelliteID, pieceID)
+		require.NoError(t, err)
+
+		start := time.Now()
+		data, err := ioutil.ReadAll(reader)
+		elapsed := time.Since(start)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+
+		assert.Equal(t, source, data)
+		assert.True(t, elapsed >= 500*time.Millisecond, "read took %v", elapsed)
+	}
+
+	{ // classes without a limit are not throttled
+		reader, err := store.ThrottledReader(ctx, pieces.OperationTransfer, satelliteID, pieceID)
+		require.NoError(t, err)
+
+		data, err := ioutil.ReadAll(reader)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+
+		assert.Equal(t, source, data)
+	}
+}
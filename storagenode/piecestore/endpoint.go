@@ -25,6 +25,7 @@ import (
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/signing"
 	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage/filestore"
 	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/storagenode/monitor"
 	"storj.io/storj/storagenode/orders"
@@ -47,11 +48,12 @@ var _ pb.PiecestoreServer = (*Endpoint)(nil)
 
 // OldConfig contains everything necessary for a server
 type OldConfig struct {
-	Path                   string         `help:"path to store data in" default:"$CONFDIR/storage"`
-	WhitelistedSatellites  storj.NodeURLs `help:"a comma-separated list of approved satellite node urls" devDefault:"" releaseDefault:"12EayRS2V1kEsWESU9QMRseFhdxYxKicsiFmxrsLZHeLUtdps3S@mars.tardigrade.io:7777,118UWpMCHzs6CvSgWd9BfFVjw5K9pZbJjkfZJexMtSkmKxvvAW@satellite.stefan-benten.de:7777,121RTSDpyNZVcEU84Ticf2L1ntiuUimbWgfATz21tuvgk3vzoA6@saturn.tardigrade.io:7777,12L9ZFwhzVpuEKMUNUqkaTLGzwY9G24tbiigLiXpmZWKwmcNDDs@jupiter.tardigrade.io:7777"`
-	AllocatedDiskSpace     memory.Size    `user:"true" help:"total allocated disk space in bytes" default:"1TB"`
-	AllocatedBandwidth     memory.Size    `user:"true" help:"total allocated bandwidth in bytes" default:"2TB"`
-	KBucketRefreshInterval time.Duration  `help:"how frequently Kademlia bucket should be refreshed with node stats" default:"1h0m0s"`
+	Path                   string             `help:"path to store data in" default:"$CONFDIR/storage"`
+	WhitelistedSatellites  storj.NodeURLs     `help:"a comma-separated list of approved satellite node urls" devDefault:"" releaseDefault:"12EayRS2V1kEsWESU9QMRseFhdxYxKicsiFmxrsLZHeLUtdps3S@mars.tardigrade.io:7777,118UWpMCHzs6CvSgWd9BfFVjw5K9pZbJjkfZJexMtSkmKxvvAW@satellite.stefan-benten.de:7777,121RTSDpyNZVcEU84Ticf2L1ntiuUimbWgfATz21tuvgk3vzoA6@saturn.tardigrade.io:7777,12L9ZFwhzVpuEKMUNUqkaTLGzwY9G24tbiigLiXpmZWKwmcNDDs@jupiter.tardigrade.io:7777"`
+	AllocatedDiskSpace     memory.Size        `user:"true" help:"total allocated disk space in bytes" default:"1TB"`
+	AllocatedBandwidth     memory.Size        `user:"true" help:"total allocated bandwidth in bytes" default:"2TB"`
+	KBucketRefreshInterval time.Duration      `help:"how frequently Kademlia bucket should be refreshed with node stats" default:"1h0m0s"`
+	BlobSync               filestore.SyncMode `help:"when stored pieces are flushed to the disk. Options: (always/batch/never)" default:"always"`
 }
 
 // Config defines parameters for piecestore endpoint.
@@ -61,6 +63,13 @@ type Config struct {
 	OrderLimitGracePeriod time.Duration `help:"how long after OrderLimit creation date are OrderLimits no longer accepted" default:"1h0m0s"`
 	RetainTimeBuffer      time.Duration `help:"allows for small differences in the satellite and storagenode clocks" default:"1h0m0s"`
 	RetainStatus          RetainStatus  `help:"allows configuration to enable, disable, or test retain requests from the satellite. Options: (disabled/enabled/debug)" default:"disabled"`
+	PreallocSize          memory.Size   `help:"how much disk space to preallocate for each uploaded piece" default:"4MiB"`
+	ScrubRateLimit        memory.Size   `help:"maximum rate per second at which pieces are read when scrubbing, 0 for unlimited" default:"0"`
+	TransferRateLimit     memory.Size   `help:"maximum rate per second at which pieces are read when transferring them to another node, 0 for unlimited" default:"0"`
+	SpaceUsedInitTimeout  time.Duration `help:"how long to wait on startup for the space used by pieces to be calculated, 0 for no limit" default:"0"`
+
+	SatelliteGracePeriods SatelliteGracePeriods `help:"per satellite overrides of the order limit and expiration grace periods, formatted as <satellite id>=<order limit>/<expiration>,..." default:""`
+	SignatureMigration    SignatureMigration    `help:"order limit signature algorithm accepted besides the current one during a migration window, formatted as <algorithm>/<start>/<end> with RFC3339 times" default:""`
 
 	Monitor monitor.Config
 	Sender  orders.SenderConfig
@@ -121,7 +130,7 @@ type Endpoint struct {
 
 	store       *pieces.Store
 	pieceinfo   pieces.DB
-	orders      orders.DB
+	orders      orders.Queue
 	usage       bandwidth.DB
 	usedSerials UsedSerials
 
@@ -129,7 +138,7 @@ type Endpoint struct {
 }
 
 // NewEndpoint creates a new piecestore endpoint.
-func NewEndpoint(log *zap.Logger, signer signing.Signer, trust *trust.Pool, monitor *monitor.Service, store *pieces.Store, pieceinfo pieces.DB, orders orders.DB, usage bandwidth.DB, usedSerials UsedSerials, config Config) (*Endpoint, error) {
+func NewEndpoint(log *zap.Logger, signer signing.Signer, trust *trust.Pool, monitor *monitor.Service, store *pieces.Store, pieceinfo pieces.DB, orders orders.Queue, usage bandwidth.DB, usedSerials UsedSerials, config Config) (*Endpoint, error) {
 	return &Endpoint{
 		log:    log,
 		config: config,
@@ -177,11 +186,26 @@ func (endpoint *Endpoint) Delete(ctx context.Context, delete *pb.PieceDeleteRequ
 		endpoint.log.Error("delete failed", zap.Stringer("Piece ID", delete.Limit.PieceId), zap.Error(err))
 		// TODO: report internal server internal or missing error using grpc status,
 		// e.g. missing might happen when we get a deletion request after garbage collection has deleted it
-	} else {
-		endpoint.log.Info("deleted", zap.Stringer("Piece ID", delete.Limit.PieceId))
+		return &pb.PieceDeleteResponse{}, nil
+	}
+
+	endpoint.log.Info("deleted", zap.Stringer("Piece ID", delete.Limit.PieceId))
+
+	if !delete.Acknowledge {
+		return &pb.PieceDeleteResponse{}, nil
+	}
+
+	ack, err := signing.SignPieceDeletionAcknowledgment(ctx, endpoint.signer, &pb.PieceDeletionAcknowledgment{
+		SatelliteId:   delete.Limit.SatelliteId,
+		StorageNodeId: endpoint.signer.ID(),
+		PieceId:       delete.Limit.PieceId,
+		DeletedAt:     time.Now(),
+	})
+	if err != nil {
+		return nil, ErrInternal.Wrap(err)
 	}
 
-	return &pb.PieceDeleteResponse{}, nil
+	return &pb.PieceDeleteResponse{Acknowledgment: ack}, nil
 }
 
 // Upload handles uploading a piece on piece store.
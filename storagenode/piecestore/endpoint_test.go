@@ -507,7 +507,7 @@ func TestRetain(t *testing.T) {
 		ctx := testcontext.New(t)
 		defer ctx.Cleanup()
 		pieceInfos := db.PieceInfo()
-		store := pieces.NewStore(zaptest.NewLogger(t), db.Pieces())
+		store := pieces.NewStore(zaptest.NewLogger(t), db.Pieces(), db.PieceInfo(), 0)
 
 		const numPieces = 1000
 		const numPiecesToKeep = 990
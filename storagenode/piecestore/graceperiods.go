@@ -0,0 +1,88 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package piecestore
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"storj.io/storj/pkg/storj"
+)
+
+// GracePeriods overrides the order limit and expiration grace periods for a satellite.
+type GracePeriods struct {
+	OrderLimit time.Duration
+	Expiration time.Duration
+}
+
+// SatelliteGracePeriods maps satellites to their grace period overrides.
+//
+// The flag format is a comma separated list of
+// `<satellite id>=<order limit grace period>/<expiration grace period>`.
+type SatelliteGracePeriods map[storj.NodeID]GracePeriods
+
+// Set implements pflag.Value
+func (v *SatelliteGracePeriods) Set(s string) error {
+	periods := SatelliteGracePeriods{}
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) != 2 {
+			return Error.New("invalid satellite grace period %q", entry)
+		}
+		satelliteID, err := storj.NodeIDFromString(parts[0])
+		if err != nil {
+			return Error.New("invalid satellite id %q: %v", parts[0], err)
+		}
+
+		durations := strings.SplitN(parts[1], "/", 2)
+		if len(durations) != 2 {
+			return Error.New("invalid grace periods %q", parts[1])
+		}
+		orderLimit, err := time.ParseDuration(durations[0])
+		if err != nil {
+			return Error.New("invalid order limit grace period %q: %v", durations[0], err)
+		}
+		expiration, err := time.ParseDuration(durations[1])
+		if err != nil {
+			return Error.New("invalid expiration grace period %q: %v", durations[1], err)
+		}
+
+		periods[satelliteID] = GracePeriods{
+			OrderLimit: orderLimit,
+			Expiration: expiration,
+		}
+	}
+	*v = periods
+	return nil
+}
+
+// Type implements pflag.Value
+func (*SatelliteGracePeriods) Type() string { return "piecestore.SatelliteGracePeriods" }
+
+// String implements pflag.Value
+func (v *SatelliteGracePeriods) String() string {
+	var entries []string
+	for satelliteID, periods := range *v {
+		entries = append(entries, satelliteID.String()+"="+periods.OrderLimit.String()+"/"+periods.Expiration.String())
+	}
+	sort.Strings(entries)
+	return strings.Join(entries, ",")
+}
+
+// gracePeriods returns the grace periods that apply to orders from satellite.
+func (endpoint *Endpoint) gracePeriods(satellite storj.NodeID) GracePeriods {
+	if periods, ok := endpoint.config.SatelliteGracePeriods[satellite]; ok {
+		return periods
+	}
+	return GracePeriods{
+		OrderLimit: endpoint.config.OrderLimitGracePeriod,
+		Expiration: endpoint.config.ExpirationGracePeriod,
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package piecestore
+
+import (
+	"strings"
+	"time"
+
+	"storj.io/storj/pkg/signing"
+)
+
+// SignatureMigration configures a signature algorithm for order limits which
+// is accepted besides the current one while satellites rotate to it.
+//
+// The flag format is `<algorithm>/<start>/<end>`, with the start and the end
+// of the migration window in RFC3339. An empty value disables the migration.
+type SignatureMigration struct {
+	Next  signing.Algorithm
+	Start time.Time
+	End   time.Time
+}
+
+// Set implements pflag.Value
+func (v *SignatureMigration) Set(s string) error {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		*v = SignatureMigration{}
+		return nil
+	}
+
+	parts := strings.Split(s, "/")
+	if len(parts) != 3 {
+		return Error.New("invalid signature migration %q", s)
+	}
+	next := signing.Algorithm(parts[0])
+	if _, err := next.Hash(); err != nil {
+		return Error.Wrap(err)
+	}
+	start, err := time.Parse(time.RFC3339, parts[1])
+	if err != nil {
+		return Error.New("invalid migration start %q: %v", parts[1], err)
+	}
+	end, err := time.Parse(time.RFC3339, parts[2])
+	if err != nil {
+		return Error.New("invalid migration end %q: %v", parts[2], err)
+	}
+	if !start.Before(end) {
+		return Error.New("migration start %v isn't before its end %v", start, end)
+	}
+
+	*v = SignatureMigration{Next: next, Start: start, End: end}
+	return nil
+}
+
+// Type implements pflag.Value
+func (*SignatureMigration) Type() string { return "piecestore.SignatureMigration" }
+
+// String implements pflag.Value
+func (v *SignatureMigration) String() string {
+	if v.Next == "" {
+		return ""
+	}
+	return string(v.Next) + "/" + v.Start.Format(time.RFC3339) + "/" + v.End.Format(time.RFC3339)
+}
+
+// Accepts returns whether order limits signed with the next algorithm are accepted at now.
+func (v *SignatureMigration) Accepts(now time.Time) bool {
+	return v.Next != "" && !now.Before(v.Start) && now.Before(v.End)
+}
@@ -33,16 +33,17 @@ func (endpoint *Endpoint) verifyOrderLimit(ctx context.Context, limit *pb.OrderL
 
 	// sanity checks
 	now := time.Now()
+	grace := endpoint.gracePeriods(limit.SatelliteId)
 	switch {
 	case limit.Limit < 0:
 		return status.Error(codes.InvalidArgument, "order limit is negative")
 	case endpoint.signer.ID() != limit.StorageNodeId:
 		return status.Errorf(codes.InvalidArgument, "order intended for other storagenode: %v", limit.StorageNodeId)
-	case endpoint.IsExpired(limit.PieceExpiration):
+	case isExpired(now, limit.PieceExpiration, grace.Expiration):
 		return status.Errorf(codes.InvalidArgument, "piece expired: %v", limit.PieceExpiration)
-	case endpoint.IsExpired(limit.OrderExpiration):
+	case isExpired(now, limit.OrderExpiration, grace.Expiration):
 		return status.Errorf(codes.InvalidArgument, "order expired: %v", limit.OrderExpiration)
-	case now.Sub(limit.OrderCreation) > endpoint.config.OrderLimitGracePeriod:
+	case now.Sub(limit.OrderCreation) > grace.OrderLimit:
 		return status.Errorf(codes.InvalidArgument, "order created too long ago: %v", limit.OrderCreation)
 	case limit.SatelliteId.IsZero():
 		return status.Errorf(codes.InvalidArgument, "missing satellite id")
@@ -69,7 +70,7 @@ func (endpoint *Endpoint) verifyOrderLimit(ctx context.Context, limit *pb.OrderL
 	serialExpiration := limit.OrderExpiration
 
 	// Expire the serial earlier if the grace period is smaller than the serial expiration.
-	if graceExpiration := now.Add(endpoint.config.OrderLimitGracePeriod); graceExpiration.Before(serialExpiration) {
+	if graceExpiration := now.Add(grace.OrderLimit); graceExpiration.Before(serialExpiration) {
 		serialExpiration = graceExpiration
 	}
 
@@ -136,7 +137,15 @@ func (endpoint *Endpoint) VerifyOrderLimitSignature(ctx context.Context, limit *
 	}
 
 	if err := signing.VerifyOrderLimitSignature(ctx, signee, limit); err != nil {
-		return ErrVerifyUntrusted.New("invalid order limit signature: %v", err) // TODO: report grpc status bad message
+		// during a migration, satellites may already sign with the next algorithm
+		migration := &endpoint.config.SignatureMigration
+		if !migration.Accepts(time.Now()) {
+			return ErrVerifyUntrusted.New("invalid order limit signature: %v", err) // TODO: report grpc status bad message
+		}
+		if nextErr := signing.VerifyOrderLimitSignatureWith(ctx, signee, migration.Next, limit); nextErr != nil {
+			return ErrVerifyUntrusted.New("invalid order limit signature: %v", errs.Combine(err, nextErr)) // TODO: report grpc status bad message
+		}
+		mon.Meter("order_limit_next_signature_algorithm").Mark(1)
 	}
 
 	return nil
@@ -144,10 +153,15 @@ func (endpoint *Endpoint) VerifyOrderLimitSignature(ctx context.Context, limit *
 
 // IsExpired checks whether the date has already expired (with a threshold) at the time of calling this function.
 func (endpoint *Endpoint) IsExpired(expiration time.Time) bool {
+	return isExpired(time.Now(), expiration, endpoint.config.ExpirationGracePeriod)
+}
+
+// isExpired checks whether the date has expired at now, allowing for the grace period.
+func isExpired(now, expiration time.Time, grace time.Duration) bool {
 	if expiration.IsZero() {
 		return false
 	}
 
 	// TODO: return specific error about either exceeding the expiration completely or just the grace period
-	return expiration.Before(time.Now().Add(-endpoint.config.ExpirationGracePeriod))
+	return expiration.Before(now.Add(-grace))
 }
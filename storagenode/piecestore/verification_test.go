@@ -20,7 +20,10 @@ import (
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/signing"
 	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite"
+	"storj.io/storj/storagenode"
 	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/piecestore"
 )
 
 const oneWeek = 7 * 24 * time.Hour
@@ -298,6 +301,122 @@ func TestOrderLimitGetValidation(t *testing.T) {
 	}
 }
 
+func TestOrderLimitSatelliteGracePeriods(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 2, StorageNodeCount: 1, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			StorageNode: func(index int, config *storagenode.Config) {
+				strictSatellite := config.Storage.WhitelistedSatellites[1].ID
+				config.Storage2.SatelliteGracePeriods = piecestore.SatelliteGracePeriods{
+					strictSatellite: {OrderLimit: time.Minute},
+				}
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		client, err := planet.Uplinks[0].DialPiecestore(ctx, planet.StorageNodes[0])
+		require.NoError(t, err)
+		defer ctx.Check(client.Close)
+
+		upload := func(satellite *satellite.Peer, serialNumber storj.SerialNumber) error {
+			orderLimit, piecePrivateKey := GenerateOrderLimit(
+				t,
+				satellite.ID(),
+				planet.StorageNodes[0].ID(),
+				testrand.PieceID(),
+				pb.PieceAction_PUT,
+				serialNumber,
+				oneWeek,
+				oneWeek,
+				memory.KiB.Int64(),
+			)
+			// older than the strict grace period, but within the default one
+			orderLimit.OrderCreation = time.Now().Add(-10 * time.Minute)
+
+			orderLimit, err := signing.SignOrderLimit(ctx, signing.SignerFromFullIdentity(satellite.Identity), orderLimit)
+			require.NoError(t, err)
+
+			uploader, err := client.Upload(ctx, orderLimit, piecePrivateKey)
+			require.NoError(t, err)
+
+			_, writeErr := uploader.Write(testrand.BytesInt(memory.KiB.Int()))
+			_, commitErr := uploader.Commit(ctx)
+			return errs.Combine(writeErr, commitErr)
+		}
+
+		require.NoError(t, upload(planet.Satellites[0], storj.SerialNumber{1}))
+
+		err = upload(planet.Satellites[1], storj.SerialNumber{2})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "order created too long ago")
+	})
+}
+
+func TestOrderLimitSignatureMigration(t *testing.T) {
+	now := time.Now()
+
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 2, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			StorageNode: func(index int, config *storagenode.Config) {
+				migration := piecestore.SignatureMigration{
+					Next:  signing.SHA512,
+					Start: now.Add(-time.Hour),
+					End:   now.Add(time.Hour),
+				}
+				if index == 1 {
+					// the migration window is over on the second node
+					migration.End = now.Add(-time.Minute)
+				}
+				config.Storage2.SignatureMigration = migration
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		signer := signing.SignerFromFullIdentity(satellite.Identity)
+
+		upload := func(node *storagenode.Peer, algorithm signing.Algorithm) error {
+			client, err := planet.Uplinks[0].DialPiecestore(ctx, node)
+			require.NoError(t, err)
+			defer ctx.Check(client.Close)
+
+			orderLimit, piecePrivateKey := GenerateOrderLimit(
+				t,
+				satellite.ID(),
+				node.ID(),
+				testrand.PieceID(),
+				pb.PieceAction_PUT,
+				testrand.SerialNumber(),
+				oneWeek,
+				oneWeek,
+				memory.KiB.Int64(),
+			)
+
+			orderLimit, err = signing.SignOrderLimitWith(ctx, signer, algorithm, orderLimit)
+			require.NoError(t, err)
+
+			uploader, err := client.Upload(ctx, orderLimit, piecePrivateKey)
+			require.NoError(t, err)
+
+			_, writeErr := uploader.Write(testrand.BytesInt(memory.KiB.Int()))
+			_, commitErr := uploader.Commit(ctx)
+			return errs.Combine(writeErr, commitErr)
+		}
+
+		during, after := planet.StorageNodes[0], planet.StorageNodes[1]
+
+		// the current algorithm is accepted during and after the migration
+		require.NoError(t, upload(during, signing.SHA256))
+		require.NoError(t, upload(after, signing.SHA256))
+
+		// the next algorithm is accepted only during the migration
+		require.NoError(t, upload(during, signing.SHA512))
+
+		err := upload(after, signing.SHA512)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid order limit signature")
+	})
+}
+
 func setBandwidth(ctx context.Context, t *testing.T, planet *testplanet.Planet, bandwidth int64) {
 	if bandwidth == 0 {
 		return
@@ -4,6 +4,8 @@
 package storagenodedb
 
 import (
+	"context"
+
 	_ "github.com/mattn/go-sqlite3" // used indirectly
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
@@ -32,6 +34,8 @@ type Config struct {
 	Kademlia string
 
 	Pieces string
+	// BlobSync defines when the pieces are flushed to the disk.
+	BlobSync filestore.SyncMode
 }
 
 // DB contains access to different database tables
@@ -50,7 +54,7 @@ type DB struct {
 
 // New creates a new master database for storage node
 func New(log *zap.Logger, config Config) (*DB, error) {
-	piecesDir, err := filestore.NewDir(config.Pieces)
+	piecesDir, err := filestore.NewDirWithSync(config.Pieces, config.BlobSync)
 	if err != nil {
 		return nil, err
 	}
@@ -61,6 +65,11 @@ func New(log *zap.Logger, config Config) (*DB, error) {
 		return nil, err
 	}
 
+	// refuse to use a database that was migrated by a newer binary
+	if err := infodb.checkSchemaVersion(); err != nil {
+		return nil, errs.Combine(err, infodb.Close())
+	}
+
 	dbs, err := boltdb.NewShared(config.Kademlia, kademlia.KademliaBucket, kademlia.NodeBucket, kademlia.AntechamberBucket)
 	if err != nil {
 		return nil, err
@@ -121,6 +130,12 @@ func (db *DB) Close() error {
 	)
 }
 
+// Ping checks that the info database can be reached.
+func (db *DB) Ping(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return db.info.db.PingContext(ctx)
+}
+
 // Pieces returns blob storage for pieces
 func (db *DB) Pieces() storage.Blobs {
 	return db.pieces
@@ -130,3 +145,29 @@ func (db *DB) Pieces() storage.Blobs {
 func (db *DB) RoutingTable() (kdb, ndb, adb storage.KeyValueStore) {
 	return db.kdb, db.ndb, db.adb
 }
+
+// MigrateRoutingTable copies all entries of the kademlia routing table stores
+// to the given destination stores, so that they can be moved to another backend.
+func (db *DB) MigrateRoutingTable(ctx context.Context, kdst, ndst, adst storage.KeyValueStore) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return errs.Combine(
+		copyStore(ctx, kdst, db.kdb),
+		copyStore(ctx, ndst, db.ndb),
+		copyStore(ctx, adst, db.adb),
+	)
+}
+
+// copyStore puts all the entries of src into dst.
+func copyStore(ctx context.Context, dst, src storage.KeyValueStore) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return src.Iterate(ctx, storage.IterateOptions{Recurse: true}, func(ctx context.Context, it storage.Iterator) error {
+		var item storage.ListItem
+		for it.Next(ctx, &item) {
+			// the iterated key and value may only be valid until the next item
+			if err := dst.Put(ctx, storage.CloneKey(item.Key), storage.CloneValue(item.Value)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -25,6 +25,9 @@ import (
 // ErrInfo is the default error class for InfoDB
 var ErrInfo = errs.Class("infodb")
 
+// ErrSchemaMismatch is returned when the database schema is newer than the migrations of this binary.
+var ErrSchemaMismatch = errs.Class("schema mismatch")
+
 // SQLDB defines interface that matches *sql.DB
 // this is such that we can use utccheck.DB for the backend
 //
@@ -116,6 +119,33 @@ func (db *InfoDB) CreateTables(log *zap.Logger) error {
 	return migration.Run(log.Named("migration"), db)
 }
 
+// checkSchemaVersion verifies that the database hasn't been migrated past the
+// latest version known to this binary. Databases without a version are new.
+func (db *InfoDB) checkSchemaVersion() error {
+	migration := db.Migration()
+
+	var tables int
+	err := db.db.QueryRow(`SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?`, migration.Table).Scan(&tables)
+	if err != nil {
+		return ErrInfo.Wrap(err)
+	}
+	if tables == 0 {
+		return nil
+	}
+
+	var version sql.NullInt64
+	err = db.db.QueryRow(`SELECT MAX(version) FROM ` + migration.Table).Scan(&version)
+	if err != nil {
+		return ErrInfo.Wrap(err)
+	}
+
+	supported := migration.Steps[len(migration.Steps)-1].Version
+	if version.Valid && int(version.Int64) > supported {
+		return ErrSchemaMismatch.New("database version %d is newer than the supported version %d", version.Int64, supported)
+	}
+	return nil
+}
+
 // RawDB returns access to the raw database, only for migration tests.
 func (db *InfoDB) RawDB() SQLDB { return db.db }
 
@@ -6,6 +6,7 @@ package storagenodedb
 import (
 	"context"
 	"database/sql"
+	"sort"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
@@ -259,3 +260,75 @@ func (db *ordersdb) ListArchived(ctx context.Context, limit int) (_ []*orders.Ar
 
 	return infos, ErrInfo.Wrap(rows.Err())
 }
+
+// CountByAge returns the number of unsent and archived orders per satellite,
+// status and age bucket. Unsent orders are aged from the creation of their
+// order limit and archived orders from the time they were archived.
+func (db *ordersdb) CountByAge(ctx context.Context, now time.Time, bounds []time.Duration) (_ map[orders.AgeBucket]int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bounds = append([]time.Duration{}, bounds...)
+	sort.Slice(bounds, func(i, k int) bool { return bounds[i] < bounds[k] })
+
+	minAge := func(created time.Time) time.Duration {
+		age := now.Sub(created)
+		i := sort.Search(len(bounds), func(i int) bool { return bounds[i] > age })
+		if i == 0 {
+			return 0
+		}
+		return bounds[i-1]
+	}
+
+	counts := map[orders.AgeBucket]int{}
+
+	unsent, err := db.db.Query(`SELECT satellite_id, order_limit_serialized FROM unsent_order`)
+	if err != nil {
+		return nil, ErrInfo.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, unsent.Close()) }()
+
+	for unsent.Next() {
+		var satelliteID storj.NodeID
+		var limitSerialized []byte
+		if err := unsent.Scan(&satelliteID, &limitSerialized); err != nil {
+			return nil, ErrInfo.Wrap(err)
+		}
+
+		var limit pb.OrderLimit
+		if err := proto.Unmarshal(limitSerialized, &limit); err != nil {
+			return nil, ErrInfo.Wrap(err)
+		}
+
+		counts[orders.AgeBucket{
+			Satellite: satelliteID,
+			Status:    orders.StatusUnsent,
+			MinAge:    minAge(limit.OrderCreation),
+		}]++
+	}
+	if err := unsent.Err(); err != nil {
+		return nil, ErrInfo.Wrap(err)
+	}
+
+	archived, err := db.db.Query(`SELECT satellite_id, status, archived_at FROM order_archive_`)
+	if err != nil {
+		return nil, ErrInfo.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, archived.Close()) }()
+
+	for archived.Next() {
+		var satelliteID storj.NodeID
+		var status int
+		var archivedAt time.Time
+		if err := archived.Scan(&satelliteID, &status, &archivedAt); err != nil {
+			return nil, ErrInfo.Wrap(err)
+		}
+
+		counts[orders.AgeBucket{
+			Satellite: satelliteID,
+			Status:    orders.Status(status),
+			MinAge:    minAge(archivedAt),
+		}]++
+	}
+
+	return counts, ErrInfo.Wrap(archived.Err())
+}
@@ -21,6 +21,7 @@ import (
 type pieceinfo struct {
 	// Moved to top of struct to resolve alignment issue with atomic operations on ARM
 	usedSpace     int64
+	spaceLoaded   int32
 	loadSpaceOnce sync.Once
 
 	*InfoDB
@@ -202,6 +203,48 @@ func (db *pieceinfo) GetExpired(ctx context.Context, expiredAt time.Time, limit
 	return infos, nil
 }
 
+// GetExpiredPaged gets a page of pieceinformation identities that are expired, starting after cursor.
+func (db *pieceinfo) GetExpiredPaged(ctx context.Context, expiredAt time.Time, cursor pieces.ExpiredCursor, limit int64) (infos []pieces.ExpiredInfo, next pieces.ExpiredCursor, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	next = cursor
+	rows, err := db.db.QueryContext(ctx, db.Rebind(`
+		SELECT satellite_id, piece_id, piece_size, piece_expiration
+		FROM pieceinfo_
+		WHERE piece_expiration IS NOT NULL
+		AND piece_expiration < ?
+		AND ((deletion_failed_at IS NULL) OR deletion_failed_at <> ?)
+		AND (piece_expiration > ?
+			OR (piece_expiration = ? AND satellite_id > ?)
+			OR (piece_expiration = ? AND satellite_id = ? AND piece_id > ?))
+		ORDER BY piece_expiration, satellite_id, piece_id
+		LIMIT ?
+	`), expiredAt.UTC(), expiredAt.UTC(),
+		cursor.PieceExpiration.UTC(),
+		cursor.PieceExpiration.UTC(), cursor.SatelliteID,
+		cursor.PieceExpiration.UTC(), cursor.SatelliteID, cursor.PieceID,
+		limit)
+	if err != nil {
+		return nil, next, ErrInfo.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+	for rows.Next() {
+		info := pieces.ExpiredInfo{}
+		var expiration time.Time
+		err = rows.Scan(&info.SatelliteID, &info.PieceID, &info.PieceSize, &expiration)
+		if err != nil {
+			return infos, next, ErrInfo.Wrap(err)
+		}
+		infos = append(infos, info)
+		next = pieces.ExpiredCursor{
+			PieceExpiration: expiration,
+			SatelliteID:     info.SatelliteID,
+			PieceID:         info.PieceID,
+		}
+	}
+	return infos, next, ErrInfo.Wrap(rows.Err())
+}
+
 // SpaceUsed returns disk space used by all pieces from cache
 func (db *pieceinfo) SpaceUsed(ctx context.Context) (_ int64, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -210,11 +253,83 @@ func (db *pieceinfo) SpaceUsed(ctx context.Context) (_ int64, err error) {
 	return atomic.LoadInt64(&db.usedSpace), nil
 }
 
+// SnapshotSpaceUsed returns disk space used by all pieces from cache, to be restored with RestoreSpaceUsed
+func (db *pieceinfo) SnapshotSpaceUsed(ctx context.Context) (_ pieces.SpaceUsedSnapshot, err error) {
+	defer mon.Task()(&ctx)(&err)
+	db.loadSpaceUsed(ctx)
+
+	return pieces.SpaceUsedSnapshot{
+		Total:     atomic.LoadInt64(&db.usedSpace),
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// RestoreSpaceUsed sets the cached disk space used by all pieces from snapshot instead of calculating it.
+// It fails when the cache has already been loaded.
+func (db *pieceinfo) RestoreSpaceUsed(ctx context.Context, snapshot pieces.SpaceUsedSnapshot) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	restored := false
+	db.loadSpaceOnce.Do(func() {
+		atomic.AddInt64(&db.usedSpace, snapshot.Total)
+		atomic.StoreInt32(&db.spaceLoaded, 1)
+		restored = true
+	})
+	if !restored {
+		return ErrInfo.New("space used is already loaded")
+	}
+	return nil
+}
+
+// InitSpaceUsed calculates the cached disk space used by all pieces, unless it's already loaded.
+// It returns false when ctx is done before the calculation finishes.
+func (db *pieceinfo) InitSpaceUsed(ctx context.Context) (complete bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if atomic.LoadInt32(&db.spaceLoaded) == 1 {
+		return true, nil
+	}
+	// sqlite doesn't always fail the query when ctx is already done
+	if ctx.Err() != nil {
+		return false, nil
+	}
+
+	usedSpace, err := db.CalculatedSpaceUsed(ctx)
+	if err != nil {
+		if ctx.Err() != nil {
+			return false, nil
+		}
+		return false, ErrInfo.Wrap(err)
+	}
+
+	db.loadSpaceOnce.Do(func() {
+		atomic.AddInt64(&db.usedSpace, usedSpace)
+		atomic.StoreInt32(&db.spaceLoaded, 1)
+	})
+	return true, nil
+}
+
+// ReconcileSpaceUsed calculates disk space used by all pieces and resets the cache to it, returning the correction.
+func (db *pieceinfo) ReconcileSpaceUsed(ctx context.Context) (correction int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	// load the cache first, so that it isn't calculated again on top of the reset value
+	db.loadSpaceUsed(ctx)
+
+	calculated, err := db.CalculatedSpaceUsed(ctx)
+	if err != nil {
+		return 0, ErrInfo.Wrap(err)
+	}
+
+	previous := atomic.SwapInt64(&db.usedSpace, calculated)
+	return calculated - previous, nil
+}
+
 func (db *pieceinfo) loadSpaceUsed(ctx context.Context) {
 	defer mon.Task()(&ctx)(nil)
 	db.loadSpaceOnce.Do(func() {
 		usedSpace, _ := db.CalculatedSpaceUsed(ctx)
 		atomic.AddInt64(&db.usedSpace, usedSpace)
+		atomic.StoreInt32(&db.spaceLoaded, 1)
 	})
 }
 
@@ -4,6 +4,7 @@
 package storagenodedbtest_test
 
 import (
+	"database/sql"
 	"runtime"
 	"sync"
 	"testing"
@@ -19,6 +20,9 @@ import (
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/signing"
 	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/filestore"
+	"storj.io/storj/storage/teststore"
 	"storj.io/storj/storagenode"
 	"storj.io/storj/storagenode/orders"
 	"storj.io/storj/storagenode/storagenodedb"
@@ -165,3 +169,98 @@ func createOrder(t *testing.T, ctx *testcontext.Context) (info *orders.Info) {
 		Order: order,
 	}
 }
+
+func TestBlobSyncMode(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	log := zaptest.NewLogger(t)
+
+	db, err := storagenodedb.New(log, storagenodedb.Config{
+		Pieces:   ctx.Dir("storage"),
+		Info2:    ctx.Dir("storage") + "/info.db",
+		Kademlia: ctx.Dir("storage") + "/kademlia",
+		BlobSync: filestore.SyncNever,
+	})
+	require.NoError(t, err)
+	defer ctx.Check(db.Close)
+
+	store, ok := db.Pieces().(*filestore.Store)
+	require.True(t, ok)
+	require.Equal(t, filestore.SyncNever, store.SyncMode())
+}
+
+func TestSchemaMismatch(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	log := zaptest.NewLogger(t)
+
+	config := storagenodedb.Config{
+		Pieces:   ctx.Dir("storage"),
+		Info2:    ctx.Dir("storage") + "/info.db",
+		Kademlia: ctx.Dir("storage") + "/kademlia",
+	}
+
+	db, err := storagenodedb.New(log, config)
+	require.NoError(t, err)
+	require.NoError(t, db.CreateTables())
+	require.NoError(t, db.Close())
+
+	// reopening a database of the same version works
+	db, err = storagenodedb.New(log, config)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	// stamp the database as if it was migrated by a newer binary
+	rawdb, err := sql.Open("sqlite3", "file:"+config.Info2)
+	require.NoError(t, err)
+	_, err = rawdb.Exec(`INSERT INTO versions (version, commited_at) VALUES (1000, 'future')`) //nolint:misspell
+	require.NoError(t, err)
+	require.NoError(t, rawdb.Close())
+
+	_, err = storagenodedb.New(log, config)
+	require.Error(t, err)
+	require.True(t, storagenodedb.ErrSchemaMismatch.Has(err), err)
+}
+
+func TestMigrateRoutingTable(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	db, err := storagenodedb.New(zaptest.NewLogger(t), storagenodedb.Config{
+		Pieces:   ctx.Dir("storage"),
+		Info2:    ctx.Dir("storage") + "/info.db",
+		Kademlia: ctx.Dir("storage") + "/kademlia",
+	})
+	require.NoError(t, err)
+	defer ctx.Check(db.Close)
+
+	kdb, ndb, adb := db.RoutingTable()
+	sources := []storage.KeyValueStore{kdb, ndb, adb}
+
+	entries := map[string][]byte{}
+	for i := 0; i < 20; i++ {
+		entries[string(testrand.Bytes(16))] = testrand.Bytes(64)
+	}
+	for _, src := range sources {
+		for key, value := range entries {
+			require.NoError(t, src.Put(ctx, storage.Key(key), storage.Value(value)))
+		}
+	}
+
+	destinations := []storage.KeyValueStore{teststore.New(), teststore.New(), teststore.New()}
+	require.NoError(t, db.MigrateRoutingTable(ctx, destinations[0], destinations[1], destinations[2]))
+
+	for _, dst := range destinations {
+		keys, err := dst.List(ctx, nil, len(entries)+1)
+		require.NoError(t, err)
+		require.Len(t, keys, len(entries))
+
+		for key, value := range entries {
+			got, err := dst.Get(ctx, storage.Key(key))
+			require.NoError(t, err)
+			require.Equal(t, storage.Value(value), got)
+		}
+	}
+}
@@ -30,10 +30,34 @@ var mon = monkit.Package()
 // Client defines an interface for storing erasure coded data to piece store nodes
 type Client interface {
 	Put(ctx context.Context, limits []*pb.AddressedOrderLimit, privateKey storj.PiecePrivateKey, rs eestream.RedundancyStrategy, data io.Reader, expiration time.Time) (successfulNodes []*pb.Node, successfulHashes []*pb.PieceHash, err error)
+	PutWithProgress(ctx context.Context, limits []*pb.AddressedOrderLimit, privateKey storj.PiecePrivateKey, rs eestream.RedundancyStrategy, data io.Reader, expiration time.Time, progress PutProgressFunc) (successfulNodes []*pb.Node, successfulHashes []*pb.PieceHash, err error)
 	Repair(ctx context.Context, limits []*pb.AddressedOrderLimit, privateKey storj.PiecePrivateKey, rs eestream.RedundancyStrategy, data io.Reader, expiration time.Time, timeout time.Duration, path storj.Path) (successfulNodes []*pb.Node, successfulHashes []*pb.PieceHash, err error)
 	Get(ctx context.Context, limits []*pb.AddressedOrderLimit, privateKey storj.PiecePrivateKey, es eestream.ErasureScheme, size int64) (ranger.Ranger, error)
+	// GetWithSpares is like Get, but when the node of limits[i] can't be
+	// dialed, the piece is downloaded with spares[i] instead. A spare limit
+	// must be for the same piece, stored on another node.
+	GetWithSpares(ctx context.Context, limits, spares []*pb.AddressedOrderLimit, privateKey storj.PiecePrivateKey, es eestream.ErasureScheme, size int64) (ranger.Ranger, error)
 	Delete(ctx context.Context, limits []*pb.AddressedOrderLimit, privateKey storj.PiecePrivateKey) error
+	DeleteWithAcknowledgments(ctx context.Context, limits []*pb.AddressedOrderLimit, privateKey storj.PiecePrivateKey) (acks []*pb.PieceDeletionAcknowledgment, unacknowledged storj.NodeIDList, err error)
 	WithForceErrorDetection(force bool) Client
+	// WithBandwidthRecorder makes the client report the bytes it transfers to recorder.
+	WithBandwidthRecorder(recorder BandwidthRecorder) Client
+	// WithDecodeConcurrency makes downloads decode every stripe in up to concurrency parts at the same time.
+	WithDecodeConcurrency(concurrency int) Client
+	// Close closes the connections kept open to storage nodes.
+	Close() error
+}
+
+// PutProgressFunc is called every time a piece has been uploaded successfully.
+// completed is the number of pieces uploaded so far and total the number of
+// pieces attempted.
+type PutProgressFunc func(completed, total int)
+
+// BandwidthRecorder is notified of the piece bytes transferred to and from
+// storage nodes, by the action of the order limit used for the transfer.
+// It is called concurrently from the transfers of different pieces.
+type BandwidthRecorder interface {
+	RecordBandwidth(action pb.PieceAction, bytes int64)
 }
 
 type dialPiecestoreFunc func(context.Context, *pb.Node) (*piecestore.Client, error)
@@ -41,16 +65,29 @@ type dialPiecestoreFunc func(context.Context, *pb.Node) (*piecestore.Client, err
 type ecClient struct {
 	log                 *zap.Logger
 	transport           transport.Client
+	pool                *connPool
 	memoryLimit         int
+	decodeLimiter       *DecodeLimiter
 	forceErrorDetection bool
+	decodeConcurrency   int
+	recorder            BandwidthRecorder
 }
 
 // NewClient from the given identity and max buffer memory
 func NewClient(log *zap.Logger, tc transport.Client, memoryLimit int) Client {
+	return NewClientWithDecodeLimiter(log, tc, memoryLimit, nil)
+}
+
+// NewClientWithDecodeLimiter creates a client whose downloads additionally
+// reserve their max buffer memory from limiter, which may be shared with other
+// clients to cap their aggregate decode memory. A nil limiter disables the cap.
+func NewClientWithDecodeLimiter(log *zap.Logger, tc transport.Client, memoryLimit int, limiter *DecodeLimiter) Client {
 	return &ecClient{
-		log:         log,
-		transport:   tc,
-		memoryLimit: memoryLimit,
+		log:           log,
+		transport:     tc,
+		pool:          newConnPool(tc, defaultPoolCapacity, defaultPoolIdleTimeout),
+		memoryLimit:   memoryLimit,
+		decodeLimiter: limiter,
 	}
 }
 
@@ -59,13 +96,48 @@ func (ec *ecClient) WithForceErrorDetection(force bool) Client {
 	return ec
 }
 
+func (ec *ecClient) WithBandwidthRecorder(recorder BandwidthRecorder) Client {
+	ec.recorder = recorder
+	return ec
+}
+
+func (ec *ecClient) WithDecodeConcurrency(concurrency int) Client {
+	ec.decodeConcurrency = concurrency
+	return ec
+}
+
+// recordBandwidth reports bytes transferred for action to the recorder, if there is one.
+func (ec *ecClient) recordBandwidth(action pb.PieceAction, bytes int64) {
+	if ec.recorder != nil && bytes > 0 {
+		ec.recorder.RecordBandwidth(action, bytes)
+	}
+}
+
+// Close closes the connections kept open to storage nodes.
+func (ec *ecClient) Close() error {
+	return ec.pool.Close()
+}
+
+// dialPiecestore returns a client for the node, reusing a pooled connection
+// when there is one. Closing the client returns the connection to the pool.
 func (ec *ecClient) dialPiecestore(ctx context.Context, n *pb.Node) (*piecestore.Client, error) {
 	logger := ec.log.Named(n.Id.String())
-	return piecestore.Dial(ctx, ec.transport, n, logger, piecestore.DefaultConfig)
+	conn, release, err := ec.pool.get(ctx, n)
+	if err != nil {
+		return nil, piecestore.Error.Wrap(err)
+	}
+	return piecestore.NewSharedClient(conn, logger, piecestore.DefaultConfig, release), nil
 }
 
 func (ec *ecClient) Put(ctx context.Context, limits []*pb.AddressedOrderLimit, privateKey storj.PiecePrivateKey, rs eestream.RedundancyStrategy, data io.Reader, expiration time.Time) (successfulNodes []*pb.Node, successfulHashes []*pb.PieceHash, err error) {
 	defer mon.Task()(&ctx)(&err)
+	return ec.PutWithProgress(ctx, limits, privateKey, rs, data, expiration, nil)
+}
+
+// PutWithProgress uploads the pieces like Put and additionally calls progress,
+// if not nil, as each piece upload succeeds.
+func (ec *ecClient) PutWithProgress(ctx context.Context, limits []*pb.AddressedOrderLimit, privateKey storj.PiecePrivateKey, rs eestream.RedundancyStrategy, data io.Reader, expiration time.Time, progress PutProgressFunc) (successfulNodes []*pb.Node, successfulHashes []*pb.PieceHash, err error) {
+	defer mon.Task()(&ctx)(&err)
 
 	pieceCount := len(limits)
 	if pieceCount != rs.TotalCount() {
@@ -135,9 +207,13 @@ func (ec *ecClient) Put(ctx context.Context, limits []*pb.AddressedOrderLimit, p
 		}
 		successfulHashes[info.i] = info.hash
 
-		atomic.AddInt32(&successfulCount, 1)
+		count := int(atomic.AddInt32(&successfulCount, 1))
 
-		if int(successfulCount) >= rs.OptimalThreshold() {
+		if progress != nil {
+			progress(count, nonNilLimits)
+		}
+
+		if count >= rs.OptimalThreshold() {
 			ec.log.Sugar().Infof("Success threshold (%d nodes) reached. Cancelling remaining uploads.", rs.OptimalThreshold())
 			cancel()
 		}
@@ -314,7 +390,8 @@ func (ec *ecClient) putPiece(ctx, parent context.Context, limit *pb.AddressedOrd
 		err = errs.Combine(err, closeErr)
 	}()
 
-	_, err = sync2.Copy(ctx, upload, data)
+	copied, err := sync2.Copy(ctx, upload, data)
+	ec.recordBandwidth(limit.GetLimit().Action, copied)
 	// Canceled context means the piece upload was interrupted by user or due
 	// to slow connection. No error logging for this case.
 	if ctx.Err() == context.Canceled {
@@ -343,11 +420,20 @@ func (ec *ecClient) putPiece(ctx, parent context.Context, limit *pb.AddressedOrd
 
 func (ec *ecClient) Get(ctx context.Context, limits []*pb.AddressedOrderLimit, privateKey storj.PiecePrivateKey, es eestream.ErasureScheme, size int64) (rr ranger.Ranger, err error) {
 	defer mon.Task()(&ctx)(&err)
+	return ec.GetWithSpares(ctx, limits, nil, privateKey, es, size)
+}
+
+func (ec *ecClient) GetWithSpares(ctx context.Context, limits, spares []*pb.AddressedOrderLimit, privateKey storj.PiecePrivateKey, es eestream.ErasureScheme, size int64) (rr ranger.Ranger, err error) {
+	defer mon.Task()(&ctx)(&err)
 
 	if len(limits) != es.TotalCount() {
 		return nil, Error.New("size of limits slice (%d) does not match total count (%d) of erasure scheme", len(limits), es.TotalCount())
 	}
 
+	if len(spares) != 0 && len(spares) != len(limits) {
+		return nil, Error.New("size of spares slice (%d) does not match size of limits slice (%d)", len(spares), len(limits))
+	}
+
 	if nonNilCount(limits) < es.RequiredCount() {
 		return nil, Error.New("number of non-nil limits (%d) is less than required count (%d) of erasure scheme", nonNilCount(limits), es.RequiredCount())
 	}
@@ -361,70 +447,121 @@ func (ec *ecClient) Get(ctx context.Context, limits []*pb.AddressedOrderLimit, p
 			continue
 		}
 
+		var spare *pb.AddressedOrderLimit
+		if len(spares) != 0 {
+			spare = spares[i]
+		}
+
 		rrs[i] = &lazyPieceRanger{
-			dialPiecestore: ec.dialPiecestore,
-			limit:          addressedLimit,
-			privateKey:     privateKey,
-			size:           pieceSize,
+			dialPiecestore:  ec.dialPiecestore,
+			recordBandwidth: ec.recordBandwidth,
+			limit:           addressedLimit,
+			spare:           spare,
+			privateKey:      privateKey,
+			size:            pieceSize,
 		}
 	}
 
-	rr, err = eestream.Decode(ec.log, rrs, es, ec.memoryLimit, ec.forceErrorDetection)
+	rr, err = eestream.Decode(ec.log, rrs, eestream.NewParallelDecodeScheme(es, ec.decodeConcurrency), ec.memoryLimit, ec.forceErrorDetection)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
 
 	ranger, err := eestream.Unpad(rr, int(paddedSize-size))
-	return ranger, Error.Wrap(err)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if ec.decodeLimiter != nil {
+		ranger = &limitedRanger{
+			Ranger:  ranger,
+			limiter: ec.decodeLimiter,
+			memory:  int64(ec.memoryLimit),
+		}
+	}
+
+	return ranger, nil
 }
 
 func (ec *ecClient) Delete(ctx context.Context, limits []*pb.AddressedOrderLimit, privateKey storj.PiecePrivateKey) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	errch := make(chan error, len(limits))
+	_, _, err = ec.deletePieces(ctx, limits, privateKey, false)
+	return err
+}
+
+// DeleteWithAcknowledgments deletes the pieces like Delete, but additionally
+// requests a signed deletion acknowledgment from every storage node. It returns
+// the verified acknowledgments together with the nodes which did not provide
+// a valid one.
+func (ec *ecClient) DeleteWithAcknowledgments(ctx context.Context, limits []*pb.AddressedOrderLimit, privateKey storj.PiecePrivateKey) (acks []*pb.PieceDeletionAcknowledgment, unacknowledged storj.NodeIDList, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return ec.deletePieces(ctx, limits, privateKey, true)
+}
+
+type deleteResult struct {
+	nodeID storj.NodeID
+	ack    *pb.PieceDeletionAcknowledgment
+	err    error
+}
+
+func (ec *ecClient) deletePieces(ctx context.Context, limits []*pb.AddressedOrderLimit, privateKey storj.PiecePrivateKey, acknowledge bool) (acks []*pb.PieceDeletionAcknowledgment, unacknowledged storj.NodeIDList, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	results := make(chan deleteResult, len(limits))
 	for _, addressedLimit := range limits {
 		if addressedLimit == nil {
-			errch <- nil
+			results <- deleteResult{}
 			continue
 		}
 
 		go func(addressedLimit *pb.AddressedOrderLimit) {
 			limit := addressedLimit.GetLimit()
+			result := deleteResult{nodeID: limit.StorageNodeId}
+
 			ps, err := ec.dialPiecestore(ctx, &pb.Node{
 				Id:      limit.StorageNodeId,
 				Address: addressedLimit.GetStorageNodeAddress(),
 			})
 			if err != nil {
 				ec.log.Sugar().Errorf("Failed dialing for deleting piece %s from node %s: %v", limit.PieceId, limit.StorageNodeId, err)
-				errch <- err
+				result.err = err
+				results <- result
 				return
 			}
-			err = ps.Delete(ctx, limit, privateKey)
-			err = errs.Combine(err, ps.Close())
-			if err != nil {
-				ec.log.Sugar().Errorf("Failed deleting piece %s from node %s: %v", limit.PieceId, limit.StorageNodeId, err)
+			if acknowledge {
+				result.ack, err = ps.DeleteWithAcknowledgment(ctx, limit, privateKey)
+			} else {
+				err = ps.Delete(ctx, limit, privateKey)
+			}
+			result.err = errs.Combine(err, ps.Close())
+			if result.err != nil {
+				ec.log.Sugar().Errorf("Failed deleting piece %s from node %s: %v", limit.PieceId, limit.StorageNodeId, result.err)
 			}
-			errch <- err
+			results <- result
 		}(addressedLimit)
 	}
 
-	allerrs := collectErrors(errch, len(limits))
-	if len(allerrs) > 0 && len(allerrs) == len(limits) {
-		return allerrs[0]
+	var allerrs []error
+	for range limits {
+		result := <-results
+		switch {
+		case result.err != nil:
+			allerrs = append(allerrs, result.err)
+			if acknowledge {
+				unacknowledged = append(unacknowledged, result.nodeID)
+			}
+		case result.ack != nil:
+			acks = append(acks, result.ack)
+		}
 	}
 
-	return nil
-}
-
-func collectErrors(errs <-chan error, size int) []error {
-	var result []error
-	for i := 0; i < size; i++ {
-		err := <-errs
-		if err != nil {
-			result = append(result, err)
-		}
+	if len(allerrs) > 0 && len(allerrs) == len(limits) {
+		return acks, unacknowledged, allerrs[0]
 	}
-	return result
+
+	return acks, unacknowledged, nil
 }
 
 func unique(limits []*pb.AddressedOrderLimit) bool {
@@ -459,10 +596,13 @@ func calcPadded(size int64, blockSize int) int64 {
 }
 
 type lazyPieceRanger struct {
-	dialPiecestore dialPiecestoreFunc
-	limit          *pb.AddressedOrderLimit
-	privateKey     storj.PiecePrivateKey
-	size           int64
+	dialPiecestore  dialPiecestoreFunc
+	recordBandwidth func(action pb.PieceAction, bytes int64)
+	limit           *pb.AddressedOrderLimit
+	// spare is used instead of limit when its node can't be dialed, it may be nil.
+	spare      *pb.AddressedOrderLimit
+	privateKey storj.PiecePrivateKey
+	size       int64
 }
 
 // Size implements Ranger.Size
@@ -473,24 +613,54 @@ func (lr *lazyPieceRanger) Size() int64 {
 // Range implements Ranger.Range to be lazily connected
 func (lr *lazyPieceRanger) Range(ctx context.Context, offset, length int64) (_ io.ReadCloser, err error) {
 	defer mon.Task()(&ctx)(&err)
-	ps, err := lr.dialPiecestore(ctx, &pb.Node{
-		Id:      lr.limit.GetLimit().StorageNodeId,
-		Address: lr.limit.GetStorageNodeAddress(),
-	})
+
+	limit := lr.limit
+	ps, err := lr.dial(ctx, limit)
+	if err != nil && lr.spare != nil {
+		var spareErr error
+		limit = lr.spare
+		ps, spareErr = lr.dial(ctx, limit)
+		if spareErr != nil {
+			return nil, errs.Combine(err, spareErr)
+		}
+		err = nil
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	download, err := ps.Download(ctx, lr.limit.GetLimit(), lr.privateKey, offset, length)
+	download, err := ps.Download(ctx, limit.GetLimit(), lr.privateKey, offset, length)
 	if err != nil {
 		return nil, errs.Combine(err, ps.Close())
 	}
-	return &clientCloser{download, ps}, nil
+	return &clientCloser{
+		Downloader: download,
+		client:     ps,
+		record: func(bytes int64) {
+			lr.recordBandwidth(limit.GetLimit().Action, bytes)
+		},
+	}, nil
+}
+
+// dial dials the storage node of limit.
+func (lr *lazyPieceRanger) dial(ctx context.Context, limit *pb.AddressedOrderLimit) (*piecestore.Client, error) {
+	return lr.dialPiecestore(ctx, &pb.Node{
+		Id:      limit.GetLimit().StorageNodeId,
+		Address: limit.GetStorageNodeAddress(),
+	})
 }
 
 type clientCloser struct {
 	piecestore.Downloader
 	client *piecestore.Client
+	record func(bytes int64)
+}
+
+// Read reads from the download and records the bytes read.
+func (client *clientCloser) Read(p []byte) (n int, err error) {
+	n, err = client.Downloader.Read(p)
+	client.record(int64(n))
+	return n, err
 }
 
 func (client *clientCloser) Close() error {
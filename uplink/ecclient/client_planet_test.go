@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"sync"
 	"testing"
 	"time"
 
@@ -21,6 +22,7 @@ import (
 	"storj.io/storj/internal/testplanet"
 	"storj.io/storj/internal/testrand"
 	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/ranger"
 	"storj.io/storj/pkg/signing"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/satellite"
@@ -69,6 +71,350 @@ func TestECClient(t *testing.T) {
 	testDelete(ctx, t, planet, ec, successfulNodes, successfulHashes)
 }
 
+func TestECClientDeleteWithAcknowledgments(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: storageNodes, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		ec := ecclient.NewClient(planet.Uplinks[0].Log.Named("ecclient"), planet.Uplinks[0].Transport, 0)
+
+		fc, err := infectious.NewFEC(storageNodes/2, storageNodes)
+		require.NoError(t, err)
+
+		es := eestream.NewRSScheme(fc, dataSize.Int()/storageNodes)
+		rs, err := eestream.NewRedundancyStrategy(es, 0, 0)
+		require.NoError(t, err)
+
+		successfulNodes, successfulHashes := testPut(ctx, t, planet, ec, rs, testrand.BytesInt(dataSize.Int()))
+
+		piecePublicKey, piecePrivateKey, err := storj.NewPieceKey()
+		require.NoError(t, err)
+
+		limits := make([]*pb.AddressedOrderLimit, len(successfulNodes))
+		for i := range limits {
+			limits[i], err = newAddressedOrderLimit(ctx, pb.PieceAction_DELETE, planet.Satellites[0], piecePublicKey, planet.StorageNodes[i], successfulHashes[i].PieceId)
+			require.NoError(t, err)
+		}
+
+		// a stopped node cannot acknowledge the deletion
+		offline := planet.StorageNodes[storageNodes-1]
+		require.NoError(t, planet.StopPeer(offline))
+
+		acks, unacknowledged, err := ec.DeleteWithAcknowledgments(ctx, limits, piecePrivateKey)
+		require.NoError(t, err)
+		assert.Equal(t, storj.NodeIDList{offline.ID()}, unacknowledged)
+		require.Len(t, acks, storageNodes-1)
+
+		for _, ack := range acks {
+			var node *storagenode.Peer
+			for i, storageNode := range planet.StorageNodes {
+				if storageNode.ID() == ack.StorageNodeId {
+					node = storageNode
+					assert.Equal(t, successfulHashes[i].PieceId, ack.PieceId)
+				}
+			}
+			require.NotNil(t, node)
+			assert.Equal(t, planet.Satellites[0].ID(), ack.SatelliteId)
+
+			signee := signing.SigneeFromPeerIdentity(node.Identity.PeerIdentity())
+			require.NoError(t, signing.VerifyPieceDeletionAcknowledgment(ctx, signee, ack))
+		}
+	})
+}
+
+func TestECClientSharedDecodeLimiter(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: storageNodes, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		const memoryLimit = 64 * memory.KiB
+
+		// the shared limiter only has room for a single download
+		limiter := ecclient.NewDecodeLimiter(memoryLimit.Int())
+		uplink := planet.Uplinks[0]
+		ec1 := ecclient.NewClientWithDecodeLimiter(uplink.Log.Named("ecclient1"), uplink.Transport, memoryLimit.Int(), limiter)
+		ec2 := ecclient.NewClientWithDecodeLimiter(uplink.Log.Named("ecclient2"), uplink.Transport, memoryLimit.Int(), limiter)
+
+		fc, err := infectious.NewFEC(storageNodes/2, storageNodes)
+		require.NoError(t, err)
+
+		es := eestream.NewRSScheme(fc, dataSize.Int()/storageNodes)
+		rs, err := eestream.NewRedundancyStrategy(es, 0, 0)
+		require.NoError(t, err)
+
+		data := testrand.BytesInt(dataSize.Int())
+		successfulNodes, successfulHashes := testPut(ctx, t, planet, ec1, rs, data)
+
+		get := func(ec ecclient.Client) ranger.Ranger {
+			piecePublicKey, piecePrivateKey, err := storj.NewPieceKey()
+			require.NoError(t, err)
+
+			limits := make([]*pb.AddressedOrderLimit, es.TotalCount())
+			for i := range limits {
+				limits[i], err = newAddressedOrderLimit(ctx, pb.PieceAction_GET, planet.Satellites[0], piecePublicKey, planet.StorageNodes[i], successfulHashes[i].PieceId)
+				require.NoError(t, err)
+			}
+			require.Len(t, successfulNodes, len(limits))
+
+			rr, err := ec.Get(ctx, limits, piecePrivateKey, es, dataSize.Int64())
+			require.NoError(t, err)
+			return rr
+		}
+		rr1, rr2 := get(ec1), get(ec2)
+
+		r1, err := rr1.Range(ctx, 0, rr1.Size())
+		require.NoError(t, err)
+
+		second := make(chan io.ReadCloser, 1)
+		ctx.Go(func() error {
+			r2, err := rr2.Range(ctx, 0, rr2.Size())
+			second <- r2
+			return err
+		})
+
+		select {
+		case <-second:
+			t.Fatal("second download started while the first one held the shared memory")
+		case <-time.After(100 * time.Millisecond):
+		}
+
+		readData, err := ioutil.ReadAll(r1)
+		require.NoError(t, err)
+		assert.Equal(t, data, readData)
+		require.NoError(t, r1.Close())
+
+		r2 := <-second
+		readData, err = ioutil.ReadAll(r2)
+		require.NoError(t, err)
+		assert.Equal(t, data, readData)
+		require.NoError(t, r2.Close())
+	})
+}
+
+func TestECClientPutProgress(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: storageNodes, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		ec := ecclient.NewClient(planet.Uplinks[0].Log.Named("ecclient"), planet.Uplinks[0].Transport, 0)
+
+		fc, err := infectious.NewFEC(storageNodes/2, storageNodes)
+		require.NoError(t, err)
+
+		es := eestream.NewRSScheme(fc, dataSize.Int()/storageNodes)
+		rs, err := eestream.NewRedundancyStrategy(es, 0, 0)
+		require.NoError(t, err)
+
+		piecePublicKey, piecePrivateKey, err := storj.NewPieceKey()
+		require.NoError(t, err)
+
+		limits := make([]*pb.AddressedOrderLimit, rs.TotalCount())
+		for i := range limits {
+			limits[i], err = newAddressedOrderLimit(ctx, pb.PieceAction_PUT, planet.Satellites[0], piecePublicKey, planet.StorageNodes[i], storj.NewPieceID())
+			require.NoError(t, err)
+		}
+
+		var completed []int
+		successfulNodes, _, err := ec.PutWithProgress(ctx, limits, piecePrivateKey, rs, bytes.NewReader(testrand.BytesInt(dataSize.Int())), time.Now(), func(done, total int) {
+			assert.Equal(t, storageNodes, total)
+			completed = append(completed, done)
+		})
+		require.NoError(t, err)
+
+		var successes []int
+		for _, node := range successfulNodes {
+			if node != nil {
+				successes = append(successes, len(successes)+1)
+			}
+		}
+		assert.Equal(t, successes, completed)
+	})
+}
+
+func TestECClientGetWithSpares(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: storageNodes + 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		ec := ecclient.NewClient(planet.Uplinks[0].Log.Named("ecclient"), planet.Uplinks[0].Transport, 0)
+
+		fc, err := infectious.NewFEC(storageNodes/2, storageNodes)
+		require.NoError(t, err)
+
+		es := eestream.NewRSScheme(fc, dataSize.Int()/storageNodes)
+		rs, err := eestream.NewRedundancyStrategy(es, 0, 0)
+		require.NoError(t, err)
+
+		data := testrand.BytesInt(dataSize.Int())
+		successfulNodes, successfulHashes := testPut(ctx, t, planet, ec, rs, data)
+
+		piecePublicKey, piecePrivateKey, err := storj.NewPieceKey()
+		require.NoError(t, err)
+
+		// download just the required pieces, so that every one of them is needed
+		limits := make([]*pb.AddressedOrderLimit, es.TotalCount())
+		spares := make([]*pb.AddressedOrderLimit, es.TotalCount())
+		first, count := -1, 0
+		for i := range limits {
+			if successfulNodes[i] == nil || count == es.RequiredCount() {
+				continue
+			}
+			limits[i], err = newAddressedOrderLimit(ctx, pb.PieceAction_GET, planet.Satellites[0], piecePublicKey, planet.StorageNodes[i], successfulHashes[i].PieceId)
+			require.NoError(t, err)
+			if first < 0 {
+				first = i
+			}
+			count++
+		}
+		require.Equal(t, es.RequiredCount(), count)
+
+		// the primary node of the first piece is down, the spare limit
+		// addresses the node which actually stores the piece
+		down := planet.StorageNodes[storageNodes]
+		downAddress := down.Local().Address
+		require.NoError(t, planet.StopPeer(down))
+
+		spares[first] = limits[first]
+		limits[first] = &pb.AddressedOrderLimit{
+			StorageNodeAddress: downAddress,
+			Limit:              spares[first].Limit,
+		}
+
+		rr, err := ec.GetWithSpares(ctx, limits, spares, piecePrivateKey, es, dataSize.Int64())
+		require.NoError(t, err)
+
+		r, err := rr.Range(ctx, 0, rr.Size())
+		require.NoError(t, err)
+		readData, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+		assert.Equal(t, data, readData)
+		assert.NoError(t, r.Close())
+	})
+}
+
+// bandwidthRecorder sums the bytes reported for every action
+type bandwidthRecorder struct {
+	mu    sync.Mutex
+	bytes map[pb.PieceAction]int64
+}
+
+func (recorder *bandwidthRecorder) RecordBandwidth(action pb.PieceAction, bytes int64) {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	recorder.bytes[action] += bytes
+}
+
+func (recorder *bandwidthRecorder) total(action pb.PieceAction) int64 {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	return recorder.bytes[action]
+}
+
+func TestECClientBandwidthRecorder(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: storageNodes, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		recorder := &bandwidthRecorder{bytes: make(map[pb.PieceAction]int64)}
+		ec := ecclient.NewClient(planet.Uplinks[0].Log.Named("ecclient"), planet.Uplinks[0].Transport, 0).
+			WithBandwidthRecorder(recorder)
+
+		fc, err := infectious.NewFEC(storageNodes/2, storageNodes)
+		require.NoError(t, err)
+
+		es := eestream.NewRSScheme(fc, dataSize.Int()/storageNodes)
+		rs, err := eestream.NewRedundancyStrategy(es, 0, 0)
+		require.NoError(t, err)
+
+		data := testrand.BytesInt(dataSize.Int())
+		successfulNodes, successfulHashes := testPut(ctx, t, planet, ec, rs, data)
+
+		pieceSize := eestream.CalcPieceSize(dataSize.Int64(), rs)
+		uploaded := 0
+		for _, node := range successfulNodes {
+			if node != nil {
+				uploaded++
+			}
+		}
+		assert.Equal(t, pieceSize*int64(uploaded), recorder.total(pb.PieceAction_PUT))
+		assert.Zero(t, recorder.total(pb.PieceAction_GET))
+
+		piecePublicKey, piecePrivateKey, err := storj.NewPieceKey()
+		require.NoError(t, err)
+
+		// download just the required pieces, so that the data is read from every one of them
+		limits := make([]*pb.AddressedOrderLimit, es.TotalCount())
+		count := 0
+		for i := range limits {
+			if successfulNodes[i] == nil || count == es.RequiredCount() {
+				continue
+			}
+			limits[i], err = newAddressedOrderLimit(ctx, pb.PieceAction_GET, planet.Satellites[0], piecePublicKey, planet.StorageNodes[i], successfulHashes[i].PieceId)
+			require.NoError(t, err)
+			count++
+		}
+		require.Equal(t, es.RequiredCount(), count)
+
+		rr, err := ec.Get(ctx, limits, piecePrivateKey, es, dataSize.Int64())
+		require.NoError(t, err)
+
+		r, err := rr.Range(ctx, 0, rr.Size())
+		require.NoError(t, err)
+		readData, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+		assert.Equal(t, data, readData)
+		assert.NoError(t, r.Close())
+
+		// the stripes with the data are downloaded, but not the padding after them
+		stripes := (dataSize.Int64() + int64(es.StripeSize()) - 1) / int64(es.StripeSize())
+		assert.Equal(t, stripes*int64(es.ErasureShareSize()*es.RequiredCount()), recorder.total(pb.PieceAction_GET))
+		assert.Equal(t, pieceSize*int64(uploaded), recorder.total(pb.PieceAction_PUT))
+	})
+}
+
+func TestECClientPutProgressConcurrent(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: storageNodes, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		ec := ecclient.NewClient(planet.Uplinks[0].Log.Named("ecclient"), planet.Uplinks[0].Transport, 0)
+
+		fc, err := infectious.NewFEC(storageNodes/2, storageNodes)
+		require.NoError(t, err)
+
+		es := eestream.NewRSScheme(fc, dataSize.Int()/storageNodes)
+		rs, err := eestream.NewRedundancyStrategy(es, 0, 0)
+		require.NoError(t, err)
+
+		// upload several segments at the same time, each reporting the progress of its own pieces
+		const segments = 4
+		completed := make([][]int, segments)
+		for i := 0; i < segments; i++ {
+			i := i
+
+			piecePublicKey, piecePrivateKey, err := storj.NewPieceKey()
+			require.NoError(t, err)
+
+			limits := make([]*pb.AddressedOrderLimit, rs.TotalCount())
+			for k := range limits {
+				limits[k], err = newAddressedOrderLimit(ctx, pb.PieceAction_PUT, planet.Satellites[0], piecePublicKey, planet.StorageNodes[k], storj.NewPieceID())
+				require.NoError(t, err)
+			}
+			data := testrand.BytesInt(dataSize.Int())
+
+			ctx.Go(func() error {
+				_, _, err := ec.PutWithProgress(ctx, limits, piecePrivateKey, rs, bytes.NewReader(data), time.Now(), func(done, total int) {
+					completed[i] = append(completed[i], done)
+				})
+				return err
+			})
+		}
+		ctx.Wait()
+
+		// every segment sees each count once, in order
+		for _, counts := range completed {
+			require.NotEmpty(t, counts)
+			for k, done := range counts {
+				assert.Equal(t, k+1, done)
+			}
+		}
+	})
+}
+
 func testPut(ctx context.Context, t *testing.T, planet *testplanet.Planet, ec ecclient.Client, rs eestream.RedundancyStrategy, data []byte) ([]*pb.Node, []*pb.PieceHash) {
 	piecePublicKey, piecePrivateKey, err := storj.NewPieceKey()
 	require.NoError(t, err)
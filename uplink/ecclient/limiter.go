@@ -0,0 +1,86 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package ecclient
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"golang.org/x/sync/semaphore"
+
+	"storj.io/storj/pkg/ranger"
+)
+
+// DecodeLimiter caps the decode buffer memory used by the downloads of all
+// clients sharing it.
+type DecodeLimiter struct {
+	size int64
+	sema *semaphore.Weighted
+}
+
+// NewDecodeLimiter creates a limiter allowing at most size bytes of decode
+// buffers to be in use at once.
+func NewDecodeLimiter(size int) *DecodeLimiter {
+	return &DecodeLimiter{
+		size: int64(size),
+		sema: semaphore.NewWeighted(int64(size)),
+	}
+}
+
+// acquire reserves memory bytes, waiting until they are available.
+// It returns the amount that has to be passed to release.
+func (limiter *DecodeLimiter) acquire(ctx context.Context, memory int64) (int64, error) {
+	// a single download larger than the limit would otherwise wait forever
+	if memory > limiter.size {
+		memory = limiter.size
+	}
+	return memory, limiter.sema.Acquire(ctx, memory)
+}
+
+// release returns memory bytes reserved by acquire.
+func (limiter *DecodeLimiter) release(memory int64) {
+	limiter.sema.Release(memory)
+}
+
+// limitedRanger reserves the decode buffer memory from the limiter for every
+// reader it returns until that reader is closed.
+type limitedRanger struct {
+	ranger.Ranger
+	limiter *DecodeLimiter
+	memory  int64
+}
+
+// Range reserves the decode memory and returns the requested range.
+func (rr *limitedRanger) Range(ctx context.Context, offset, length int64) (_ io.ReadCloser, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	reserved, err := rr.limiter.acquire(ctx, rr.memory)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	r, err := rr.Ranger.Range(ctx, offset, length)
+	if err != nil {
+		rr.limiter.release(reserved)
+		return nil, err
+	}
+
+	return &limitedReadCloser{ReadCloser: r, limiter: rr.limiter, memory: reserved}, nil
+}
+
+// limitedReadCloser releases the reserved memory on Close.
+type limitedReadCloser struct {
+	io.ReadCloser
+	limiter *DecodeLimiter
+	memory  int64
+	once    sync.Once
+}
+
+// Close closes the underlying reader and releases the reserved memory.
+func (r *limitedReadCloser) Close() error {
+	err := r.ReadCloser.Close()
+	r.once.Do(func() { r.limiter.release(r.memory) })
+	return err
+}
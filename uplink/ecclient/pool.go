@@ -0,0 +1,182 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package ecclient
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"google.golang.org/grpc"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+)
+
+const (
+	// defaultPoolCapacity is the maximum number of nodes the client keeps connections to.
+	defaultPoolCapacity = 100
+	// defaultPoolIdleTimeout is how long an unused connection is kept before it is closed.
+	defaultPoolIdleTimeout = time.Minute
+)
+
+// connPool keeps connections to storage nodes open between operations, so
+// that repeated operations on the same node don't have to dial again.
+//
+// A connection is shared by all operations on its node. Connections that
+// haven't been used for idleTimeout are closed, and at most capacity nodes
+// are kept in the pool; connections that don't fit are closed after use.
+type connPool struct {
+	transport   transport.Client
+	capacity    int
+	idleTimeout time.Duration
+
+	mu    sync.Mutex
+	conns map[storj.NodeID]*pooledConn
+}
+
+// pooledConn is a connection to a single node and its usage.
+type pooledConn struct {
+	conn     *grpc.ClientConn
+	address  string
+	refs     int
+	lastUsed time.Time
+	// evicted is set when the connection was removed from the pool while in
+	// use. It is closed when the last user releases it.
+	evicted bool
+}
+
+func newConnPool(tc transport.Client, capacity int, idleTimeout time.Duration) *connPool {
+	return &connPool{
+		transport:   tc,
+		capacity:    capacity,
+		idleTimeout: idleTimeout,
+		conns:       make(map[storj.NodeID]*pooledConn),
+	}
+}
+
+// get returns a connection to node, dialing it when there is no connection in
+// the pool. release must be called once the connection isn't used anymore.
+func (pool *connPool) get(ctx context.Context, node *pb.Node) (conn *grpc.ClientConn, release func() error, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	address := node.GetAddress().GetAddress()
+
+	pool.mu.Lock()
+	pooled := pool.lookup(node.Id, address)
+	pool.mu.Unlock()
+	if pooled != nil {
+		return pooled.conn, pool.releaser(pooled), nil
+	}
+
+	dialed, err := pool.transport.DialNode(ctx, node)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	// another operation may have connected to the same node meanwhile
+	pooled = pool.lookup(node.Id, address)
+	if pooled != nil {
+		_ = dialed.Close()
+		return pooled.conn, pool.releaser(pooled), nil
+	}
+
+	if len(pool.conns) >= pool.capacity && !pool.evictOldestIdle() {
+		return dialed, dialed.Close, nil
+	}
+
+	pooled = &pooledConn{conn: dialed, address: address, refs: 1}
+	pool.conns[node.Id] = pooled
+	return pooled.conn, pool.releaser(pooled), nil
+}
+
+// lookup returns the pooled connection to id at address, after closing idle
+// connections. It must be called with mu held.
+func (pool *connPool) lookup(id storj.NodeID, address string) *pooledConn {
+	// failing to close a stale connection shouldn't fail the operation,
+	// so the errors from closing them are ignored.
+	now := time.Now()
+	for nodeID, pooled := range pool.conns {
+		if pooled.refs == 0 && now.Sub(pooled.lastUsed) > pool.idleTimeout {
+			delete(pool.conns, nodeID)
+			_ = pooled.conn.Close()
+		}
+	}
+
+	pooled, ok := pool.conns[id]
+	if ok && pooled.address != address {
+		// the node has moved, so the old connection shouldn't be handed out anymore
+		delete(pool.conns, id)
+		if pooled.refs == 0 {
+			_ = pooled.conn.Close()
+		} else {
+			pooled.evicted = true
+		}
+		return nil
+	}
+	if ok {
+		pooled.refs++
+	}
+	return pooled
+}
+
+// evictOldestIdle closes the least recently used idle connection. It returns
+// false when all pooled connections are in use. It must be called with mu held.
+func (pool *connPool) evictOldestIdle() bool {
+	var oldestID storj.NodeID
+	var oldest *pooledConn
+	for nodeID, pooled := range pool.conns {
+		if pooled.refs == 0 && (oldest == nil || pooled.lastUsed.Before(oldest.lastUsed)) {
+			oldestID, oldest = nodeID, pooled
+		}
+	}
+	if oldest == nil {
+		return false
+	}
+	delete(pool.conns, oldestID)
+	_ = oldest.conn.Close()
+	return true
+}
+
+// releaser returns a function that gives the connection back to the pool.
+func (pool *connPool) releaser(pooled *pooledConn) func() error {
+	var once sync.Once
+	return func() (err error) {
+		once.Do(func() {
+			pool.mu.Lock()
+			defer pool.mu.Unlock()
+
+			pooled.refs--
+			pooled.lastUsed = time.Now()
+			if pooled.refs == 0 && pooled.evicted {
+				err = pooled.conn.Close()
+			}
+		})
+		return err
+	}
+}
+
+// Close empties the pool and closes its idle connections. Connections still
+// in use are closed when they are released. The pool can still be used
+// afterwards, it will dial new connections.
+func (pool *connPool) Close() error {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	var group errs.Group
+	for nodeID, pooled := range pool.conns {
+		delete(pool.conns, nodeID)
+		if pooled.refs == 0 {
+			group.Add(pooled.conn.Close())
+		} else {
+			pooled.evicted = true
+		}
+	}
+	return group.Err()
+}
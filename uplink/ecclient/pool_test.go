@@ -0,0 +1,133 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package ecclient
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/transport"
+)
+
+// countingTransport creates connections without connecting and counts the dials per address.
+type countingTransport struct {
+	transport.Client
+	dials map[string]int
+}
+
+func (tc *countingTransport) DialNode(ctx context.Context, node *pb.Node, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	address := node.GetAddress().GetAddress()
+	tc.dials[address]++
+	return grpc.Dial(address, grpc.WithInsecure())
+}
+
+func TestConnPool(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	newNode := func(address string) *pb.Node {
+		return &pb.Node{Id: testrand.NodeID(), Address: &pb.NodeAddress{Address: address}}
+	}
+
+	t.Run("reuses connections", func(t *testing.T) {
+		tc := &countingTransport{dials: map[string]int{}}
+		pool := newConnPool(tc, defaultPoolCapacity, time.Hour)
+		defer ctx.Check(pool.Close)
+
+		node := newNode("127.0.0.1:10000")
+		var first *grpc.ClientConn
+		for i := 0; i < 5; i++ {
+			conn, release, err := pool.get(ctx, node)
+			require.NoError(t, err)
+			if first == nil {
+				first = conn
+			}
+			assert.Equal(t, first, conn)
+			require.NoError(t, release())
+		}
+		assert.Equal(t, 1, tc.dials["127.0.0.1:10000"])
+
+		// concurrent operations share the connection as well
+		conn1, release1, err := pool.get(ctx, node)
+		require.NoError(t, err)
+		conn2, release2, err := pool.get(ctx, node)
+		require.NoError(t, err)
+		assert.Equal(t, conn1, conn2)
+		require.NoError(t, release1())
+		require.NoError(t, release2())
+		assert.Equal(t, 1, tc.dials["127.0.0.1:10000"])
+	})
+
+	t.Run("evicts idle connections", func(t *testing.T) {
+		tc := &countingTransport{dials: map[string]int{}}
+		pool := newConnPool(tc, defaultPoolCapacity, time.Millisecond)
+		defer ctx.Check(pool.Close)
+
+		node := newNode("127.0.0.1:10001")
+		for i := 0; i < 2; i++ {
+			_, release, err := pool.get(ctx, node)
+			require.NoError(t, err)
+			require.NoError(t, release())
+			time.Sleep(10 * time.Millisecond)
+		}
+		assert.Equal(t, 2, tc.dials["127.0.0.1:10001"])
+	})
+
+	t.Run("redials moved nodes", func(t *testing.T) {
+		tc := &countingTransport{dials: map[string]int{}}
+		pool := newConnPool(tc, defaultPoolCapacity, time.Hour)
+		defer ctx.Check(pool.Close)
+
+		node := newNode("127.0.0.1:10002")
+		_, release, err := pool.get(ctx, node)
+		require.NoError(t, err)
+		require.NoError(t, release())
+
+		node.Address.Address = "127.0.0.1:10003"
+		_, release, err = pool.get(ctx, node)
+		require.NoError(t, err)
+		require.NoError(t, release())
+
+		assert.Equal(t, 1, tc.dials["127.0.0.1:10002"])
+		assert.Equal(t, 1, tc.dials["127.0.0.1:10003"])
+	})
+
+	t.Run("bounded capacity", func(t *testing.T) {
+		tc := &countingTransport{dials: map[string]int{}}
+		pool := newConnPool(tc, 1, time.Hour)
+		defer ctx.Check(pool.Close)
+
+		busy := newNode("127.0.0.1:10004")
+		_, releaseBusy, err := pool.get(ctx, busy)
+		require.NoError(t, err)
+
+		// the pool is full with a connection in use, so this one isn't kept
+		other := newNode("127.0.0.1:10005")
+		for i := 0; i < 2; i++ {
+			_, release, err := pool.get(ctx, other)
+			require.NoError(t, err)
+			require.NoError(t, release())
+		}
+		assert.Equal(t, 2, tc.dials["127.0.0.1:10005"])
+		assert.Len(t, pool.conns, 1)
+
+		// once idle, the least recently used connection makes room
+		require.NoError(t, releaseBusy())
+		for i := 0; i < 2; i++ {
+			_, release, err := pool.get(ctx, other)
+			require.NoError(t, err)
+			require.NoError(t, release())
+		}
+		assert.Equal(t, 3, tc.dials["127.0.0.1:10005"])
+		assert.Len(t, pool.conns, 1)
+	})
+}
@@ -0,0 +1,86 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package eestream
+
+import (
+	"sync"
+)
+
+type parallelDecodeScheme struct {
+	ErasureScheme
+	concurrency int
+}
+
+// NewParallelDecodeScheme returns an ErasureScheme which splits the erasure
+// shares of every stripe into up to concurrency parts and decodes them with es
+// at the same time. A concurrency below 2 returns es.
+//
+// es must decode every byte position of the erasure shares independently and
+// return the stripe as the concatenation of its first RequiredCount erasure
+// shares, as the Reed-Solomon scheme does.
+func NewParallelDecodeScheme(es ErasureScheme, concurrency int) ErasureScheme {
+	if concurrency < 2 {
+		return es
+	}
+	return &parallelDecodeScheme{ErasureScheme: es, concurrency: concurrency}
+}
+
+// Decode decodes the parts of the erasure shares in parallel and appends the
+// combined stripe to out.
+func (s *parallelDecodeScheme) Decode(out []byte, in map[int][]byte) ([]byte, error) {
+	shareSize := s.ErasureShareSize()
+	required := s.RequiredCount()
+
+	parts := s.concurrency
+	if parts > shareSize {
+		parts = shareSize
+	}
+	partSize := (shareSize + parts - 1) / parts
+
+	start := len(out)
+	out = append(out, make([]byte, required*shareSize)...)
+	stripe := out[start:]
+
+	var wg sync.WaitGroup
+	errs := make([]error, parts)
+	for part := 0; part < parts; part++ {
+		low := part * partSize
+		high := low + partSize
+		if high > shareSize {
+			high = shareSize
+		}
+		if low >= high {
+			break
+		}
+
+		partIn := make(map[int][]byte, len(in))
+		for num, data := range in {
+			partIn[num] = data[low:high]
+		}
+
+		wg.Add(1)
+		go func(part, low, high int) {
+			defer wg.Done()
+
+			decoded, err := s.ErasureScheme.Decode(nil, partIn)
+			if err != nil {
+				errs[part] = err
+				return
+			}
+
+			size := high - low
+			for i := 0; i < required; i++ {
+				copy(stripe[i*shareSize+low:i*shareSize+high], decoded[i*size:(i+1)*size])
+			}
+		}(part, low, high)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
@@ -12,6 +12,7 @@ import (
 	"io"
 	"io/ioutil"
 	"math/rand"
+	"sync"
 	"testing"
 	"time"
 
@@ -59,6 +60,68 @@ func TestRS(t *testing.T) {
 	assert.Equal(t, data, data2)
 }
 
+// countingScheme is an ErasureScheme which counts its Decode calls and
+// records the largest number of them running at the same time.
+type countingScheme struct {
+	ErasureScheme
+
+	mu        sync.Mutex
+	calls     int
+	active    int
+	maxActive int
+}
+
+func (s *countingScheme) Decode(out []byte, in map[int][]byte) ([]byte, error) {
+	s.mu.Lock()
+	s.calls++
+	s.active++
+	if s.active > s.maxActive {
+		s.maxActive = s.active
+	}
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		s.active--
+		s.mu.Unlock()
+	}()
+
+	time.Sleep(time.Millisecond)
+	return s.ErasureScheme.Decode(out, in)
+}
+
+func TestRSParallelDecode(t *testing.T) {
+	ctx := context.Background()
+	data := testrand.Bytes(32 * 1024)
+	fc, err := infectious.NewFEC(2, 4)
+	require.NoError(t, err)
+
+	const concurrency = 4
+	const stripes = 16
+
+	counting := &countingScheme{ErasureScheme: NewRSScheme(fc, 1024)}
+	rs, err := NewRedundancyStrategy(NewParallelDecodeScheme(counting, concurrency), 0, 0)
+	require.NoError(t, err)
+
+	readers, err := EncodeReader(ctx, zaptest.NewLogger(t), bytes.NewReader(data), rs)
+	require.NoError(t, err)
+	readerMap := make(map[int]io.ReadCloser, len(readers))
+	for i, reader := range readers {
+		readerMap[i] = reader
+	}
+
+	decoder := DecodeReaders(ctx, zaptest.NewLogger(t), readerMap, rs, 32*1024, 0, false)
+	defer func() { assert.NoError(t, decoder.Close()) }()
+	data2, err := ioutil.ReadAll(decoder)
+	require.NoError(t, err)
+	assert.Equal(t, data, data2)
+
+	// every stripe is decoded in concurrency parts, at most concurrency at a time
+	assert.Equal(t, stripes*concurrency, counting.calls)
+	assert.True(t, counting.maxActive <= concurrency, "%d decodes at the same time", counting.maxActive)
+	assert.True(t, counting.maxActive > 1, "decodes aren't parallel")
+}
+
 // Check that io.ReadFull will return io.ErrUnexpectedEOF
 // if DecodeReaders return less data than expected.
 func TestRSUnexpectedEOF(t *testing.T) {
@@ -97,12 +97,22 @@ func (client *Client) CreateSegment(ctx context.Context, bucket string, path sto
 func (client *Client) CommitSegment(ctx context.Context, bucket string, path storj.Path, segmentIndex int64, pointer *pb.Pointer, originalLimits []*pb.OrderLimit) (savedPointer *pb.Pointer, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	return client.CommitSegmentIdempotent(ctx, bucket, path, segmentIndex, pointer, originalLimits, nil)
+}
+
+// CommitSegmentIdempotent requests to store the pointer for the segment. The satellite
+// commits the segment only once for the same idempotency key, a retried commit
+// returns the pointer saved by the first one.
+func (client *Client) CommitSegmentIdempotent(ctx context.Context, bucket string, path storj.Path, segmentIndex int64, pointer *pb.Pointer, originalLimits []*pb.OrderLimit, idempotencyKey []byte) (savedPointer *pb.Pointer, err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	response, err := client.client.CommitSegmentOld(ctx, &pb.SegmentCommitRequestOld{
 		Bucket:         []byte(bucket),
 		Path:           []byte(path),
 		Segment:        segmentIndex,
 		Pointer:        pointer,
 		OriginalLimits: originalLimits,
+		IdempotencyKey: idempotencyKey,
 	})
 	if err != nil {
 		return nil, Error.Wrap(err)
@@ -216,6 +226,42 @@ func (client *Client) ListSegments(ctx context.Context, bucket string, prefix, s
 	return items, response.GetMore(), nil
 }
 
+// SegmentRelocation is the new metadata of a segment moved with RelocateObject.
+type SegmentRelocation struct {
+	Index    int64
+	Metadata []byte
+}
+
+// RelocateObject moves the pointers of the given segments of the object at path
+// in bucket to newPath in newBucket, replacing their metadata. The satellite
+// relocates either all segments or none.
+func (client *Client) RelocateObject(ctx context.Context, bucket string, path storj.Path, newBucket string, newPath storj.Path, segments []SegmentRelocation) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	relocations := make([]*pb.SegmentRelocationOld, 0, len(segments))
+	for _, segment := range segments {
+		relocations = append(relocations, &pb.SegmentRelocationOld{
+			Segment:  segment.Index,
+			Metadata: segment.Metadata,
+		})
+	}
+
+	_, err = client.client.RelocateObjectOld(ctx, &pb.ObjectRelocateRequestOld{
+		Bucket:    []byte(bucket),
+		Path:      []byte(path),
+		NewBucket: []byte(newBucket),
+		NewPath:   []byte(newPath),
+		Segments:  relocations,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return storage.ErrKeyNotFound.Wrap(err)
+		}
+		return Error.Wrap(err)
+	}
+	return nil
+}
+
 // SetAttribution tries to set the attribution information on the bucket.
 func (client *Client) SetAttribution(ctx context.Context, bucket string, partnerID uuid.UUID) (err error) {
 	defer mon.Task()(&ctx)(&err)
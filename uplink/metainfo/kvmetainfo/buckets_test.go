@@ -21,6 +21,7 @@ import (
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/uplink/ecclient"
 	"storj.io/storj/uplink/eestream"
+	"storj.io/storj/uplink/metainfo"
 	"storj.io/storj/uplink/metainfo/kvmetainfo"
 	"storj.io/storj/uplink/storage/segments"
 	"storj.io/storj/uplink/storage/streams"
@@ -224,18 +225,33 @@ func runTest(t *testing.T, test func(*testing.T, context.Context, *testplanet.Pl
 }
 
 func newMetainfoParts(planet *testplanet.Planet) (*kvmetainfo.DB, streams.Store, error) {
+	metainfo, err := newMetainfoClient(planet)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key := new(storj.Key)
+	copy(key[:], TestEncKey)
+
+	encStore := encryption.NewStore()
+	encStore.SetDefaultKey(key)
+
+	return newMetainfoDB(planet, metainfo, encStore, 64*memory.MiB)
+}
+
+func newMetainfoClient(planet *testplanet.Planet) (*metainfo.Client, error) {
 	// TODO(kaloyan): We should have a better way for configuring the Satellite's API Key
 	// add project to satisfy constraint
 	project, err := planet.Satellites[0].DB.Console().Projects().Insert(context.Background(), &console.Project{
 		Name: "testProject",
 	})
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	apiKey, err := macaroon.NewAPIKey([]byte("testSecret"))
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	apiKeyInfo := console.APIKeyInfo{
@@ -247,15 +263,19 @@ func newMetainfoParts(planet *testplanet.Planet) (*kvmetainfo.DB, streams.Store,
 	// add api key to db
 	_, err = planet.Satellites[0].DB.Console().APIKeys().Create(context.Background(), apiKey.Head(), apiKeyInfo)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	metainfo, err := planet.Uplinks[0].DialMetainfo(context.Background(), planet.Satellites[0], apiKey.Serialize())
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 	// TODO(leak): call metainfo.Close somehow
 
+	return metainfo, nil
+}
+
+func newMetainfoDB(planet *testplanet.Planet, metainfo *metainfo.Client, encStore *encryption.Store, segmentSize memory.Size) (*kvmetainfo.DB, streams.Store, error) {
 	ec := ecclient.NewClient(planet.Uplinks[0].Log.Named("ecclient"), planet.Uplinks[0].Transport, 0)
 	fc, err := infectious.NewFEC(2, 4)
 	if err != nil {
@@ -269,20 +289,14 @@ func newMetainfoParts(planet *testplanet.Planet) (*kvmetainfo.DB, streams.Store,
 
 	segments := segments.NewSegmentStore(metainfo, ec, rs, 8*memory.KiB.Int(), 8*memory.MiB.Int64())
 
-	key := new(storj.Key)
-	copy(key[:], TestEncKey)
-
-	encStore := encryption.NewStore()
-	encStore.SetDefaultKey(key)
-
 	const stripesPerBlock = 2
 	blockSize := stripesPerBlock * rs.StripeSize()
 	inlineThreshold := 8 * memory.KiB.Int()
-	streams, err := streams.NewStreamStore(segments, 64*memory.MiB.Int64(), encStore, blockSize, storj.EncAESGCM, inlineThreshold)
+	streams, err := streams.NewStreamStore(segments, segmentSize.Int64(), encStore, blockSize, storj.EncAESGCM, inlineThreshold)
 	if err != nil {
 		return nil, nil, err
 	}
-	proj := kvmetainfo.NewProject(streams, int32(blockSize), rs, 64*memory.MiB.Int64(), *metainfo)
+	proj := kvmetainfo.NewProject(streams, int32(blockSize), rs, segmentSize.Int64(), *metainfo)
 	return kvmetainfo.New(proj, metainfo, streams, segments, encStore), streams, nil
 }
 
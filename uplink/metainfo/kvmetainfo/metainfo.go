@@ -22,6 +22,12 @@ var mon = monkit.Package()
 
 var errClass = errs.Class("kvmetainfo")
 
+// ErrQuotaExceeded is returned when a bucket is over its storage quota
+var ErrQuotaExceeded = errs.Class("quota exceeded")
+
+// ErrRemoteCopy is returned when copying an object with remote segments
+var ErrRemoteCopy = errs.Class("objects with remote segments cannot be copied")
+
 const defaultSegmentLimit = 8 // TODO
 
 var _ storj.Metainfo = (*DB)(nil)
@@ -36,6 +42,16 @@ type DB struct {
 	segments segments.Store
 
 	encStore *encryption.Store
+
+	quota QuotaProvider
+}
+
+// QuotaProvider reports how much storage a bucket uses and how much it is
+// allowed to use
+type QuotaProvider interface {
+	// BucketQuota returns the bytes used by the bucket and the bytes it is
+	// allowed to use. A non-positive allowed value means no limit.
+	BucketQuota(ctx context.Context, bucket string) (used, allowed memory.Size, err error)
 }
 
 // New creates a new metainfo database
@@ -49,6 +65,30 @@ func New(project *Project, metainfo *metainfo.Client, streams streams.Store, seg
 	}
 }
 
+// SetQuotaProvider sets the provider consulted before creating objects.
+// A nil provider disables the quota check.
+func (db *DB) SetQuotaProvider(quota QuotaProvider) {
+	db.quota = quota
+}
+
+// checkQuota returns ErrQuotaExceeded if the bucket uses all of its quota
+func (db *DB) checkQuota(ctx context.Context, bucket string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if db.quota == nil {
+		return nil
+	}
+
+	used, allowed, err := db.quota.BucketQuota(ctx, bucket)
+	if err != nil {
+		return errClass.Wrap(err)
+	}
+	if allowed > 0 && used >= allowed {
+		return ErrQuotaExceeded.New("bucket %q uses %s of %s", bucket, used, allowed)
+	}
+	return nil
+}
+
 // Limits returns limits for this metainfo database
 func (db *DB) Limits() (storj.MetainfoLimits, error) {
 	return storj.MetainfoLimits{
@@ -5,9 +5,11 @@ package kvmetainfo
 
 import (
 	"context"
+	"crypto/rand"
 	"errors"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/zeebo/errs"
 
 	"storj.io/storj/internal/memory"
 	"storj.io/storj/pkg/encryption"
@@ -15,6 +17,7 @@ import (
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/storage"
+	"storj.io/storj/uplink/metainfo"
 	"storj.io/storj/uplink/storage/meta"
 	"storj.io/storj/uplink/storage/objects"
 	"storj.io/storj/uplink/storage/segments"
@@ -83,6 +86,11 @@ func (db *DB) CreateObject(ctx context.Context, bucket string, path storj.Path,
 		return nil, storj.ErrNoPath.New("")
 	}
 
+	err = db.checkQuota(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+
 	info := storj.Object{
 		Bucket: bucketInfo,
 		Path:   path,
@@ -146,6 +154,294 @@ func (db *DB) DeleteObject(ctx context.Context, bucket string, path storj.Path)
 	return prefixed.Delete(ctx, path)
 }
 
+// RekeyObjectMetadata re-encrypts the path and the metadata of an object with
+// newKey as the root key of the bucket. The content keys of the segments are
+// kept and only re-encrypted with the key derived from newKey, so no segment
+// data is downloaded or uploaded again.
+func (db *DB) RekeyObjectMetadata(ctx context.Context, bucket string, path storj.Path, newKey *storj.Key) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if newKey == nil {
+		return errClass.New("new key is nil")
+	}
+
+	newStore := encryption.NewStore()
+	newStore.SetDefaultKey(newKey)
+
+	return db.relocateObject(ctx, bucket, path, db.encStore, bucket, path, newStore)
+}
+
+// MoveObject moves an object to dstPath in dstBucket by rewriting its pointers,
+// re-encrypting the path with the path cipher of the destination bucket.
+func (db *DB) MoveObject(ctx context.Context, srcBucket string, srcPath storj.Path, dstBucket string, dstPath storj.Path) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if srcBucket == dstBucket && srcPath == dstPath {
+		return nil
+	}
+
+	err = db.checkNotExists(ctx, dstBucket, dstPath)
+	if err != nil {
+		return err
+	}
+
+	return db.relocateObject(ctx, srcBucket, srcPath, db.encStore, dstBucket, dstPath, db.encStore)
+}
+
+// CopyObject copies an object to dstPath in dstBucket by committing copies of
+// its pointers with the segment content keys re-encrypted for the new path, so
+// no segment data is downloaded or uploaded again. Pieces of remote segments
+// would be shared by both objects and deleted together with either of them, so
+// only objects made of inline segments can be copied.
+func (db *DB) CopyObject(ctx context.Context, srcBucket string, srcPath storj.Path, dstBucket string, dstPath storj.Path) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if srcBucket == dstBucket && srcPath == dstPath {
+		return errClass.New("cannot copy object %q onto itself", srcPath)
+	}
+
+	err = db.checkNotExists(ctx, dstBucket, dstPath)
+	if err != nil {
+		return err
+	}
+
+	relocation, err := db.prepareRelocation(ctx, srcBucket, srcPath, db.encStore, dstBucket, dstPath, db.encStore)
+	if err != nil {
+		return err
+	}
+
+	for i, pointer := range relocation.pointers {
+		if pointer.GetType() != pb.Pointer_INLINE {
+			return ErrRemoteCopy.New("segment %d of %q", relocation.segmentIndexes[i], srcPath)
+		}
+	}
+
+	// a partial copy is removed again
+	var committed []int64
+	defer func() {
+		if err != nil {
+			err = errs.Combine(err, db.deleteSegments(ctx, dstBucket, relocation.dstEncPath, committed))
+		}
+	}()
+
+	for i, segmentIndex := range relocation.segmentIndexes {
+		_, err = db.metainfo.CommitSegment(ctx, dstBucket, relocation.dstEncPath.Raw(), segmentIndex, relocation.pointers[i], nil)
+		if err != nil {
+			return err
+		}
+		committed = append(committed, segmentIndex)
+	}
+	return nil
+}
+
+// checkNotExists returns an error unless there is no object at path in bucket.
+func (db *DB) checkNotExists(ctx context.Context, bucket string, path storj.Path) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, _, err = db.getInfo(ctx, bucket, path)
+	if err == nil {
+		return errClass.New("object %q already exists in bucket %q", path, bucket)
+	}
+	if !storj.ErrObjectNotFound.Has(err) {
+		return err
+	}
+	return nil
+}
+
+// relocateObject moves the pointers of the object at srcPath in srcBucket,
+// encrypted with the keys in srcStore, to dstPath in dstBucket encrypted with
+// the keys in dstStore. The satellite moves all pointers or none of them, so
+// a failure leaves the object where it was.
+func (db *DB) relocateObject(ctx context.Context, srcBucket string, srcPath storj.Path, srcStore *encryption.Store, dstBucket string, dstPath storj.Path, dstStore *encryption.Store) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	relocation, err := db.prepareRelocation(ctx, srcBucket, srcPath, srcStore, dstBucket, dstPath, dstStore)
+	if err != nil {
+		return err
+	}
+
+	// remote pointers keep their pieces, whose IDs and order limits don't
+	// depend on the path, so only the metadata has to be replaced
+	relocations := make([]metainfo.SegmentRelocation, 0, len(relocation.segmentIndexes))
+	for i, segmentIndex := range relocation.segmentIndexes {
+		relocations = append(relocations, metainfo.SegmentRelocation{
+			Index:    segmentIndex,
+			Metadata: relocation.pointers[i].Metadata,
+		})
+	}
+
+	err = db.metainfo.RelocateObject(ctx, srcBucket, relocation.srcEncPath.Raw(), dstBucket, relocation.dstEncPath.Raw(), relocations)
+	if storage.ErrKeyNotFound.Has(err) {
+		err = storj.ErrObjectNotFound.Wrap(err)
+	}
+	return err
+}
+
+// relocation holds the pointers of an object with their segment content keys
+// re-encrypted for a new location.
+type relocation struct {
+	srcEncPath     paths.Encrypted
+	dstEncPath     paths.Encrypted
+	segmentIndexes []int64
+	pointers       []*pb.Pointer
+}
+
+// prepareRelocation reads the pointers of the object at srcPath in srcBucket,
+// encrypted with the keys in srcStore, and re-encrypts their segment content
+// keys for dstPath in dstBucket encrypted with the keys in dstStore.
+func (db *DB) prepareRelocation(ctx context.Context, srcBucket string, srcPath storj.Path, srcStore *encryption.Store, dstBucket string, dstPath storj.Path, dstStore *encryption.Store) (_ *relocation, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if srcPath == "" || dstPath == "" {
+		return nil, storj.ErrNoPath.New("")
+	}
+
+	srcBucketInfo, err := db.GetBucket(ctx, srcBucket)
+	if err != nil {
+		return nil, err
+	}
+	dstBucketInfo, err := db.GetBucket(ctx, dstBucket)
+	if err != nil {
+		return nil, err
+	}
+
+	srcEncPath, err := encryption.EncryptPath(srcBucket, paths.NewUnencrypted(srcPath), srcBucketInfo.PathCipher, srcStore)
+	if err != nil {
+		return nil, err
+	}
+	dstEncPath, err := encryption.EncryptPath(dstBucket, paths.NewUnencrypted(dstPath), dstBucketInfo.PathCipher, dstStore)
+	if err != nil {
+		return nil, err
+	}
+
+	srcKey, err := encryption.DeriveContentKey(srcBucket, paths.NewUnencrypted(srcPath), srcStore)
+	if err != nil {
+		return nil, err
+	}
+	dstKey, err := encryption.DeriveContentKey(dstBucket, paths.NewUnencrypted(dstPath), dstStore)
+	if err != nil {
+		return nil, err
+	}
+
+	lastSegment, err := db.metainfo.SegmentInfo(ctx, srcBucket, srcEncPath.Raw(), -1)
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			err = storj.ErrObjectNotFound.Wrap(err)
+		}
+		return nil, err
+	}
+
+	streamInfoData, streamMeta, err := streams.TypedDecryptStreamInfo(ctx, lastSegment.GetMetadata(), streams.CreatePath(srcBucket, paths.NewUnencrypted(srcPath)), srcStore)
+	if err != nil {
+		return nil, err
+	}
+
+	var streamInfo pb.StreamInfo
+	err = proto.Unmarshal(streamInfoData, &streamInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	cipher := storj.CipherSuite(streamMeta.EncryptionType)
+
+	// the last segment is stored under index -1, all others under their position
+	segmentIndexes := make([]int64, 0, streamInfo.NumberOfSegments)
+	for i := int64(0); i < streamInfo.NumberOfSegments-1; i++ {
+		segmentIndexes = append(segmentIndexes, i)
+	}
+	segmentIndexes = append(segmentIndexes, -1)
+
+	pointers := make([]*pb.Pointer, len(segmentIndexes))
+	for i, segmentIndex := range segmentIndexes {
+		pointer := lastSegment
+		if segmentIndex != -1 {
+			pointer, err = db.metainfo.SegmentInfo(ctx, srcBucket, srcEncPath.Raw(), segmentIndex)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if segmentIndex == -1 {
+			err = reencryptSegmentKey(streamMeta.LastSegmentMeta, cipher, srcKey, dstKey)
+			if err != nil {
+				return nil, err
+			}
+			pointer.Metadata, err = proto.Marshal(&streamMeta)
+			if err != nil {
+				return nil, err
+			}
+		} else if len(pointer.Metadata) > 0 {
+			var segmentMeta pb.SegmentMeta
+			err = proto.Unmarshal(pointer.Metadata, &segmentMeta)
+			if err != nil {
+				return nil, err
+			}
+			err = reencryptSegmentKey(&segmentMeta, cipher, srcKey, dstKey)
+			if err != nil {
+				return nil, err
+			}
+			pointer.Metadata, err = proto.Marshal(&segmentMeta)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		pointers[i] = pointer
+	}
+
+	return &relocation{
+		srcEncPath:     srcEncPath,
+		dstEncPath:     dstEncPath,
+		segmentIndexes: segmentIndexes,
+		pointers:       pointers,
+	}, nil
+}
+
+// deleteSegments deletes the segments with the given indexes of the
+// object at encPath.
+func (db *DB) deleteSegments(ctx context.Context, bucket string, encPath paths.Encrypted, segmentIndexes []int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, segmentIndex := range segmentIndexes {
+		_, _, err = db.metainfo.DeleteSegment(ctx, bucket, encPath.Raw(), segmentIndex)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reencryptSegmentKey decrypts the content key in segmentMeta with oldKey and
+// encrypts it again with newKey using a new random nonce.
+func reencryptSegmentKey(segmentMeta *pb.SegmentMeta, cipher storj.CipherSuite, oldKey, newKey *storj.Key) error {
+	if segmentMeta == nil {
+		return nil
+	}
+
+	var oldNonce storj.Nonce
+	copy(oldNonce[:], segmentMeta.KeyNonce)
+
+	contentKey, err := encryption.DecryptKey(segmentMeta.EncryptedKey, cipher, oldKey, &oldNonce)
+	if err != nil {
+		return err
+	}
+
+	var newNonce storj.Nonce
+	_, err = rand.Read(newNonce[:])
+	if err != nil {
+		return err
+	}
+
+	encryptedKey, err := encryption.EncryptKey(contentKey, cipher, newKey, &newNonce)
+	if err != nil {
+		return err
+	}
+
+	segmentMeta.EncryptedKey = encryptedKey
+	segmentMeta.KeyNonce = newNonce[:]
+	return nil
+}
+
 // ModifyPendingObject creates an interface for updating a partially uploaded object
 func (db *DB) ModifyPendingObject(ctx context.Context, bucket string, path storj.Path) (object storj.MutableObject, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -334,6 +630,12 @@ func objectStreamFromMeta(bucket storj.Bucket, path storj.Path, lastSegment segm
 		return storj.Object{}, err
 	}
 
+	// flushing the upload can store segments of different sizes
+	fixedSegmentSize := stream.SegmentsSize
+	if len(stream.SegmentSizes) > 0 {
+		fixedSegmentSize = -1
+	}
+
 	return storj.Object{
 		Version:  0, // TODO:
 		Bucket:   bucket,
@@ -348,11 +650,11 @@ func objectStreamFromMeta(bucket storj.Bucket, path storj.Path, lastSegment segm
 		Expires:     lastSegment.Expiration, // TODO: use correct field
 
 		Stream: storj.Stream{
-			Size: stream.SegmentsSize*(stream.NumberOfSegments-1) + stream.LastSegmentSize,
+			Size: streams.StreamSize(stream),
 			// Checksum: []byte(object.Checksum),
 
 			SegmentCount:     stream.NumberOfSegments,
-			FixedSegmentSize: stream.SegmentsSize,
+			FixedSegmentSize: fixedSegmentSize,
 
 			RedundancyScheme: storj.RedundancyScheme{
 				Algorithm:      storj.ReedSolomon,
@@ -13,9 +13,12 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/testplanet"
 	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/encryption"
 	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
 	"storj.io/storj/uplink/metainfo/kvmetainfo"
 	"storj.io/storj/uplink/storage/streams"
 	"storj.io/storj/uplink/stream"
@@ -86,6 +89,46 @@ func TestCreateObject(t *testing.T) {
 	})
 }
 
+type fixedQuota struct {
+	used, allowed memory.Size
+	buckets       []string
+}
+
+func (quota *fixedQuota) BucketQuota(ctx context.Context, bucket string) (used, allowed memory.Size, err error) {
+	quota.buckets = append(quota.buckets, bucket)
+	return quota.used, quota.allowed, nil
+}
+
+func TestCreateObjectQuotaExceeded(t *testing.T) {
+	runTest(t, func(t *testing.T, ctx context.Context, planet *testplanet.Planet, db *kvmetainfo.DB, streams streams.Store) {
+		bucket, err := db.CreateBucket(ctx, TestBucket, nil)
+		require.NoError(t, err)
+
+		quota := &fixedQuota{used: 2 * memory.MiB, allowed: memory.MiB}
+		db.SetQuotaProvider(quota)
+
+		_, err = db.CreateObject(ctx, bucket.Name, TestFile, nil)
+		require.Error(t, err)
+		assert.True(t, kvmetainfo.ErrQuotaExceeded.Has(err))
+		assert.Equal(t, []string{bucket.Name}, quota.buckets)
+
+		// nothing was uploaded to the storage nodes
+		for _, node := range planet.StorageNodes {
+			used, err := node.DB.PieceInfo().SpaceUsed(ctx)
+			require.NoError(t, err)
+			assert.Zero(t, used)
+		}
+
+		quota.allowed = 4 * memory.MiB
+		_, err = db.CreateObject(ctx, bucket.Name, TestFile, nil)
+		require.NoError(t, err)
+
+		db.SetQuotaProvider(nil)
+		_, err = db.CreateObject(ctx, bucket.Name, TestFile, nil)
+		require.NoError(t, err)
+	})
+}
+
 func TestGetObject(t *testing.T) {
 	runTest(t, func(t *testing.T, ctx context.Context, planet *testplanet.Planet, db *kvmetainfo.DB, streams streams.Store) {
 		bucket, err := db.CreateBucket(ctx, TestBucket, nil)
@@ -174,6 +217,73 @@ func upload(ctx context.Context, t *testing.T, db *kvmetainfo.DB, streams stream
 	require.NoError(t, err)
 }
 
+func TestUploadFlush(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		metainfo, err := newMetainfoClient(planet)
+		require.NoError(t, err)
+
+		key := new(storj.Key)
+		copy(key[:], TestEncKey)
+		encStore := encryption.NewStore()
+		encStore.SetDefaultKey(key)
+
+		const segmentSize = 2 * memory.KiB
+		db, streams, err := newMetainfoDB(planet, metainfo, encStore, segmentSize)
+		require.NoError(t, err)
+
+		bucket, err := db.CreateBucket(ctx, TestBucket, &storj.Bucket{PathCipher: storj.EncNull})
+		require.NoError(t, err)
+
+		obj, err := db.CreateObject(ctx, bucket.Name, TestFile, nil)
+		require.NoError(t, err)
+
+		str, err := obj.CreateStream(ctx)
+		require.NoError(t, err)
+
+		uploadCtx, cancel := context.WithCancel(ctx)
+		upload := stream.NewUpload(uploadCtx, str, streams)
+
+		data := testrand.Bytes(4 * segmentSize)
+		half := segmentSize.Int() / 2
+
+		// the first segment is full and the second one is flushed half full
+		_, err = upload.Write(data[:3*half])
+		require.NoError(t, err)
+		require.NoError(t, upload.Flush(ctx))
+		assert.Equal(t, data[:3*half], download(ctx, t, db, streams, bucket.Name, TestFile))
+
+		// the third segment is full before the flush
+		_, err = upload.Write(data[3*half : 5*half])
+		require.NoError(t, err)
+		require.NoError(t, upload.Flush(ctx))
+
+		for index, size := range []int{2 * half, half, 2 * half} {
+			pointer, err := metainfo.SegmentInfo(ctx, bucket.Name, TestFile, int64(index))
+			require.NoError(t, err)
+			assert.True(t, pointer.SegmentSize >= int64(size))
+		}
+
+		// aborting the upload keeps the flushed data only
+		_, err = upload.Write(data[5*half:])
+		require.NoError(t, err)
+		cancel()
+		require.Error(t, upload.Close())
+
+		_, err = metainfo.SegmentInfo(ctx, bucket.Name, TestFile, 3)
+		assert.True(t, storage.ErrKeyNotFound.Has(err), "%+v", err)
+
+		assert.Equal(t, data[:5*half], download(ctx, t, db, streams, bucket.Name, TestFile))
+
+		object, err := db.GetObject(ctx, bucket.Name, TestFile)
+		require.NoError(t, err)
+		assert.EqualValues(t, 5*half, object.Size)
+		assert.EqualValues(t, 4, object.SegmentCount)
+		assert.EqualValues(t, -1, object.FixedSegmentSize)
+	})
+}
+
 func assertStream(ctx context.Context, t *testing.T, db *kvmetainfo.DB, streams streams.Store, bucket storj.Bucket, path storj.Path, content []byte) {
 	readOnly, err := db.GetObjectStream(ctx, bucket.Name, path)
 	require.NoError(t, err)
@@ -266,6 +376,118 @@ func TestDeleteObject(t *testing.T) {
 	})
 }
 
+func TestRekeyObjectMetadata(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		metainfo, err := newMetainfoClient(planet)
+		require.NoError(t, err)
+
+		oldKey := new(storj.Key)
+		copy(oldKey[:], TestEncKey)
+		oldStore := encryption.NewStore()
+		oldStore.SetDefaultKey(oldKey)
+
+		db, streams, err := newMetainfoDB(planet, metainfo, oldStore, 64*memory.MiB)
+		require.NoError(t, err)
+
+		bucket, err := db.CreateBucket(ctx, TestBucket, nil)
+		require.NoError(t, err)
+
+		remoteBucket, err := db.CreateBucket(ctx, "remote-bucket", nil)
+		require.NoError(t, err)
+
+		data := []byte("test")
+		upload(ctx, t, db, streams, bucket, "small-file", data)
+
+		newKey := testrand.Key()
+		err = db.RekeyObjectMetadata(ctx, bucket.Name, "small-file", &newKey)
+		require.NoError(t, err)
+
+		largeData := testrand.Bytes(32 * memory.KiB)
+		upload(ctx, t, db, streams, remoteBucket, "large-file", largeData)
+		err = db.RekeyObjectMetadata(ctx, remoteBucket.Name, "large-file", &newKey)
+		require.NoError(t, err)
+
+		// the old key cannot decrypt the rekeyed objects anymore
+		_, err = db.GetObject(ctx, bucket.Name, "small-file")
+		assert.True(t, storj.ErrObjectNotFound.Has(err))
+		_, err = db.GetObject(ctx, remoteBucket.Name, "large-file")
+		assert.True(t, storj.ErrObjectNotFound.Has(err))
+
+		newStore := encryption.NewStore()
+		newStore.SetDefaultKey(&newKey)
+
+		newDB, newStreams, err := newMetainfoDB(planet, metainfo, newStore, 64*memory.MiB)
+		require.NoError(t, err)
+
+		list, err := newDB.ListObjects(ctx, bucket.Name, storj.ListOptions{Direction: storj.After})
+		require.NoError(t, err)
+		require.Len(t, list.Items, 1)
+		assert.Equal(t, "small-file", list.Items[0].Path)
+
+		assertStream(ctx, t, newDB, newStreams, bucket, "small-file", data)
+		assert.Equal(t, largeData, download(ctx, t, newDB, newStreams, remoteBucket.Name, "large-file"))
+	})
+}
+
+func TestMoveObject(t *testing.T) {
+	runTest(t, func(t *testing.T, ctx context.Context, planet *testplanet.Planet, db *kvmetainfo.DB, streams streams.Store) {
+		src, err := db.CreateBucket(ctx, TestBucket, nil)
+		require.NoError(t, err)
+
+		dst, err := db.CreateBucket(ctx, "dst-bucket", &storj.Bucket{PathCipher: storj.EncNull})
+		require.NoError(t, err)
+
+		upload(ctx, t, db, streams, dst, "existing-file", nil)
+
+		for _, tt := range []struct {
+			name string
+			data []byte
+		}{
+			{name: "inline", data: testrand.Bytes(2 * memory.KiB)},
+			{name: "remote", data: testrand.Bytes(32 * memory.KiB)},
+		} {
+			upload(ctx, t, db, streams, src, tt.name, tt.data)
+
+			before, err := db.GetObject(ctx, src.Name, tt.name)
+			require.NoError(t, err)
+
+			err = db.MoveObject(ctx, src.Name, tt.name, dst.Name, "existing-file")
+			require.Error(t, err)
+
+			err = db.MoveObject(ctx, src.Name, tt.name, dst.Name, "moved/"+tt.name)
+			require.NoError(t, err, tt.name)
+
+			_, err = db.GetObject(ctx, src.Name, tt.name)
+			assert.True(t, storj.ErrObjectNotFound.Has(err), tt.name)
+
+			after, err := db.GetObject(ctx, dst.Name, "moved/"+tt.name)
+			require.NoError(t, err, tt.name)
+			assert.Equal(t, storj.EncNull, after.Bucket.PathCipher)
+			assert.Equal(t, before.Size, after.Size)
+			assert.Equal(t, before.Metadata, after.Metadata)
+			assert.Equal(t, before.EncryptionParameters, after.EncryptionParameters)
+			assert.Equal(t, before.RedundancyScheme, after.RedundancyScheme)
+
+			assert.Equal(t, tt.data, download(ctx, t, db, streams, dst.Name, "moved/"+tt.name), tt.name)
+		}
+	})
+}
+
+func download(ctx context.Context, t *testing.T, db *kvmetainfo.DB, streams streams.Store, bucket string, path storj.Path) []byte {
+	readOnly, err := db.GetObjectStream(ctx, bucket, path)
+	require.NoError(t, err)
+
+	download := stream.NewDownload(ctx, readOnly, streams)
+	defer func() { assert.NoError(t, download.Close()) }()
+
+	data := make([]byte, readOnly.Info().Size)
+	_, err = io.ReadFull(download, data)
+	require.NoError(t, err)
+	return data
+}
+
 func TestListObjectsEmpty(t *testing.T) {
 	runTest(t, func(t *testing.T, ctx context.Context, planet *testplanet.Planet, db *kvmetainfo.DB, streams streams.Store) {
 		bucket, err := db.CreateBucket(ctx, TestBucket, nil)
@@ -10,8 +10,10 @@ import (
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
 
 	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/pkg/transport"
@@ -44,6 +46,9 @@ type Client struct {
 	client pb.PiecestoreClient
 	conn   *grpc.ClientConn
 	config Config
+
+	// release is called instead of closing conn when the connection is shared.
+	release func() error
 }
 
 // Dial dials the target piecestore endpoint.
@@ -61,6 +66,19 @@ func Dial(ctx context.Context, transport transport.Client, target *pb.Node, log
 	}, nil
 }
 
+// NewSharedClient creates a client using an already established connection,
+// which may be shared by several clients. Closing the client calls release
+// instead of closing the connection.
+func NewSharedClient(conn *grpc.ClientConn, log *zap.Logger, config Config, release func() error) *Client {
+	return &Client{
+		log:     log,
+		client:  pb.NewPiecestoreClient(conn),
+		conn:    conn,
+		config:  config,
+		release: release,
+	}
+}
+
 // Delete uses delete order limit to delete a piece on piece store.
 func (client *Client) Delete(ctx context.Context, limit *pb.OrderLimit, privateKey storj.PiecePrivateKey) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -70,6 +88,33 @@ func (client *Client) Delete(ctx context.Context, limit *pb.OrderLimit, privateK
 	return Error.Wrap(err)
 }
 
+// DeleteWithAcknowledgment uses delete order limit to delete a piece on piece store
+// and returns the verified deletion acknowledgment signed by the storage node.
+func (client *Client) DeleteWithAcknowledgment(ctx context.Context, limit *pb.OrderLimit, privateKey storj.PiecePrivateKey) (_ *pb.PieceDeletionAcknowledgment, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var remote peer.Peer
+	response, err := client.client.Delete(ctx, &pb.PieceDeleteRequest{
+		Limit:       limit,
+		Acknowledge: true,
+	}, grpc.Peer(&remote))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	peerIdentity, err := identity.PeerIdentityFromPeer(&remote)
+	if err != nil {
+		return nil, ErrInternal.Wrap(err)
+	}
+
+	err = client.VerifyPieceDeletionAcknowledgment(ctx, peerIdentity, limit, response.Acknowledgment)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Acknowledgment, nil
+}
+
 // Retain uses a bloom filter to tell the piece store which pieces to keep.
 func (client *Client) Retain(ctx context.Context, req *pb.RetainRequest) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -77,8 +122,11 @@ func (client *Client) Retain(ctx context.Context, req *pb.RetainRequest) (err er
 	return Error.Wrap(err)
 }
 
-// Close closes the underlying connection.
+// Close closes the underlying connection, or releases it when it is shared.
 func (client *Client) Close() error {
+	if client.release != nil {
+		return client.release()
+	}
 	return client.conn.Close()
 }
 
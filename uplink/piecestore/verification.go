@@ -42,3 +42,30 @@ func (client *Client) VerifyPieceHash(ctx context.Context, peer *identity.PeerId
 
 	return nil
 }
+
+// VerifyPieceDeletionAcknowledgment verifies that the deletion acknowledgment
+// sent by peer matches the order limit and is signed by peer.
+func (client *Client) VerifyPieceDeletionAcknowledgment(ctx context.Context, peer *identity.PeerIdentity, limit *pb.OrderLimit, ack *pb.PieceDeletionAcknowledgment) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if peer == nil || limit == nil {
+		return ErrProtocol.New("invalid arguments")
+	}
+	if ack == nil {
+		return ErrVerifyUntrusted.New("missing deletion acknowledgment")
+	}
+	if ack.PieceId != limit.PieceId {
+		return ErrVerifyUntrusted.New("acknowledged piece id %v, expected %v", ack.PieceId, limit.PieceId)
+	}
+	if ack.SatelliteId != limit.SatelliteId {
+		return ErrVerifyUntrusted.New("acknowledged satellite id %v, expected %v", ack.SatelliteId, limit.SatelliteId)
+	}
+	if ack.StorageNodeId != peer.ID {
+		return ErrVerifyUntrusted.New("acknowledged by %v, expected %v", ack.StorageNodeId, peer.ID)
+	}
+
+	if err := signing.VerifyPieceDeletionAcknowledgment(ctx, signing.SigneeFromPeerIdentity(peer), ack); err != nil {
+		return ErrVerifyUntrusted.New("invalid deletion acknowledgment signature: %v", err)
+	}
+
+	return nil
+}
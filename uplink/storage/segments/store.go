@@ -30,6 +30,9 @@ type Meta struct {
 	Modified   time.Time
 	Expiration time.Time
 	Size       int64
+	// StoredSize is the number of bytes stored for the segment: the inline
+	// data, or the erasure coded piece size times the number of pieces.
+	StoredSize int64
 	Data       []byte
 }
 
@@ -44,8 +47,11 @@ type ListItem struct {
 type Store interface {
 	Meta(ctx context.Context, path storj.Path) (meta Meta, err error)
 	Get(ctx context.Context, path storj.Path) (rr ranger.Ranger, meta Meta, err error)
+	GetRange(ctx context.Context, path storj.Path, offset, length int64) (data io.ReadCloser, meta Meta, err error)
 	Put(ctx context.Context, data io.Reader, expiration time.Time, segmentInfo func() (storj.Path, []byte, error)) (meta Meta, err error)
+	PutIdempotent(ctx context.Context, data io.Reader, expiration time.Time, idempotencyKey []byte, segmentInfo func() (storj.Path, []byte, error)) (meta Meta, err error)
 	Delete(ctx context.Context, path storj.Path) (err error)
+	DeleteVerified(ctx context.Context, path storj.Path) (unconfirmed storj.NodeIDList, err error)
 	List(ctx context.Context, prefix, startAfter, endBefore storj.Path, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error)
 }
 
@@ -89,6 +95,15 @@ func (s *segmentStore) Meta(ctx context.Context, path storj.Path) (meta Meta, er
 func (s *segmentStore) Put(ctx context.Context, data io.Reader, expiration time.Time, segmentInfo func() (storj.Path, []byte, error)) (meta Meta, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	return s.PutIdempotent(ctx, data, expiration, nil, segmentInfo)
+}
+
+// PutIdempotent uploads a segment like Put, passing the idempotency key along with
+// the commit. Retrying with the same key doesn't commit the segment a second time,
+// the segment committed first is returned instead.
+func (s *segmentStore) PutIdempotent(ctx context.Context, data io.Reader, expiration time.Time, idempotencyKey []byte, segmentInfo func() (storj.Path, []byte, error)) (meta Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	redundancy := &pb.RedundancyScheme{
 		Type:             pb.RedundancyScheme_RS,
 		MinReq:           int32(s.rs.RequiredCount()),
@@ -168,7 +183,7 @@ func (s *segmentStore) Put(ctx context.Context, data io.Reader, expiration time.
 		return Meta{}, err
 	}
 
-	savedPointer, err := s.metainfo.CommitSegment(ctx, bucket, objectPath, segmentIndex, pointer, originalLimits)
+	savedPointer, err := s.metainfo.CommitSegmentIdempotent(ctx, bucket, objectPath, segmentIndex, pointer, originalLimits, idempotencyKey)
 	if err != nil {
 		return Meta{}, Error.Wrap(err)
 	}
@@ -195,22 +210,49 @@ func (s *segmentStore) Get(ctx context.Context, path storj.Path) (rr ranger.Rang
 		return ranger.ByteRanger(pointer.InlineSegment), convertMeta(pointer), nil
 	case pb.Pointer_REMOTE:
 		needed := CalcNeededNodes(pointer.GetRemote().GetRedundancy())
-		selected := make([]*pb.AddressedOrderLimit, len(limits))
-
-		for _, i := range rand.Perm(len(limits)) {
-			limit := limits[i]
-			if limit == nil {
-				continue
-			}
+		selected := selectLimits(limits, needed)
 
-			selected[i] = limit
+		redundancy, err := eestream.NewRedundancyStrategyFromProto(pointer.GetRemote().GetRedundancy())
+		if err != nil {
+			return nil, Meta{}, err
+		}
 
-			needed--
-			if needed <= 0 {
-				break
-			}
+		rr, err = s.ec.Get(ctx, selected, piecePrivateKey, redundancy, pointer.GetSegmentSize())
+		if err != nil {
+			return nil, Meta{}, Error.Wrap(err)
 		}
 
+		return rr, convertMeta(pointer), nil
+	default:
+		return nil, Meta{}, Error.New("unsupported pointer type: %d", pointer.GetType())
+	}
+}
+
+// GetRange reads length bytes of a segment starting at offset. Unlike Get, it
+// downloads only as many pieces as are required to decode the segment, and
+// only the stripes of those pieces which cover the range. Without extra
+// pieces errors can't be corrected, so the read fails when any of the
+// contacted nodes fails.
+func (s *segmentStore) GetRange(ctx context.Context, path storj.Path, offset, length int64) (data io.ReadCloser, meta Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucket, objectPath, segmentIndex, err := splitPathFragments(path)
+	if err != nil {
+		return nil, Meta{}, err
+	}
+
+	pointer, limits, piecePrivateKey, err := s.metainfo.ReadSegment(ctx, bucket, objectPath, segmentIndex)
+	if err != nil {
+		return nil, Meta{}, Error.Wrap(err)
+	}
+
+	var rr ranger.Ranger
+	switch pointer.GetType() {
+	case pb.Pointer_INLINE:
+		rr = ranger.ByteRanger(pointer.InlineSegment)
+	case pb.Pointer_REMOTE:
+		selected := selectLimits(limits, pointer.GetRemote().GetRedundancy().GetMinReq())
+
 		redundancy, err := eestream.NewRedundancyStrategyFromProto(pointer.GetRemote().GetRedundancy())
 		if err != nil {
 			return nil, Meta{}, err
@@ -220,11 +262,37 @@ func (s *segmentStore) Get(ctx context.Context, path storj.Path) (rr ranger.Rang
 		if err != nil {
 			return nil, Meta{}, Error.Wrap(err)
 		}
-
-		return rr, convertMeta(pointer), nil
 	default:
 		return nil, Meta{}, Error.New("unsupported pointer type: %d", pointer.GetType())
 	}
+
+	data, err = rr.Range(ctx, offset, length)
+	if err != nil {
+		return nil, Meta{}, Error.Wrap(err)
+	}
+	return data, convertMeta(pointer), nil
+}
+
+// selectLimits randomly selects needed of the non-nil limits, keeping them at
+// the index of their piece number.
+func selectLimits(limits []*pb.AddressedOrderLimit, needed int32) []*pb.AddressedOrderLimit {
+	selected := make([]*pb.AddressedOrderLimit, len(limits))
+
+	for _, i := range rand.Perm(len(limits)) {
+		limit := limits[i]
+		if limit == nil {
+			continue
+		}
+
+		selected[i] = limit
+
+		needed--
+		if needed <= 0 {
+			break
+		}
+	}
+
+	return selected
 }
 
 // makeRemotePointer creates a pointer of type remote
@@ -296,6 +364,36 @@ func (s *segmentStore) Delete(ctx context.Context, path storj.Path) (err error)
 	return nil
 }
 
+// DeleteVerified deletes a segment like Delete, but requires every storage node
+// to acknowledge the deletion of its piece. It returns the nodes that did not
+// confirm the deletion, so that the caller can retry deleting from them.
+func (s *segmentStore) DeleteVerified(ctx context.Context, path storj.Path) (unconfirmed storj.NodeIDList, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucket, objectPath, segmentIndex, err := splitPathFragments(path)
+	if err != nil {
+		return nil, err
+	}
+
+	limits, privateKey, err := s.metainfo.DeleteSegment(ctx, bucket, objectPath, segmentIndex)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if len(limits) == 0 {
+		// inline segment - nothing else to do
+		return nil, nil
+	}
+
+	// remote segment - delete the pieces from storage nodes
+	_, unconfirmed, err = s.ec.DeleteWithAcknowledgments(ctx, limits, privateKey)
+	if err != nil {
+		return unconfirmed, Error.Wrap(err)
+	}
+
+	return unconfirmed, nil
+}
+
 // List retrieves paths to segments and their metadata stored in the metainfo
 func (s *segmentStore) List(ctx context.Context, prefix, startAfter, endBefore storj.Path, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -350,10 +448,26 @@ func convertMeta(pr *pb.Pointer) Meta {
 		Modified:   pr.GetCreationDate(),
 		Expiration: pr.GetExpirationDate(),
 		Size:       pr.GetSegmentSize(),
+		StoredSize: storedSize(pr),
 		Data:       pr.GetMetadata(),
 	}
 }
 
+// storedSize returns the number of bytes the storage nodes or the satellite
+// keep for the segment, including the erasure coding overhead.
+func storedSize(pr *pb.Pointer) int64 {
+	if pr.GetType() == pb.Pointer_INLINE {
+		return int64(len(pr.GetInlineSegment()))
+	}
+
+	remote := pr.GetRemote()
+	rs, err := eestream.NewRedundancyStrategyFromProto(remote.GetRedundancy())
+	if err != nil {
+		return 0
+	}
+	return eestream.CalcPieceSize(pr.GetSegmentSize(), rs) * int64(len(remote.GetRemotePieces()))
+}
+
 func splitPathFragments(path storj.Path) (bucket string, objectPath storj.Path, segmentIndex int64, err error) {
 	components := storj.SplitPath(path)
 	if len(components) < 1 {
@@ -110,6 +110,67 @@ func TestSegmentStorePutGet(t *testing.T) {
 	}
 }
 
+func TestSegmentStoreGetRange(t *testing.T) {
+	runTest(t, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet, segmentStore segments.Store) {
+		data := testrand.Bytes(256 * memory.KiB)
+		path := "s0/test-bucket/mypath/1"
+		_, err := segmentStore.Put(ctx, bytes.NewReader(data), time.Time{}, func() (storj.Path, []byte, error) {
+			return path, []byte("metadata"), nil
+		})
+		require.NoError(t, err)
+
+		offset, length := 100*memory.KiB.Int64(), 10*memory.KiB.Int64()
+		reader, meta, err := segmentStore.GetRange(ctx, path, offset, length)
+		require.NoError(t, err)
+		assert.Equal(t, int64(len(data)), meta.Size)
+
+		read, err := ioutil.ReadAll(reader)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+		assert.Equal(t, data[offset:offset+length], read)
+
+		// the nodes save the orders of a download once it's closed
+		downloads := func() (orders []*pb.Order) {
+			for _, node := range planet.StorageNodes {
+				unsent, err := node.DB.Orders().ListUnsent(ctx, 100)
+				require.NoError(t, err)
+				for _, info := range unsent {
+					if info.Limit.Action == pb.PieceAction_GET {
+						orders = append(orders, info.Order)
+					}
+				}
+			}
+			return orders
+		}
+		var orders []*pb.Order
+		for start := time.Now(); len(orders) < 2 && time.Since(start) < 10*time.Second; {
+			time.Sleep(100 * time.Millisecond)
+			orders = downloads()
+		}
+
+		// only the required pieces are downloaded, and only the part covering the range
+		require.Len(t, orders, 2)
+		for _, order := range orders {
+			assert.True(t, order.Amount <= length, "downloaded %d bytes of a piece", order.Amount)
+		}
+
+		// inline segments are ranged too
+		inlineData := testrand.Bytes(2 * memory.KiB)
+		inlinePath := "l/path/1"
+		_, err = segmentStore.Put(ctx, bytes.NewReader(inlineData), time.Time{}, func() (storj.Path, []byte, error) {
+			return inlinePath, []byte("metadata"), nil
+		})
+		require.NoError(t, err)
+
+		reader, _, err = segmentStore.GetRange(ctx, inlinePath, 10, 100)
+		require.NoError(t, err)
+		read, err = ioutil.ReadAll(reader)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+		assert.Equal(t, inlineData[10:110], read)
+	})
+}
+
 func TestSegmentStoreDelete(t *testing.T) {
 	for _, tt := range []struct {
 		name       string
@@ -147,6 +208,39 @@ func TestSegmentStoreDelete(t *testing.T) {
 	}
 }
 
+func TestSegmentStoreDeleteVerified(t *testing.T) {
+	runTest(t, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet, segmentStore segments.Store) {
+		path := "s0/test-bucket/mypath/1"
+		_, err := segmentStore.Put(ctx, bytes.NewReader(testrand.Bytes(100*memory.KiB)), time.Time{}, func() (storj.Path, []byte, error) {
+			return path, []byte("metadata"), nil
+		})
+		require.NoError(t, err)
+
+		// a stopped node cannot confirm the deletion of its piece
+		offline := planet.StorageNodes[len(planet.StorageNodes)-1]
+		require.NoError(t, planet.StopPeer(offline))
+
+		unconfirmed, err := segmentStore.DeleteVerified(ctx, path)
+		require.NoError(t, err)
+		assert.Equal(t, storj.NodeIDList{offline.ID()}, unconfirmed)
+
+		_, _, err = segmentStore.Get(ctx, path)
+		require.Error(t, err)
+		require.True(t, storage.ErrKeyNotFound.Has(err))
+
+		// inline segments have no pieces to confirm
+		inlinePath := "l/path/1"
+		_, err = segmentStore.Put(ctx, bytes.NewReader(testrand.Bytes(2*memory.KiB)), time.Time{}, func() (storj.Path, []byte, error) {
+			return inlinePath, []byte("metadata"), nil
+		})
+		require.NoError(t, err)
+
+		unconfirmed, err = segmentStore.DeleteVerified(ctx, inlinePath)
+		require.NoError(t, err)
+		assert.Empty(t, unconfirmed)
+	})
+}
+
 func TestSegmentStoreList(t *testing.T) {
 	runTest(t, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet, segmentStore segments.Store) {
 		expiration := time.Now().Add(24 * time.Hour * 10)
@@ -234,6 +328,85 @@ func TestCalcNeededNodes(t *testing.T) {
 	}
 }
 
+func TestSegmentStorePutStoredSize(t *testing.T) {
+	runTest(t, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet, segmentStore segments.Store) {
+		meta, err := segmentStore.Put(ctx, bytes.NewReader(testrand.Bytes(100*memory.KiB)), time.Time{}, func() (storj.Path, []byte, error) {
+			return "s0/test-bucket/mypath/1", []byte("metadata"), nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, (100 * memory.KiB).Int64(), meta.Size)
+
+		// the erasure coding used by runTest expands 2 shares into 4 pieces,
+		// each padded up to a whole number of stripes
+		fc, err := infectious.NewFEC(2, 4)
+		require.NoError(t, err)
+		scheme := eestream.NewRSScheme(fc, 1*memory.KiB.Int())
+		pieceSize := eestream.CalcPieceSize(meta.Size, scheme)
+		assert.True(t, pieceSize*int64(scheme.RequiredCount()) > meta.Size)
+		assert.Equal(t, pieceSize*int64(len(planet.StorageNodes)), meta.StoredSize)
+		assert.True(t, meta.StoredSize > meta.Size)
+
+		// inline segments are stored as they are
+		meta, err = segmentStore.Put(ctx, bytes.NewReader(testrand.Bytes(2*memory.KiB)), time.Time{}, func() (storj.Path, []byte, error) {
+			return "l/path/1", []byte("metadata"), nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, meta.Size, meta.StoredSize)
+	})
+}
+
+func TestSegmentStorePutIdempotent(t *testing.T) {
+	runTest(t, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet, segmentStore segments.Store) {
+		const path = "s0/test-bucket/mypath/1"
+		idempotencyKey := testrand.Bytes(16)
+
+		tryPut := func(content []byte, key []byte) (segments.Meta, error) {
+			return segmentStore.PutIdempotent(ctx, bytes.NewReader(content), time.Time{}, key, func() (storj.Path, []byte, error) {
+				return path, []byte("metadata"), nil
+			})
+		}
+
+		put := func(content []byte, key []byte) segments.Meta {
+			meta, err := tryPut(content, key)
+			require.NoError(t, err)
+			return meta
+		}
+
+		get := func() []byte {
+			rr, _, err := segmentStore.Get(ctx, path)
+			require.NoError(t, err)
+			reader, err := rr.Range(ctx, 0, rr.Size())
+			require.NoError(t, err)
+			defer ctx.Check(reader.Close)
+			content, err := ioutil.ReadAll(reader)
+			require.NoError(t, err)
+			return content
+		}
+
+		first := testrand.Bytes(100 * memory.KiB)
+		committed := put(first, idempotencyKey)
+
+		// the retry is not committed, the first segment is returned
+		retried := put(testrand.Bytes(50*memory.KiB), idempotencyKey)
+		assert.True(t, committed.Modified.Equal(retried.Modified))
+		assert.Equal(t, committed.Size, retried.Size)
+		assert.Equal(t, first, get())
+
+		// a different key doesn't overwrite the committed segment
+		second := testrand.Bytes(50 * memory.KiB)
+		secondKey := testrand.Bytes(16)
+		_, err := tryPut(second, secondKey)
+		require.Error(t, err)
+		assert.Equal(t, first, get())
+
+		// the key of the failed commit is released, so retrying it commits the segment
+		require.NoError(t, segmentStore.Delete(ctx, path))
+		meta := put(second, secondKey)
+		assert.Equal(t, int64(len(second)), meta.Size)
+		assert.Equal(t, second, get())
+	})
+}
+
 func runTest(t *testing.T, test func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet, segmentStore segments.Store)) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
@@ -21,7 +21,7 @@ func (r *EOFReader) Read(p []byte) (n int, err error) {
 	n, err = r.reader.Read(p)
 	if err == io.EOF {
 		r.eof = true
-	} else if err != nil && r.err == nil {
+	} else if err != nil && err != ErrFlush && r.err == nil {
 		r.err = err
 	}
 	return n, err
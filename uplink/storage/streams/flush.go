@@ -0,0 +1,32 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package streams
+
+import (
+	"errors"
+	"io"
+)
+
+// ErrFlush is returned by the data reader passed to Put to commit the data read
+// so far, even if it doesn't fill a segment. The committed segments are readable
+// if the upload doesn't finish, and Put continues reading into a new segment.
+var ErrFlush = errors.New("flush")
+
+// flushReader ends a segment when the underlying reader asks for a flush
+type flushReader struct {
+	reader  io.Reader
+	flushed bool
+}
+
+func (r *flushReader) Read(p []byte) (n int, err error) {
+	if r.flushed {
+		return 0, io.EOF
+	}
+	n, err = r.reader.Read(p)
+	if err == ErrFlush {
+		r.flushed = true
+		err = io.EOF
+	}
+	return n, err
+}
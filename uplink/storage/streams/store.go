@@ -44,11 +44,24 @@ func convertMeta(lastSegmentMeta segments.Meta, stream pb.StreamInfo, streamMeta
 	return Meta{
 		Modified:   lastSegmentMeta.Modified,
 		Expiration: lastSegmentMeta.Expiration,
-		Size:       ((stream.NumberOfSegments - 1) * stream.SegmentsSize) + stream.LastSegmentSize,
+		Size:       StreamSize(stream),
 		Data:       stream.Metadata,
 	}
 }
 
+// StreamSize returns the size of the data of a stream
+func StreamSize(stream pb.StreamInfo) int64 {
+	if len(stream.SegmentSizes) == 0 {
+		return ((stream.NumberOfSegments - 1) * stream.SegmentsSize) + stream.LastSegmentSize
+	}
+
+	size := stream.LastSegmentSize
+	for _, segmentSize := range stream.SegmentSizes {
+		size += segmentSize
+	}
+	return size
+}
+
 // Store interface methods for streams to satisfy to be a store
 type typedStore interface {
 	Meta(ctx context.Context, path Path, pathCipher storj.CipherSuite) (Meta, error)
@@ -92,6 +105,10 @@ func newTypedStreamStore(segments segments.Store, segmentSize int64, encStore *e
 // store the first piece at s0/<path>, second piece at s1/<path>, and the
 // *last* piece at l/<path>. Store the given metadata, along with the number
 // of segments, in a new protobuf, in the metadata of l/<path>.
+//
+// When data returns ErrFlush, the current piece is stored even if it is shorter,
+// followed by an empty l/<path> describing the pieces stored so far. Those
+// pieces are kept when the upload fails afterwards.
 func (s *streamStore) Put(ctx context.Context, path Path, pathCipher storj.CipherSuite, data io.Reader, metadata []byte, expiration time.Time) (m Meta, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -103,36 +120,57 @@ func (s *streamStore) Put(ctx context.Context, path Path, pathCipher storj.Ciphe
 		return Meta{}, err
 	}
 
-	m, lastSegment, err := s.upload(ctx, path, pathCipher, data, metadata, expiration)
+	m, flushedSegments, lastSegment, err := s.upload(ctx, path, pathCipher, data, metadata, expiration)
 	if err != nil {
-		s.cancelHandler(context.Background(), lastSegment, path, pathCipher)
+		s.cancelHandler(context.Background(), flushedSegments, lastSegment, path, pathCipher)
 	}
 
 	return m, err
 }
 
-func (s *streamStore) upload(ctx context.Context, path Path, pathCipher storj.CipherSuite, data io.Reader, metadata []byte, expiration time.Time) (m Meta, lastSegment int64, err error) {
+func (s *streamStore) upload(ctx context.Context, path Path, pathCipher storj.CipherSuite, data io.Reader, metadata []byte, expiration time.Time) (m Meta, flushedSegments, lastSegment int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	var currentSegment int64
 	var streamSize int64
 	var putMeta segments.Meta
 
+	// the sizes of the segments are stored only if a flush made any of them short
+	var segmentSizes []int64
+	var flushed, checkpointed bool
+
 	defer func() {
 		select {
 		case <-ctx.Done():
-			s.cancelHandler(context.Background(), currentSegment, path, pathCipher)
+			s.cancelHandler(context.Background(), flushedSegments, currentSegment, path, pathCipher)
 		default:
 		}
 	}()
 
 	derivedKey, err := encryption.DeriveContentKey(path.Bucket(), path.UnencryptedPath(), s.encStore)
 	if err != nil {
-		return Meta{}, currentSegment, err
+		return Meta{}, flushedSegments, currentSegment, err
 	}
 	encPath, err := encryption.EncryptPath(path.Bucket(), path.UnencryptedPath(), pathCipher, s.encStore)
 	if err != nil {
-		return Meta{}, currentSegment, err
+		return Meta{}, flushedSegments, currentSegment, err
+	}
+	lastSegmentPath, err := createSegmentPath(ctx, -1, path.Bucket(), encPath)
+	if err != nil {
+		return Meta{}, flushedSegments, currentSegment, err
+	}
+
+	streamInfo := func(lastSegmentSize int64) *pb.StreamInfo {
+		info := &pb.StreamInfo{
+			NumberOfSegments: currentSegment + 1,
+			SegmentsSize:     s.segmentSize,
+			LastSegmentSize:  lastSegmentSize,
+			Metadata:         metadata,
+		}
+		if flushed {
+			info.SegmentSizes = segmentSizes
+		}
+		return info
 	}
 
 	eofReader := NewEOFReader(data)
@@ -142,7 +180,7 @@ func (s *streamStore) upload(ctx context.Context, path Path, pathCipher storj.Ci
 		var contentKey storj.Key
 		_, err = rand.Read(contentKey[:])
 		if err != nil {
-			return Meta{}, currentSegment, err
+			return Meta{}, flushedSegments, currentSegment, err
 		}
 
 		// Initialize the content nonce with the segment's index incremented by 1.
@@ -151,33 +189,34 @@ func (s *streamStore) upload(ctx context.Context, path Path, pathCipher storj.Ci
 		var contentNonce storj.Nonce
 		_, err := encryption.Increment(&contentNonce, currentSegment+1)
 		if err != nil {
-			return Meta{}, currentSegment, err
+			return Meta{}, flushedSegments, currentSegment, err
 		}
 
 		encrypter, err := encryption.NewEncrypter(s.cipher, &contentKey, &contentNonce, s.encBlockSize)
 		if err != nil {
-			return Meta{}, currentSegment, err
+			return Meta{}, flushedSegments, currentSegment, err
 		}
 
 		// generate random nonce for encrypting the content key
 		var keyNonce storj.Nonce
 		_, err = rand.Read(keyNonce[:])
 		if err != nil {
-			return Meta{}, currentSegment, err
+			return Meta{}, flushedSegments, currentSegment, err
 		}
 
 		encryptedKey, err := encryption.EncryptKey(&contentKey, s.cipher, derivedKey, &keyNonce)
 		if err != nil {
-			return Meta{}, currentSegment, err
+			return Meta{}, flushedSegments, currentSegment, err
 		}
 
-		sizeReader := NewSizeReader(eofReader)
+		flushReader := &flushReader{reader: eofReader}
+		sizeReader := NewSizeReader(flushReader)
 		segmentReader := io.LimitReader(sizeReader, s.segmentSize)
 		peekReader := segments.NewPeekThresholdReader(segmentReader)
 		// If the data is larger than the inline threshold size, then it will be a remote segment
 		isRemote, err := peekReader.IsLargerThan(s.inlineThreshold)
 		if err != nil {
-			return Meta{}, currentSegment, err
+			return Meta{}, flushedSegments, currentSegment, err
 		}
 		var transformedReader io.Reader
 		if isRemote {
@@ -186,88 +225,77 @@ func (s *streamStore) upload(ctx context.Context, path Path, pathCipher storj.Ci
 		} else {
 			data, err := ioutil.ReadAll(peekReader)
 			if err != nil {
-				return Meta{}, currentSegment, err
+				return Meta{}, flushedSegments, currentSegment, err
 			}
 			cipherData, err := encryption.Encrypt(data, s.cipher, &contentKey, &contentNonce)
 			if err != nil {
-				return Meta{}, currentSegment, err
+				return Meta{}, flushedSegments, currentSegment, err
 			}
 			transformedReader = bytes.NewReader(cipherData)
 		}
 
-		putMeta, err = s.segments.Put(ctx, transformedReader, expiration, func() (storj.Path, []byte, error) {
-			if !eofReader.isEOF() {
-				segmentPath, err := createSegmentPath(ctx, currentSegment, path.Bucket(), encPath)
-				if err != nil {
-					return "", nil, err
+		// a flush right after a complete segment doesn't store an empty one
+		if !flushReader.flushed || sizeReader.Size() > 0 {
+			putMeta, err = s.segments.Put(ctx, transformedReader, expiration, func() (storj.Path, []byte, error) {
+				if !eofReader.isEOF() {
+					segmentPath, err := createSegmentPath(ctx, currentSegment, path.Bucket(), encPath)
+					if err != nil {
+						return "", nil, err
+					}
+
+					if s.cipher == storj.EncNull {
+						return segmentPath, nil, nil
+					}
+
+					segmentMeta, err := proto.Marshal(&pb.SegmentMeta{
+						EncryptedKey: encryptedKey,
+						KeyNonce:     keyNonce[:],
+					})
+					if err != nil {
+						return "", nil, err
+					}
+
+					return segmentPath, segmentMeta, nil
 				}
 
-				if s.cipher == storj.EncNull {
-					return segmentPath, nil, nil
+				if checkpointed {
+					// replace the last segment stored by the latest flush
+					err := s.segments.Delete(ctx, lastSegmentPath)
+					if err != nil {
+						return "", nil, err
+					}
+					checkpointed = false
 				}
 
-				segmentMeta, err := proto.Marshal(&pb.SegmentMeta{
-					EncryptedKey: encryptedKey,
-					KeyNonce:     keyNonce[:],
-				})
+				lastSegmentMeta, err := s.lastSegmentMeta(streamInfo(sizeReader.Size()), &contentKey, encryptedKey, &keyNonce)
 				if err != nil {
 					return "", nil, err
 				}
 
-				return segmentPath, segmentMeta, nil
-			}
-
-			lastSegmentPath, err := createSegmentPath(ctx, -1, path.Bucket(), encPath)
-			if err != nil {
-				return "", nil, err
-			}
-
-			streamInfo, err := proto.Marshal(&pb.StreamInfo{
-				NumberOfSegments: currentSegment + 1,
-				SegmentsSize:     s.segmentSize,
-				LastSegmentSize:  sizeReader.Size(),
-				Metadata:         metadata,
+				return lastSegmentPath, lastSegmentMeta, nil
 			})
 			if err != nil {
-				return "", nil, err
-			}
-
-			// encrypt metadata with the content encryption key and zero nonce
-			encryptedStreamInfo, err := encryption.Encrypt(streamInfo, s.cipher, &contentKey, &storj.Nonce{})
-			if err != nil {
-				return "", nil, err
-			}
-
-			streamMeta := pb.StreamMeta{
-				EncryptedStreamInfo: encryptedStreamInfo,
-				EncryptionType:      int32(s.cipher),
-				EncryptionBlockSize: int32(s.encBlockSize),
+				return Meta{}, flushedSegments, currentSegment, err
 			}
 
-			if s.cipher != storj.EncNull {
-				streamMeta.LastSegmentMeta = &pb.SegmentMeta{
-					EncryptedKey: encryptedKey,
-					KeyNonce:     keyNonce[:],
-				}
-			}
+			currentSegment++
+			streamSize += sizeReader.Size()
+			segmentSizes = append(segmentSizes, sizeReader.Size())
+		}
 
-			lastSegmentMeta, err := proto.Marshal(&streamMeta)
+		if flushReader.flushed && !(checkpointed && flushedSegments == currentSegment) {
+			flushed = true
+			err = s.commitCheckpoint(ctx, lastSegmentPath, derivedKey, streamInfo(0), checkpointed, expiration)
 			if err != nil {
-				return "", nil, err
+				return Meta{}, flushedSegments, currentSegment, err
 			}
-
-			return lastSegmentPath, lastSegmentMeta, nil
-		})
-		if err != nil {
-			return Meta{}, currentSegment, err
+			flushedSegments = currentSegment
+			checkpointed = true
 		}
-
-		currentSegment++
-		streamSize += sizeReader.Size()
 	}
 
 	if eofReader.hasError() {
-		return Meta{}, currentSegment, eofReader.err
+		return Meta{}, flushedSegments, currentSegment, eofReader.err
 	}
 
 	resultMeta := Meta{
@@ -277,7 +305,79 @@ func (s *streamStore) upload(ctx context.Context, path Path, pathCipher storj.Ci
 		Data:       metadata,
 	}
 
-	return resultMeta, currentSegment, nil
+	return resultMeta, flushedSegments, currentSegment, nil
+}
+
+// lastSegmentMeta returns the metadata of the last segment, which holds the
+// stream info encrypted with the content key of the segment.
+func (s *streamStore) lastSegmentMeta(info *pb.StreamInfo, contentKey *storj.Key, encryptedKey storj.EncryptedPrivateKey, keyNonce *storj.Nonce) (_ []byte, err error) {
+	streamInfo, err := proto.Marshal(info)
+	if err != nil {
+		return nil, err
+	}
+
+	// encrypt metadata with the content encryption key and zero nonce
+	encryptedStreamInfo, err := encryption.Encrypt(streamInfo, s.cipher, contentKey, &storj.Nonce{})
+	if err != nil {
+		return nil, err
+	}
+
+	streamMeta := pb.StreamMeta{
+		EncryptedStreamInfo: encryptedStreamInfo,
+		EncryptionType:      int32(s.cipher),
+		EncryptionBlockSize: int32(s.encBlockSize),
+	}
+
+	if s.cipher != storj.EncNull {
+		streamMeta.LastSegmentMeta = &pb.SegmentMeta{
+			EncryptedKey: encryptedKey,
+			KeyNonce:     keyNonce[:],
+		}
+	}
+
+	return proto.Marshal(&streamMeta)
+}
+
+// commitCheckpoint stores an empty last segment describing the segments stored
+// so far, so that the flushed data is readable before the upload finishes. The
+// last segment of the previous flush is replaced.
+func (s *streamStore) commitCheckpoint(ctx context.Context, lastSegmentPath storj.Path, derivedKey *storj.Key, info *pb.StreamInfo, replace bool, expiration time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var contentKey storj.Key
+	_, err = rand.Read(contentKey[:])
+	if err != nil {
+		return err
+	}
+
+	var keyNonce storj.Nonce
+	_, err = rand.Read(keyNonce[:])
+	if err != nil {
+		return err
+	}
+
+	encryptedKey, err := encryption.EncryptKey(&contentKey, s.cipher, derivedKey, &keyNonce)
+	if err != nil {
+		return err
+	}
+
+	lastSegmentMeta, err := s.lastSegmentMeta(info, &contentKey, encryptedKey, &keyNonce)
+	if err != nil {
+		return err
+	}
+
+	if replace {
+		err = s.segments.Delete(ctx, lastSegmentPath)
+		if err != nil {
+			return err
+		}
+	}
+
+	// the last segment has no data, so there is nothing to encrypt
+	_, err = s.segments.Put(ctx, bytes.NewReader(nil), expiration, func() (storj.Path, []byte, error) {
+		return lastSegmentPath, lastSegmentMeta, nil
+	})
+	return err
 }
 
 // Get returns a ranger that knows what the overall size is (from l/<path>)
@@ -317,6 +417,11 @@ func (s *streamStore) Get(ctx context.Context, path Path, pathCipher storj.Ciphe
 		return nil, Meta{}, err
 	}
 
+	// flushed streams have segments of different sizes
+	if len(stream.SegmentSizes) > 0 && int64(len(stream.SegmentSizes)) != stream.NumberOfSegments-1 {
+		return nil, Meta{}, errs.New("stream has %d segment sizes for %d segments", len(stream.SegmentSizes), stream.NumberOfSegments)
+	}
+
 	var rangers []ranger.Ranger
 	for i := int64(0); i < stream.NumberOfSegments-1; i++ {
 		currentPath, err := createSegmentPath(ctx, i, path.Bucket(), encPath)
@@ -330,10 +435,15 @@ func (s *streamStore) Get(ctx context.Context, path Path, pathCipher storj.Ciphe
 			return nil, Meta{}, err
 		}
 
+		size := stream.SegmentsSize
+		if len(stream.SegmentSizes) > 0 {
+			size = stream.SegmentSizes[i]
+		}
+
 		rangers = append(rangers, &lazySegmentRanger{
 			segments:      s.segments,
 			path:          currentPath,
-			size:          stream.SegmentsSize,
+			size:          size,
 			derivedKey:    derivedKey,
 			startingNonce: &contentNonce,
 			encBlockSize:  int(streamMeta.EncryptionBlockSize),
@@ -635,8 +745,9 @@ func decryptRanger(ctx context.Context, rr ranger.Ranger, decryptedSize int64, c
 	return eestream.Unpad(rd, int(rd.Size()-decryptedSize))
 }
 
-// CancelHandler handles clean up of segments on receiving CTRL+C
-func (s *streamStore) cancelHandler(ctx context.Context, totalSegments int64, path Path, pathCipher storj.CipherSuite) {
+// CancelHandler handles clean up of segments on receiving CTRL+C, the segments
+// before firstSegment were flushed and are kept
+func (s *streamStore) cancelHandler(ctx context.Context, firstSegment, totalSegments int64, path Path, pathCipher storj.CipherSuite) {
 	defer mon.Task()(&ctx)(nil)
 
 	encPath, err := encryption.EncryptPath(path.Bucket(), path.UnencryptedPath(), pathCipher, s.encStore)
@@ -645,7 +756,7 @@ func (s *streamStore) cancelHandler(ctx context.Context, totalSegments int64, pa
 		return
 	}
 
-	for i := int64(0); i < totalSegments; i++ {
+	for i := firstSegment; i < totalSegments; i++ {
 		currentPath, err := createSegmentPath(ctx, i, path.Bucket(), encPath)
 		if err != nil {
 			zap.S().Warnf("Failed deleting segment %d: %v", i, err)
@@ -13,12 +13,13 @@ import (
 
 // Download implements Reader, Seeker and Closer for reading from stream.
 type Download struct {
-	ctx     context.Context
-	stream  storj.ReadOnlyStream
-	streams streams.Store
-	reader  io.ReadCloser
-	offset  int64
-	closed  bool
+	ctx       context.Context
+	stream    storj.ReadOnlyStream
+	streams   streams.Store
+	readAhead int
+	reader    io.ReadCloser
+	offset    int64
+	closed    bool
 }
 
 // NewDownload creates new stream download.
@@ -30,6 +31,17 @@ func NewDownload(ctx context.Context, stream storj.ReadOnlyStream, streams strea
 	}
 }
 
+// NewDownloadWithReadAhead creates new stream download, which downloads up to
+// readAhead segments in the background ahead of the segment being read.
+func NewDownloadWithReadAhead(ctx context.Context, stream storj.ReadOnlyStream, streams streams.Store, readAhead int) *Download {
+	return &Download{
+		ctx:       ctx,
+		stream:    stream,
+		streams:   streams,
+		readAhead: readAhead,
+	}
+}
+
 // Read reads up to len(data) bytes into data.
 //
 // If this is the first call it will read from the beginning of the stream.
@@ -111,9 +123,14 @@ func (download *Download) resetReader(offset int64) error {
 		return err
 	}
 
-	download.reader, err = rr.Range(download.ctx, offset, obj.Size-offset)
-	if err != nil {
-		return err
+	if download.readAhead > 0 && obj.FixedSegmentSize > 0 {
+		chunks := splitChunks(offset, obj.Size-offset, obj.FixedSegmentSize)
+		download.reader = newReadAheadReader(download.ctx, rr, chunks, download.readAhead)
+	} else {
+		download.reader, err = rr.Range(download.ctx, offset, obj.Size-offset)
+		if err != nil {
+			return err
+		}
 	}
 
 	download.offset = offset
@@ -0,0 +1,147 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package stream
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/ranger"
+)
+
+// chunk is a byte range of a stream, usually covering a single segment.
+type chunk struct {
+	offset, length int64
+}
+
+// splitChunks splits the range starting at offset with the given length at
+// the segment boundaries of a stream with fixed segmentSize.
+func splitChunks(offset, length, segmentSize int64) []chunk {
+	var chunks []chunk
+	end := offset + length
+	for offset < end {
+		next := (offset/segmentSize + 1) * segmentSize
+		if next > end {
+			next = end
+		}
+		chunks = append(chunks, chunk{offset: offset, length: next - offset})
+		offset = next
+	}
+	return chunks
+}
+
+// prefetch is a chunk being downloaded in the background.
+type prefetch struct {
+	done chan struct{}
+	data []byte
+	err  error
+}
+
+// readAheadReader reads a sequence of chunks from a ranger, keeping up to depth
+// chunks downloading in the background ahead of the one being read.
+type readAheadReader struct {
+	ctx    context.Context
+	cancel func()
+	rr     ranger.Ranger
+	depth  int
+
+	chunks  []chunk
+	pending []*prefetch
+	current io.Reader
+}
+
+// newReadAheadReader creates a reader for chunks of rr which prefetches up to
+// depth chunks in the background.
+func newReadAheadReader(ctx context.Context, rr ranger.Ranger, chunks []chunk, depth int) io.ReadCloser {
+	ctx, cancel := context.WithCancel(ctx)
+	return &readAheadReader{
+		ctx:    ctx,
+		cancel: cancel,
+		rr:     rr,
+		depth:  depth,
+		chunks: chunks,
+	}
+}
+
+// fill starts downloading chunks until depth chunks are in flight.
+func (reader *readAheadReader) fill() {
+	for len(reader.pending) < reader.depth && len(reader.chunks) > 0 {
+		next := reader.chunks[0]
+		reader.chunks = reader.chunks[1:]
+
+		fetch := &prefetch{done: make(chan struct{})}
+		reader.pending = append(reader.pending, fetch)
+
+		go func() {
+			defer close(fetch.done)
+			fetch.data, fetch.err = reader.download(next)
+		}()
+	}
+}
+
+// download reads the whole chunk into memory.
+func (reader *readAheadReader) download(next chunk) (_ []byte, err error) {
+	r, err := reader.rr.Range(reader.ctx, next.offset, next.length)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, r.Close()) }()
+
+	return ioutil.ReadAll(r)
+}
+
+// Read reads from the current chunk, moving on to the next prefetched chunk
+// when the current one is exhausted.
+func (reader *readAheadReader) Read(data []byte) (n int, err error) {
+	for {
+		if reader.current != nil {
+			n, err = reader.current.Read(data)
+			if err != io.EOF {
+				return n, err
+			}
+			reader.current = nil
+			if n > 0 {
+				return n, nil
+			}
+		}
+
+		reader.fill()
+		if len(reader.pending) == 0 {
+			return 0, io.EOF
+		}
+
+		next := reader.pending[0]
+		reader.pending = reader.pending[1:]
+
+		// keep depth chunks downloading while the next one is being read
+		reader.fill()
+
+		select {
+		case <-next.done:
+		case <-reader.ctx.Done():
+			return 0, reader.ctx.Err()
+		}
+		if next.err != nil {
+			return 0, next.err
+		}
+
+		reader.current = bytes.NewReader(next.data)
+	}
+}
+
+// Close cancels the downloads in flight and waits for them to finish.
+func (reader *readAheadReader) Close() error {
+	reader.cancel()
+	for _, fetch := range reader.pending {
+		<-fetch.done
+	}
+	reader.pending = nil
+	reader.chunks = nil
+	reader.current = nil
+	return nil
+}
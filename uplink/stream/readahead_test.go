@@ -0,0 +1,68 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package stream
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/ranger"
+)
+
+// recordingRanger reports the offset of every Range call.
+type recordingRanger struct {
+	ranger.Ranger
+	requested chan int64
+}
+
+func (rr *recordingRanger) Range(ctx context.Context, offset, length int64) (io.ReadCloser, error) {
+	rr.requested <- offset
+	return rr.Ranger.Range(ctx, offset, length)
+}
+
+func TestSplitChunks(t *testing.T) {
+	assert.Equal(t, []chunk{{0, 10}, {10, 10}, {20, 5}}, splitChunks(0, 25, 10))
+	assert.Equal(t, []chunk{{5, 5}, {10, 3}}, splitChunks(5, 8, 10))
+	assert.Empty(t, splitChunks(10, 0, 10))
+}
+
+func TestReadAheadReader(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	const segmentSize = 100
+	data := testrand.BytesInt(4*segmentSize + 10)
+
+	rr := &recordingRanger{
+		Ranger:    ranger.ByteRanger(data),
+		requested: make(chan int64, 5),
+	}
+
+	reader := newReadAheadReader(ctx, rr, splitChunks(0, int64(len(data)), segmentSize), 2)
+
+	// reading the first segment starts the download of the following two
+	first := make([]byte, segmentSize)
+	_, err := io.ReadFull(reader, first)
+	require.NoError(t, err)
+	assert.Equal(t, data[:segmentSize], first)
+
+	requested := map[int64]bool{}
+	for i := 0; i < 3; i++ {
+		requested[<-rr.requested] = true
+	}
+	assert.Equal(t, map[int64]bool{0: true, segmentSize: true, 2 * segmentSize: true}, requested)
+
+	rest, err := ioutil.ReadAll(reader)
+	require.NoError(t, err)
+	assert.Equal(t, data[segmentSize:], rest)
+
+	require.NoError(t, reader.Close())
+}
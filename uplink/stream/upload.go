@@ -6,9 +6,9 @@ package stream
 import (
 	"context"
 	"io"
+	"sync"
 
 	"github.com/gogo/protobuf/proto"
-	"github.com/zeebo/errs"
 	"golang.org/x/sync/errgroup"
 
 	"storj.io/storj/pkg/pb"
@@ -21,20 +21,20 @@ type Upload struct {
 	ctx      context.Context
 	stream   storj.MutableStream
 	streams  streams.Store
-	writer   io.WriteCloser
+	pipe     *uploadPipe
 	closed   bool
 	errgroup errgroup.Group
 }
 
 // NewUpload creates new stream upload.
 func NewUpload(ctx context.Context, stream storj.MutableStream, streams streams.Store) *Upload {
-	reader, writer := io.Pipe()
+	pipe := newUploadPipe(ctx)
 
 	upload := Upload{
 		ctx:     ctx,
 		stream:  stream,
 		streams: streams,
-		writer:  writer,
+		pipe:    pipe,
 	}
 
 	upload.errgroup.Go(func() error {
@@ -46,15 +46,13 @@ func NewUpload(ctx context.Context, stream storj.MutableStream, streams streams.
 		}
 		metadata, err := proto.Marshal(&serMetaInfo)
 		if err != nil {
-			return errs.Combine(err, reader.CloseWithError(err))
+			pipe.closeRead(err)
+			return err
 		}
 
-		_, err = streams.Put(ctx, storj.JoinPaths(obj.Bucket.Name, obj.Path), obj.Bucket.PathCipher, reader, metadata, obj.Expires)
-		if err != nil {
-			return errs.Combine(err, reader.CloseWithError(err))
-		}
-
-		return nil
+		_, err = streams.Put(ctx, storj.JoinPaths(obj.Bucket.Name, obj.Path), obj.Bucket.PathCipher, pipe, metadata, obj.Expires)
+		pipe.closeRead(err)
+		return err
 	})
 
 	return &upload
@@ -68,7 +66,19 @@ func (upload *Upload) Write(data []byte) (n int, err error) {
 		return 0, Error.New("already closed")
 	}
 
-	return upload.writer.Write(data)
+	return upload.pipe.Write(data)
+}
+
+// Flush commits the data written so far in a segment of its own, even if the
+// segment isn't full, and starts a new segment for the data written next. The
+// flushed data is readable when Flush returns, and stays readable if the upload
+// is aborted later.
+func (upload *Upload) Flush(ctx context.Context) error {
+	if upload.closed {
+		return Error.New("already closed")
+	}
+
+	return upload.pipe.flush(ctx)
 }
 
 // Close closes the stream and releases the underlying resources.
@@ -79,8 +89,151 @@ func (upload *Upload) Close() error {
 
 	upload.closed = true
 
-	err := upload.writer.Close()
+	upload.pipe.closeWrite()
 
 	// Wait for streams.Put to commit the upload to the PointerDB
-	return errs.Combine(err, upload.errgroup.Wait())
+	return upload.errgroup.Wait()
+}
+
+// uploadPipe passes the written data to the uploader like io.Pipe does, and
+// asks the uploader to flush the data written so far with streams.ErrFlush.
+type uploadPipe struct {
+	ctx context.Context
+
+	mu       sync.Mutex
+	data     []byte
+	flushing bool
+	flushed  bool
+	flushes  int64
+	writeErr error
+	readErr  error
+	changed  chan struct{}
+}
+
+func newUploadPipe(ctx context.Context) *uploadPipe {
+	return &uploadPipe{
+		ctx:     ctx,
+		changed: make(chan struct{}),
+	}
+}
+
+// notify wakes up the waiters after the state was modified under the lock.
+func (pipe *uploadPipe) notify() {
+	close(pipe.changed)
+	pipe.changed = make(chan struct{})
+}
+
+// wait waits for the state to be modified, releasing the lock meanwhile.
+func (pipe *uploadPipe) wait(ctx context.Context) error {
+	changed := pipe.changed
+	pipe.mu.Unlock()
+	defer pipe.mu.Lock()
+
+	select {
+	case <-changed:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Write waits until the uploader has read all of data.
+func (pipe *uploadPipe) Write(data []byte) (n int, err error) {
+	pipe.mu.Lock()
+	defer pipe.mu.Unlock()
+
+	if pipe.writeErr != nil {
+		return 0, io.ErrClosedPipe
+	}
+
+	pipe.data = data
+	pipe.notify()
+	for len(pipe.data) > 0 && pipe.readErr == nil && err == nil {
+		err = pipe.wait(pipe.ctx)
+	}
+
+	n = len(data) - len(pipe.data)
+	pipe.data = nil
+	if n < len(data) && err == nil {
+		err = pipe.readErr
+	}
+	return n, err
+}
+
+// Read reads the written data, and returns streams.ErrFlush once all data
+// written before a flush was read.
+func (pipe *uploadPipe) Read(data []byte) (n int, err error) {
+	pipe.mu.Lock()
+	defer pipe.mu.Unlock()
+
+	if pipe.flushed {
+		// the uploader reads again only after committing the flushed data
+		pipe.flushed = false
+		pipe.flushes++
+		pipe.notify()
+	}
+
+	for {
+		switch {
+		case len(pipe.data) > 0:
+			n = copy(data, pipe.data)
+			pipe.data = pipe.data[n:]
+			pipe.notify()
+			return n, nil
+		case pipe.flushing:
+			pipe.flushing = false
+			pipe.flushed = true
+			return 0, streams.ErrFlush
+		case pipe.writeErr != nil:
+			return 0, pipe.writeErr
+		}
+
+		if err := pipe.wait(pipe.ctx); err != nil {
+			return 0, err
+		}
+	}
+}
+
+// flush asks the uploader to flush and waits until the flushed data is committed.
+func (pipe *uploadPipe) flush(ctx context.Context) error {
+	pipe.mu.Lock()
+	defer pipe.mu.Unlock()
+
+	if pipe.readErr != nil {
+		return Error.Wrap(pipe.readErr)
+	}
+
+	flushes := pipe.flushes
+	pipe.flushing = true
+	pipe.notify()
+	for pipe.flushes == flushes {
+		if pipe.readErr != nil {
+			return Error.Wrap(pipe.readErr)
+		}
+		if err := pipe.wait(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// closeWrite makes the uploader read io.EOF after the written data.
+func (pipe *uploadPipe) closeWrite() {
+	pipe.mu.Lock()
+	defer pipe.mu.Unlock()
+
+	pipe.writeErr = io.EOF
+	pipe.notify()
+}
+
+// closeRead stops the writes once the uploader finished with err.
+func (pipe *uploadPipe) closeRead(err error) {
+	pipe.mu.Lock()
+	defer pipe.mu.Unlock()
+
+	if err == nil {
+		err = io.ErrClosedPipe
+	}
+	pipe.readErr = err
+	pipe.notify()
 }
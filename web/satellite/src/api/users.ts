@@ -108,13 +108,13 @@ export async function forgotPasswordRequest(email: string): Promise<RequestRespo
 }
 
 // Performs Create user graqhQL request.
-export async function createUserRequest(user: User, password: string, secret: string, refUserId?: string): Promise<RequestResponse<string>> {
+export async function createUserRequest(user: User, password: string, secret: string, referralToken?: string): Promise<RequestResponse<string>> {
     let result: RequestResponse<string> = new RequestResponse<string>();
 
     let response = await apolloManager.mutate(
         {
             mutation: gql(`
-                mutation($email: String!, $password: String!, $fullName: String!, $shortName: String!, $partnerID: String!, $referrerUserID: String!, $secret: String!) {
+                mutation($email: String!, $password: String!, $fullName: String!, $shortName: String!, $partnerID: String!, $referralToken: String!, $secret: String!) {
                     createUser(
                         input:{
                             email: $email,
@@ -123,7 +123,7 @@ export async function createUserRequest(user: User, password: string, secret: st
                             shortName: $shortName,
                             partnerId: $partnerID
                         },
-                        referrerUserId: $referrerUserID,
+                        referralToken: $referralToken,
                         secret: $secret,
                     ){email, id}
                 }`
@@ -134,7 +134,7 @@ export async function createUserRequest(user: User, password: string, secret: st
                 fullName: user.fullName,
                 shortName: user.shortName,
                 partnerID: user.partnerId ? user.partnerId : '',
-                referrerUserID: refUserId ? refUserId : '',
+                referralToken: referralToken ? referralToken : '',
                 secret: secret
             },
             fetchPolicy: 'no-cache',